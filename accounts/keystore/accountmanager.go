@@ -80,7 +80,7 @@ func (m *AccountManager) Import(keyBytes []byte) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to convert bytes to private key")
 	}
-	pubKey, err := crypto.EC283.NewPubKey(priKey)
+	pubKey, err := crypto.NewPubKey(priKey)
 	if err != nil {
 		return errors.Wrap(err, "failed to derive public key from private key")
 	}
@@ -111,5 +111,5 @@ func (m *AccountManager) SignHash(encodedAddr string, hash []byte) ([]byte, erro
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get the private key of account %s", encodedAddr)
 	}
-	return crypto.EC283.Sign(key, hash), nil
+	return crypto.Sign(key, hash), nil
 }
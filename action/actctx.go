@@ -67,6 +67,10 @@ func (act *AbstractAction) BasicActionSize() uint32 {
 	return uint32(size)
 }
 
+func (act *AbstractAction) setDstAddr(addr string) { act.dstAddr = addr }
+
+func (act *AbstractAction) setGasLimit(gasLimit uint64) { act.gasLimit = gasLimit }
+
 // SetEnvelopeContext sets the SealedEnvelope context to action context.
 func (act *AbstractAction) SetEnvelopeContext(selp SealedEnvelope) {
 	if act == nil {
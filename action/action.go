@@ -15,7 +15,6 @@ import (
 	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/keypair"
-	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/proto"
 )
@@ -32,7 +31,8 @@ type Action interface {
 	SetEnvelopeContext(SealedEnvelope)
 }
 
-type actionPayload interface {
+// Payload is the payload of an action envelope, which is implemented by each kind of action
+type Payload interface {
 	ByteStream() []byte
 	Cost() (*big.Int, error)
 	IntrinsicGas() (uint64, error)
@@ -45,7 +45,7 @@ type Envelope struct {
 	nonce    uint64
 	dstAddr  string
 	gasLimit uint64
-	payload  actionPayload
+	payload  Payload
 	gasPrice *big.Int
 }
 
@@ -120,6 +120,11 @@ func (sealed *SealedEnvelope) SrcAddr() string { return sealed.srcAddr }
 // SrcPubkey returns the source public key
 func (sealed *SealedEnvelope) SrcPubkey() keypair.PublicKey { return sealed.srcPubkey }
 
+// SrcKeyType returns the type of the source public key, which determines the signature scheme
+func (sealed *SealedEnvelope) SrcKeyType() keypair.KeyType {
+	return keypair.PublicKeyType(sealed.srcPubkey)
+}
+
 // Signature returns signature bytes
 func (sealed *SealedEnvelope) Signature() []byte {
 	sig := make([]byte, len(sealed.signature))
@@ -127,8 +132,8 @@ func (sealed *SealedEnvelope) Signature() []byte {
 	return sig
 }
 
-// Proto converts it to it's proto scheme.
-func (sealed SealedEnvelope) Proto() *iproto.ActionPb {
+// Proto converts it to it's proto scheme, which fails if the payload type is not registered or cannot be encoded.
+func (sealed SealedEnvelope) Proto() (*iproto.ActionPb, error) {
 	elp := sealed.Envelope
 	actPb := &iproto.ActionPb{
 		Version:      elp.version,
@@ -142,29 +147,15 @@ func (sealed SealedEnvelope) Proto() *iproto.ActionPb {
 		actPb.GasPrice = elp.gasPrice.Bytes()
 	}
 
-	// TODO assert each action
-	act := sealed.Action()
-	switch act := act.(type) {
-	case *Transfer:
-		actPb.Action = &iproto.ActionPb_Transfer{Transfer: act.Proto()}
-	case *Vote:
-		actPb.Action = &iproto.ActionPb_Vote{Vote: act.Proto()}
-	case *Execution:
-		actPb.Action = &iproto.ActionPb_Execution{Execution: act.Proto()}
-	case *PutBlock:
-		actPb.Action = &iproto.ActionPb_PutBlock{PutBlock: act.Proto()}
-	case *StartSubChain:
-		actPb.Action = &iproto.ActionPb_StartSubChain{StartSubChain: act.Proto()}
-	case *StopSubChain:
-		actPb.Action = &iproto.ActionPb_StopSubChain{StopSubChain: act.Proto()}
-	case *CreateDeposit:
-		actPb.Action = &iproto.ActionPb_CreateDeposit{CreateDeposit: act.Proto()}
-	case *SettleDeposit:
-		actPb.Action = &iproto.ActionPb_SettleDeposit{SettleDeposit: act.Proto()}
-	default:
-		log.S().Panicf("Cannot convert type of action %T.\r\n", act)
+	act := sealed.payload
+	codec, err := codecOf(act)
+	if err != nil {
+		return nil, err
+	}
+	if err := codec.Encode(act, actPb); err != nil {
+		return nil, errors.Wrapf(err, "failed to convert action %T", act)
 	}
-	return actPb
+	return actPb, nil
 }
 
 // LoadProto loads from proto scheme.
@@ -176,6 +167,9 @@ func (sealed *SealedEnvelope) LoadProto(pbAct *iproto.ActionPb) error {
 	if err != nil {
 		return err
 	}
+	if keypair.PublicKeyType(srcPub) == keypair.UnknownKeyType {
+		return errors.Wrap(keypair.ErrPublicKey, "unknown sender public key type")
+	}
 	if sealed == nil {
 		return errors.New("nil action to load proto")
 	}
@@ -191,64 +185,16 @@ func (sealed *SealedEnvelope) LoadProto(pbAct *iproto.ActionPb) error {
 	sealed.gasPrice = &big.Int{}
 	sealed.gasPrice.SetBytes(pbAct.GetGasPrice())
 
-	if pbAct.GetTransfer() != nil {
-		sealed.dstAddr = pbAct.GetTransfer().Recipient
-		act := &Transfer{}
-		if err := act.LoadProto(pbAct.GetTransfer()); err != nil {
-			return err
-		}
-		sealed.payload = act
-	} else if pbAct.GetVote() != nil {
-		sealed.dstAddr = pbAct.GetVote().VoteeAddress
-		act := &Vote{}
-		if err := act.LoadProto(pbAct.GetVote()); err != nil {
-			return err
-		}
-		sealed.payload = act
-	} else if pbAct.GetExecution() != nil {
-		sealed.dstAddr = pbAct.GetExecution().Contract
-		act := &Execution{}
-		if err := act.LoadProto(pbAct.GetExecution()); err != nil {
-			return err
-		}
-		sealed.payload = act
-	} else if pbAct.GetPutBlock() != nil {
-		sealed.dstAddr = pbAct.GetPutBlock().SubChainAddress
-		act := &PutBlock{}
-		if err := act.LoadProto(pbAct.GetPutBlock()); err != nil {
-			return err
-		}
-		sealed.payload = act
-	} else if pbAct.GetStartSubChain() != nil {
-		act := &StartSubChain{}
-		if err := act.LoadProto(pbAct.GetStartSubChain()); err != nil {
-			return err
-		}
-		sealed.payload = act
-	} else if pbAct.GetStopSubChain() != nil {
-		sealed.dstAddr = pbAct.GetStopSubChain().SubChainAddress
-		act := &StopSubChain{}
-		if err := act.LoadProto(pbAct.GetStopSubChain()); err != nil {
-			return err
-		}
-		sealed.payload = act
-	} else if pbAct.GetCreateDeposit() != nil {
-		sealed.dstAddr = pbAct.GetCreateDeposit().Recipient
-		act := &CreateDeposit{}
-		if err := act.LoadProto(pbAct.GetCreateDeposit()); err != nil {
-			return err
-		}
-		sealed.payload = act
-	} else if pbAct.GetSettleDeposit() != nil {
-		sealed.dstAddr = pbAct.GetSettleDeposit().Recipient
-		act := &SettleDeposit{}
-		if err := act.LoadProto(pbAct.GetSettleDeposit()); err != nil {
-			return err
-		}
-		sealed.payload = act
-	} else {
+	codec := matchCodec(pbAct)
+	if codec == nil {
 		return errors.New("no appliable action to handle in action proto")
 	}
+	payload, dstAddr, err := codec.Decode(pbAct)
+	if err != nil {
+		return err
+	}
+	sealed.dstAddr = dstAddr
+	sealed.payload = payload
 	sealed.payload.SetEnvelopeContext(*sealed)
 	return nil
 }
@@ -258,7 +204,7 @@ func Sign(act Envelope, addr string, sk keypair.PrivateKey) (SealedEnvelope, err
 	sealed := SealedEnvelope{Envelope: act}
 
 	// TODO: we should avoid generate public key from private key in each signature
-	pk, err := crypto.EC283.NewPubKey(sk)
+	pk, err := crypto.NewPubKey(sk)
 	if err != nil {
 		return sealed, errors.Wrapf(err, "error when deriving public key from private key")
 	}
@@ -269,7 +215,7 @@ func Sign(act Envelope, addr string, sk keypair.PrivateKey) (SealedEnvelope, err
 	sealed.payload.SetEnvelopeContext(sealed)
 
 	hash := sealed.Hash()
-	sig := crypto.EC283.Sign(sk, hash[:])
+	sig := crypto.Sign(sk, hash[:])
 	if len(sig) == 0 {
 		return sealed, errors.Wrapf(ErrAction, "failed to sign action hash = %x", hash)
 	}
@@ -305,13 +251,14 @@ func AssembleSealedEnvelope(act Envelope, addr string, pk keypair.PublicKey, sig
 // Verify verifies the action using sender's public key
 func Verify(sealed SealedEnvelope) error {
 	hash := sealed.Hash()
-	if success := crypto.EC283.Verify(sealed.SrcPubkey(), hash[:], sealed.Signature()); success {
+	if success := crypto.Verify(sealed.SrcPubkey(), hash[:], sealed.Signature()); success {
 		return nil
 	}
 	return errors.Wrapf(
 		ErrAction,
-		"failed to verify action hash = %x and signature = %x",
+		"failed to verify action hash = %x and %s signature = %x",
 		hash,
+		sealed.SrcKeyType(),
 		sealed.Signature(),
 	)
 }
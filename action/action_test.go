@@ -13,7 +13,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
 )
 
 func TestActionProto(t *testing.T) {
@@ -37,8 +39,49 @@ func TestActionProto(t *testing.T) {
 
 	require.NoError(Verify(selp))
 
+	actPb, err := selp.Proto()
+	require.NoError(err)
 	nselp := &SealedEnvelope{}
-	nselp.LoadProto(selp.Proto())
+	require.NoError(nselp.LoadProto(actPb))
 
 	require.Equal(selp.Hash(), nselp.Hash())
 }
+
+func TestActionSecp256k1(t *testing.T) {
+	require := require.New(t)
+	pk, sk, err := crypto.Secp256k1.NewKeyPair()
+	require.NoError(err)
+	sender, err := iotxaddress.GetAddressByPubkey(iotxaddress.IsTestnet, chainid, pk)
+	require.NoError(err)
+	recipient, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainid)
+	require.NoError(err)
+	tsf, err := NewTransfer(0, big.NewInt(10), sender.RawAddress, recipient.RawAddress, nil, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetDestinationAddress(recipient.RawAddress).
+		SetGasPrice(big.NewInt(10)).
+		SetGasLimit(uint64(100000)).
+		SetAction(tsf).Build()
+
+	selp, err := Sign(elp, sender.RawAddress, sk)
+	require.NoError(err)
+	require.Equal(keypair.Secp256k1, selp.SrcKeyType())
+	require.NoError(Verify(selp))
+
+	actPb, err := selp.Proto()
+	require.NoError(err)
+	nselp := &SealedEnvelope{}
+	require.NoError(nselp.LoadProto(actPb))
+	require.Equal(selp.Hash(), nselp.Hash())
+	require.Equal(keypair.Secp256k1, nselp.SrcKeyType())
+	require.NoError(Verify(*nselp))
+
+	// an ec283 signature doesn't verify against a secp256k1 key
+	ec283Selp, err := Sign(elp, recipient.RawAddress, recipient.PrivateKey)
+	require.NoError(err)
+	require.Equal(keypair.EC283, ec283Selp.SrcKeyType())
+	require.NoError(Verify(ec283Selp))
+	forged := AssembleSealedEnvelope(elp, sender.RawAddress, pk, ec283Selp.Signature())
+	require.Error(Verify(forged))
+}
@@ -0,0 +1,203 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+func init() {
+	mustRegister("batch", &codecFuncs{
+		newPayload: func() Payload { return &Batch{} },
+		oneof:      &iproto.ActionPb_Batch{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			batchPb, err := act.(*Batch).Proto()
+			if err != nil {
+				return err
+			}
+			pbAct.Action = &iproto.ActionPb_Batch{Batch: batchPb}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &Batch{}
+			if err := act.LoadProto(pbAct.GetBatch()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+}
+
+// MaxBatchSize is the maximum number of payloads in a batch
+const MaxBatchSize = 64
+
+// ErrBatch indicates the error of batch
+var ErrBatch = errors.New("invalid batch")
+
+type dstAddrSetter interface {
+	DstAddr() string
+	setDstAddr(string)
+}
+
+type gasLimitSetter interface {
+	setGasLimit(uint64)
+}
+
+// Batch groups several action payloads under one envelope, which share the nonce and signature of the envelope and
+// are executed atomically: if any of them fails, the changes of the whole batch are reverted
+type Batch struct {
+	AbstractAction
+
+	payloads []Payload
+}
+
+// NewBatch returns a Batch of the payloads, each of which keeps its own destination address
+func NewBatch(payloads ...Payload) (*Batch, error) {
+	if len(payloads) == 0 || len(payloads) > MaxBatchSize {
+		return nil, errors.Wrapf(ErrBatch, "batch size %d is not in (0, %d]", len(payloads), MaxBatchSize)
+	}
+	for _, payload := range payloads {
+		if err := validateBatchPayload(payload); err != nil {
+			return nil, err
+		}
+	}
+	return &Batch{payloads: payloads}, nil
+}
+
+// Payloads returns the payloads in the batch
+func (b *Batch) Payloads() []Payload { return b.payloads }
+
+// ByteStream returns a raw byte stream of this batch
+func (b *Batch) ByteStream() []byte {
+	batchPb, err := b.Proto()
+	if err != nil {
+		panic(err)
+	}
+	return byteutil.Must(proto.Marshal(batchPb))
+}
+
+// Proto converts Batch to protobuf's BatchPb
+func (b *Batch) Proto() (*iproto.BatchPb, error) {
+	actions := make([]*iproto.ActionPb, 0, len(b.payloads))
+	for _, payload := range b.payloads {
+		actPb := &iproto.ActionPb{}
+		codec, err := codecOf(payload)
+		if err != nil {
+			return nil, err
+		}
+		if err := codec.Encode(payload, actPb); err != nil {
+			return nil, errors.Wrapf(err, "failed to convert payload %T of the batch", payload)
+		}
+		actions = append(actions, actPb)
+	}
+	return &iproto.BatchPb{Actions: actions}, nil
+}
+
+// LoadProto converts a protobuf's BatchPb to Batch
+func (b *Batch) LoadProto(pbAct *iproto.BatchPb) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if b == nil {
+		return errors.New("nil action to load proto")
+	}
+	*b = Batch{}
+
+	if len(pbAct.Actions) == 0 || len(pbAct.Actions) > MaxBatchSize {
+		return errors.Wrapf(ErrBatch, "batch size %d is not in (0, %d]", len(pbAct.Actions), MaxBatchSize)
+	}
+	for _, actPb := range pbAct.Actions {
+		codec := matchCodec(actPb)
+		if codec == nil {
+			return errors.Wrap(ErrBatch, "no appliable action to handle in batch")
+		}
+		payload, dstAddr, err := codec.Decode(actPb)
+		if err != nil {
+			return err
+		}
+		if err := validateBatchPayload(payload); err != nil {
+			return err
+		}
+		payload.(dstAddrSetter).setDstAddr(dstAddr)
+		b.payloads = append(b.payloads, payload)
+	}
+	return nil
+}
+
+// SetEnvelopeContext sets the envelope context to the batch and its payloads. The payloads share the envelope except
+// for their own destination addresses.
+func (b *Batch) SetEnvelopeContext(selp SealedEnvelope) {
+	if b == nil {
+		return
+	}
+	b.AbstractAction.SetEnvelopeContext(selp)
+	for _, payload := range b.payloads {
+		inner := selp
+		inner.dstAddr = payload.(dstAddrSetter).DstAddr()
+		payload.SetEnvelopeContext(inner)
+	}
+}
+
+// LimitPayloadGas sets the gas limit of the i-th payload, which is the remaining gas budget of the batch when the
+// payload runs
+func (b *Batch) LimitPayloadGas(i int, gasLimit uint64) {
+	b.payloads[i].(gasLimitSetter).setGasLimit(gasLimit)
+}
+
+// IntrinsicGas returns the sum of the intrinsic gas of the payloads
+func (b *Batch) IntrinsicGas() (uint64, error) {
+	var total uint64
+	for _, payload := range b.payloads {
+		gas, err := payload.IntrinsicGas()
+		if err != nil {
+			return 0, err
+		}
+		if math.MaxUint64-total < gas {
+			return 0, ErrOutOfGas
+		}
+		total += gas
+	}
+	return total, nil
+}
+
+// Cost returns the sum of the cost of the payloads
+func (b *Batch) Cost() (*big.Int, error) {
+	total := big.NewInt(0)
+	for _, payload := range b.payloads {
+		cost, err := payload.Cost()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get cost of the payload in batch")
+		}
+		total.Add(total, cost)
+	}
+	return total, nil
+}
+
+func validateBatchPayload(payload Payload) error {
+	switch act := payload.(type) {
+	case *Batch:
+		return errors.Wrap(ErrBatch, "nested batch is not allowed")
+	case *Transfer:
+		if act.IsCoinbase() {
+			return errors.Wrap(ErrBatch, "coinbase transfer is not allowed in batch")
+		}
+	}
+	if _, ok := payload.(dstAddrSetter); !ok {
+		return errors.Wrapf(ErrBatch, "payload type %T does not embed AbstractAction", payload)
+	}
+	if _, err := codecOf(payload); err != nil {
+		return errors.Wrap(ErrBatch, err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/iotxaddress"
+)
+
+func TestBatch(t *testing.T) {
+	require := require.New(t)
+	sender, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainid)
+	require.NoError(err)
+	recipient1, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainid)
+	require.NoError(err)
+	recipient2, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainid)
+	require.NoError(err)
+
+	_, err = NewBatch()
+	require.Equal(ErrBatch, errors.Cause(err))
+	_, err = NewBatch(NewCoinBaseTransfer(1, big.NewInt(10), recipient1.RawAddress))
+	require.Equal(ErrBatch, errors.Cause(err))
+
+	tsf, err := NewTransfer(1, big.NewInt(10), sender.RawAddress, recipient1.RawAddress, nil, 0, big.NewInt(0))
+	require.NoError(err)
+	exec, err := NewExecution(sender.RawAddress, recipient2.RawAddress, 1, big.NewInt(20), 0, big.NewInt(0), []byte{0x01})
+	require.NoError(err)
+	batch, err := NewBatch(tsf, exec)
+	require.NoError(err)
+	_, err = NewBatch(batch)
+	require.Equal(ErrBatch, errors.Cause(err))
+
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).
+		SetGasPrice(big.NewInt(10)).
+		SetGasLimit(uint64(100000)).
+		SetAction(batch).Build()
+	selp, err := Sign(elp, sender.RawAddress, sender.PrivateKey)
+	require.NoError(err)
+	require.NoError(Verify(selp))
+
+	gas, err := batch.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(TransferBaseIntrinsicGas+ExecutionBaseIntrinsicGas+ExecutionDataGas, gas)
+	cost, err := batch.Cost()
+	require.NoError(err)
+	// execution is charged by the gas limit of the envelope
+	require.Equal(big.NewInt(30+int64(TransferBaseIntrinsicGas+100000)*10), cost)
+
+	nselp := &SealedEnvelope{}
+	actPb, err := selp.Proto()
+	require.NoError(err)
+	require.NoError(nselp.LoadProto(actPb))
+	require.Equal(selp.Hash(), nselp.Hash())
+	require.NoError(Verify(*nselp))
+	payloads := nselp.Action().(*Batch).Payloads()
+	require.Equal(2, len(payloads))
+	require.Equal(recipient1.RawAddress, payloads[0].(*Transfer).Recipient())
+	require.Equal(sender.RawAddress, payloads[0].(*Transfer).Sender())
+	require.Equal(uint64(1), payloads[0].(*Transfer).Nonce())
+	require.Equal(recipient2.RawAddress, payloads[1].(*Execution).Contract())
+	require.Equal(big.NewInt(20), payloads[1].(*Execution).Amount())
+	require.Equal(uint64(100000), payloads[1].(*Execution).GasLimit())
+	nselp.Action().(*Batch).LimitPayloadGas(1, 90000)
+	require.Equal(uint64(90000), payloads[1].(*Execution).GasLimit())
+	require.Equal(selp.Hash(), payloads[1].(*Execution).Hash())
+}
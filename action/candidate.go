@@ -0,0 +1,332 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/keypair"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+func init() {
+	mustRegister("registerCandidate", &codecFuncs{
+		newPayload: func() Payload { return &RegisterCandidate{} },
+		oneof:      &iproto.ActionPb_RegisterCandidate{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_RegisterCandidate{RegisterCandidate: act.(*RegisterCandidate).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &RegisterCandidate{}
+			if err := act.LoadProto(pbAct.GetRegisterCandidate()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+	mustRegister("updateCandidate", &codecFuncs{
+		newPayload: func() Payload { return &UpdateCandidate{} },
+		oneof:      &iproto.ActionPb_UpdateCandidate{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_UpdateCandidate{UpdateCandidate: act.(*UpdateCandidate).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &UpdateCandidate{}
+			if err := act.LoadProto(pbAct.GetUpdateCandidate()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+	mustRegister("unregisterCandidate", &codecFuncs{
+		newPayload: func() Payload { return &UnregisterCandidate{} },
+		oneof:      &iproto.ActionPb_UnregisterCandidate{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_UnregisterCandidate{UnregisterCandidate: act.(*UnregisterCandidate).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &UnregisterCandidate{}
+			if err := act.LoadProto(pbAct.GetUnregisterCandidate()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+}
+
+const (
+	// RegisterCandidateIntrinsicGas represents the intrinsic gas for the candidate registration action
+	RegisterCandidateIntrinsicGas = uint64(10000)
+	// UpdateCandidateIntrinsicGas represents the intrinsic gas for the candidate update action
+	UpdateCandidateIntrinsicGas = uint64(10000)
+	// UnregisterCandidateIntrinsicGas represents the intrinsic gas for the candidate unregistration action
+	UnregisterCandidateIntrinsicGas = uint64(10000)
+)
+
+// CandidateMetadata is the metadata of a candidate set by the candidate registration and update actions
+type CandidateMetadata struct {
+	name         string
+	operatorAddr string
+	rewardAddr   string
+	pubKey       keypair.PublicKey
+}
+
+// NewCandidateMetadata instantiates the metadata of a candidate
+func NewCandidateMetadata(
+	name string,
+	operatorAddr string,
+	rewardAddr string,
+	pubKey keypair.PublicKey,
+) CandidateMetadata {
+	return CandidateMetadata{
+		name:         name,
+		operatorAddr: operatorAddr,
+		rewardAddr:   rewardAddr,
+		pubKey:       pubKey,
+	}
+}
+
+// Name returns the name of the candidate
+func (cm *CandidateMetadata) Name() string { return cm.name }
+
+// OperatorAddress returns the address of the operator running the node of the candidate
+func (cm *CandidateMetadata) OperatorAddress() string { return cm.operatorAddr }
+
+// RewardAddress returns the address receiving the rewards of the candidate
+func (cm *CandidateMetadata) RewardAddress() string { return cm.rewardAddr }
+
+// PublicKey returns the public key which the candidate produces blocks with
+func (cm *CandidateMetadata) PublicKey() keypair.PublicKey { return cm.pubKey }
+
+// RegisterCandidate represents the action to register the sender as a candidate with the metadata, which locks the
+// self-stake from the balance of the sender until it's unregistered
+type RegisterCandidate struct {
+	AbstractAction
+	CandidateMetadata
+
+	selfStake *big.Int
+}
+
+// NewRegisterCandidate instantiates a candidate registration action struct
+func NewRegisterCandidate(
+	nonce uint64,
+	candidate string,
+	metadata CandidateMetadata,
+	selfStake *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) *RegisterCandidate {
+	return &RegisterCandidate{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  candidate,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		CandidateMetadata: metadata,
+		selfStake:         selfStake,
+	}
+}
+
+// Candidate returns the address of the candidate. It's the wrapper of Action.SrcAddr
+func (rc *RegisterCandidate) Candidate() string { return rc.SrcAddr() }
+
+// SelfStake returns the amount to lock as the self-stake
+func (rc *RegisterCandidate) SelfStake() *big.Int { return rc.selfStake }
+
+// ByteStream returns a raw byte stream of the candidate registration action
+func (rc *RegisterCandidate) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(rc.Proto()))
+}
+
+// Proto converts RegisterCandidate to protobuf's ActionPb
+func (rc *RegisterCandidate) Proto() *iproto.RegisterCandidatePb {
+	pbRC := &iproto.RegisterCandidatePb{
+		Name:            rc.name,
+		OperatorAddress: rc.operatorAddr,
+		RewardAddress:   rc.rewardAddr,
+		PubKey:          rc.pubKey[:],
+	}
+	if rc.selfStake != nil {
+		pbRC.SelfStake = rc.selfStake.Bytes()
+	}
+	return pbRC
+}
+
+// LoadProto converts a protobuf's ActionPb to RegisterCandidate
+func (rc *RegisterCandidate) LoadProto(pbRC *iproto.RegisterCandidatePb) error {
+	if rc == nil {
+		return errors.New("nil action to load proto")
+	}
+	*rc = RegisterCandidate{}
+
+	if pbRC == nil {
+		return errors.New("empty action proto to load")
+	}
+	rc.name = pbRC.Name
+	rc.operatorAddr = pbRC.OperatorAddress
+	rc.rewardAddr = pbRC.RewardAddress
+	copy(rc.pubKey[:], pbRC.PubKey)
+	rc.selfStake = big.NewInt(0).SetBytes(pbRC.SelfStake)
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a candidate registration
+func (rc *RegisterCandidate) IntrinsicGas() (uint64, error) {
+	return RegisterCandidateIntrinsicGas, nil
+}
+
+// Cost returns the total cost of a candidate registration
+func (rc *RegisterCandidate) Cost() (*big.Int, error) {
+	intrinsicGas, err := rc.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the candidate registration")
+	}
+	fee := big.NewInt(0).Mul(rc.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return fee.Add(fee, rc.SelfStake()), nil
+}
+
+// UpdateCandidate represents the action to replace the metadata of the candidate registered by the sender
+type UpdateCandidate struct {
+	AbstractAction
+	CandidateMetadata
+}
+
+// NewUpdateCandidate instantiates a candidate update action struct
+func NewUpdateCandidate(
+	nonce uint64,
+	candidate string,
+	metadata CandidateMetadata,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) *UpdateCandidate {
+	return &UpdateCandidate{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  candidate,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		CandidateMetadata: metadata,
+	}
+}
+
+// Candidate returns the address of the candidate. It's the wrapper of Action.SrcAddr
+func (uc *UpdateCandidate) Candidate() string { return uc.SrcAddr() }
+
+// ByteStream returns a raw byte stream of the candidate update action
+func (uc *UpdateCandidate) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(uc.Proto()))
+}
+
+// Proto converts UpdateCandidate to protobuf's ActionPb
+func (uc *UpdateCandidate) Proto() *iproto.UpdateCandidatePb {
+	return &iproto.UpdateCandidatePb{
+		Name:            uc.name,
+		OperatorAddress: uc.operatorAddr,
+		RewardAddress:   uc.rewardAddr,
+		PubKey:          uc.pubKey[:],
+	}
+}
+
+// LoadProto converts a protobuf's ActionPb to UpdateCandidate
+func (uc *UpdateCandidate) LoadProto(pbUC *iproto.UpdateCandidatePb) error {
+	if uc == nil {
+		return errors.New("nil action to load proto")
+	}
+	*uc = UpdateCandidate{}
+
+	if pbUC == nil {
+		return errors.New("empty action proto to load")
+	}
+	uc.name = pbUC.Name
+	uc.operatorAddr = pbUC.OperatorAddress
+	uc.rewardAddr = pbUC.RewardAddress
+	copy(uc.pubKey[:], pbUC.PubKey)
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a candidate update
+func (uc *UpdateCandidate) IntrinsicGas() (uint64, error) { return UpdateCandidateIntrinsicGas, nil }
+
+// Cost returns the total cost of a candidate update
+func (uc *UpdateCandidate) Cost() (*big.Int, error) {
+	intrinsicGas, err := uc.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the candidate update")
+	}
+	return big.NewInt(0).Mul(uc.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
+
+// UnregisterCandidate represents the action to unregister the candidate registered by the sender, which unlocks its
+// self-stake back to the balance
+type UnregisterCandidate struct {
+	AbstractAction
+}
+
+// NewUnregisterCandidate instantiates a candidate unregistration action struct
+func NewUnregisterCandidate(nonce uint64, candidate string, gasLimit uint64, gasPrice *big.Int) *UnregisterCandidate {
+	return &UnregisterCandidate{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  candidate,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+	}
+}
+
+// Candidate returns the address of the candidate. It's the wrapper of Action.SrcAddr
+func (urc *UnregisterCandidate) Candidate() string { return urc.SrcAddr() }
+
+// ByteStream returns a raw byte stream of the candidate unregistration action
+func (urc *UnregisterCandidate) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(urc.Proto()))
+}
+
+// Proto converts UnregisterCandidate to protobuf's ActionPb
+func (urc *UnregisterCandidate) Proto() *iproto.UnregisterCandidatePb {
+	return &iproto.UnregisterCandidatePb{}
+}
+
+// LoadProto converts a protobuf's ActionPb to UnregisterCandidate
+func (urc *UnregisterCandidate) LoadProto(pbURC *iproto.UnregisterCandidatePb) error {
+	if urc == nil {
+		return errors.New("nil action to load proto")
+	}
+	*urc = UnregisterCandidate{}
+
+	if pbURC == nil {
+		return errors.New("empty action proto to load")
+	}
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a candidate unregistration
+func (urc *UnregisterCandidate) IntrinsicGas() (uint64, error) {
+	return UnregisterCandidateIntrinsicGas, nil
+}
+
+// Cost returns the total cost of a candidate unregistration
+func (urc *UnregisterCandidate) Cost() (*big.Int, error) {
+	intrinsicGas, err := urc.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the candidate unregistration")
+	}
+	return big.NewInt(0).Mul(urc.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestCandidateProto(t *testing.T) {
+	t.Parallel()
+
+	candidate := testaddress.IotxAddrinfo["producer"]
+	operator := testaddress.IotxAddrinfo["alfa"].RawAddress
+	reward := testaddress.IotxAddrinfo["bravo"].RawAddress
+	metadata := NewCandidateMetadata("producer", operator, reward, candidate.PublicKey)
+
+	rc1 := NewRegisterCandidate(1, candidate.RawAddress, metadata, big.NewInt(1000), 10, big.NewInt(100))
+	assert.Equal(t, candidate.RawAddress, rc1.Candidate())
+	cost, err := rc1.Cost()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000+int64(RegisterCandidateIntrinsicGas)*100), cost)
+
+	// Round trip through a sealed envelope
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetGasLimit(10).SetGasPrice(big.NewInt(100)).SetAction(rc1).Build()
+	selp, err := Sign(elp, candidate.RawAddress, candidate.PrivateKey)
+	require.NoError(t, err)
+	var selp2 SealedEnvelope
+	actPb, err := selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	rc2, ok := selp2.Action().(*RegisterCandidate)
+	require.True(t, ok)
+	assert.Equal(t, "producer", rc2.Name())
+	assert.Equal(t, operator, rc2.OperatorAddress())
+	assert.Equal(t, reward, rc2.RewardAddress())
+	assert.Equal(t, candidate.PublicKey, rc2.PublicKey())
+	assert.Equal(t, big.NewInt(1000), rc2.SelfStake())
+	kind, ok := KindOf(rc2)
+	require.True(t, ok)
+	assert.Equal(t, "registerCandidate", kind)
+
+	metadata = NewCandidateMetadata("renamed", reward, operator, candidate.PublicKey)
+	elp = bd.SetNonce(2).SetAction(NewUpdateCandidate(2, candidate.RawAddress, metadata, 10, big.NewInt(100))).Build()
+	selp, err = Sign(elp, candidate.RawAddress, candidate.PrivateKey)
+	require.NoError(t, err)
+	actPb, err = selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	uc, ok := selp2.Action().(*UpdateCandidate)
+	require.True(t, ok)
+	assert.Equal(t, "renamed", uc.Name())
+	assert.Equal(t, reward, uc.OperatorAddress())
+	assert.Equal(t, operator, uc.RewardAddress())
+	assert.Equal(t, candidate.PublicKey, uc.PublicKey())
+
+	elp = bd.SetNonce(3).SetAction(NewUnregisterCandidate(3, candidate.RawAddress, 10, big.NewInt(100))).Build()
+	selp, err = Sign(elp, candidate.RawAddress, candidate.PrivateKey)
+	require.NoError(t, err)
+	actPb, err = selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	urc, ok := selp2.Action().(*UnregisterCandidate)
+	require.True(t, ok)
+	assert.Equal(t, candidate.RawAddress, urc.Candidate())
+}
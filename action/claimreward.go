@@ -0,0 +1,115 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+func init() {
+	mustRegister("claimReward", &codecFuncs{
+		newPayload: func() Payload { return &ClaimReward{} },
+		oneof:      &iproto.ActionPb_ClaimReward{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_ClaimReward{ClaimReward: act.(*ClaimReward).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &ClaimReward{}
+			if err := act.LoadProto(pbAct.GetClaimReward()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+}
+
+const (
+	// ClaimRewardIntrinsicGas represents the intrinsic gas for the reward claim action
+	ClaimRewardIntrinsicGas = uint64(10000)
+)
+
+// ClaimReward represents the action to pay out the amount from the unclaimed rewards of the sender. If the unclaimed
+// rewards are less than the amount, all of them are paid out.
+type ClaimReward struct {
+	AbstractAction
+
+	amount *big.Int
+}
+
+// NewClaimReward instantiates a reward claim action struct
+func NewClaimReward(
+	nonce uint64,
+	claimer string,
+	amount *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) *ClaimReward {
+	return &ClaimReward{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  claimer,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		amount: amount,
+	}
+}
+
+// Claimer returns the claimer address. It's the wrapper of Action.SrcAddr
+func (cr *ClaimReward) Claimer() string { return cr.SrcAddr() }
+
+// Amount returns the amount to claim
+func (cr *ClaimReward) Amount() *big.Int { return cr.amount }
+
+// ByteStream returns a raw byte stream of the reward claim action
+func (cr *ClaimReward) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(cr.Proto()))
+}
+
+// Proto converts ClaimReward to protobuf's ActionPb
+func (cr *ClaimReward) Proto() *iproto.ClaimRewardPb {
+	pbCR := &iproto.ClaimRewardPb{}
+	if cr.amount != nil {
+		pbCR.Amount = cr.amount.Bytes()
+	}
+	return pbCR
+}
+
+// LoadProto converts a protobuf's ActionPb to ClaimReward
+func (cr *ClaimReward) LoadProto(pbCR *iproto.ClaimRewardPb) error {
+	if cr == nil {
+		return errors.New("nil action to load proto")
+	}
+	*cr = ClaimReward{}
+
+	if pbCR == nil {
+		return errors.New("empty action proto to load")
+	}
+	cr.amount = big.NewInt(0).SetBytes(pbCR.Amount)
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a reward claim
+func (cr *ClaimReward) IntrinsicGas() (uint64, error) { return ClaimRewardIntrinsicGas, nil }
+
+// Cost returns the total cost of a reward claim
+func (cr *ClaimReward) Cost() (*big.Int, error) {
+	intrinsicGas, err := cr.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the reward claim")
+	}
+	return big.NewInt(0).Mul(cr.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
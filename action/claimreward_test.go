@@ -0,0 +1,51 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestClaimRewardProto(t *testing.T) {
+	t.Parallel()
+
+	claimer := testaddress.IotxAddrinfo["producer"].RawAddress
+
+	cr1 := NewClaimReward(1, claimer, big.NewInt(1000), 10, big.NewInt(100))
+	assert.Equal(t, claimer, cr1.Claimer())
+	assert.Equal(t, big.NewInt(1000), cr1.Amount())
+	cost, err := cr1.Cost()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(int64(ClaimRewardIntrinsicGas)*100), cost)
+
+	var cr2 ClaimReward
+	require.NoError(t, cr2.LoadProto(cr1.Proto()))
+	assert.Equal(t, big.NewInt(1000), cr2.Amount())
+
+	// Round trip through a sealed envelope
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetGasLimit(10).SetGasPrice(big.NewInt(100)).SetAction(cr1).Build()
+	selp, err := Sign(elp, claimer, testaddress.IotxAddrinfo["producer"].PrivateKey)
+	require.NoError(t, err)
+	var selp2 SealedEnvelope
+	actPb, err := selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	cr3, ok := selp2.Action().(*ClaimReward)
+	require.True(t, ok)
+	assert.Equal(t, claimer, cr3.Claimer())
+	assert.Equal(t, big.NewInt(1000), cr3.Amount())
+	kind, ok := KindOf(cr3)
+	require.True(t, ok)
+	assert.Equal(t, "claimReward", kind)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+func init() {
+	mustRegister("claimTimelock", &codecFuncs{
+		newPayload: func() Payload { return &ClaimTimelock{} },
+		oneof:      &iproto.ActionPb_ClaimTimelock{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_ClaimTimelock{ClaimTimelock: act.(*ClaimTimelock).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &ClaimTimelock{}
+			if err := act.LoadProto(pbAct.GetClaimTimelock()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+}
+
+const (
+	// ClaimTimelockIntrinsicGas represents the intrinsic gas for the time lock claim action
+	ClaimTimelockIntrinsicGas = uint64(10000)
+)
+
+// ClaimTimelock represents the action to pay out all the time-locked transfers to the sender, which are released at
+// the height and time of the block including the action
+type ClaimTimelock struct {
+	AbstractAction
+}
+
+// NewClaimTimelock instantiates a time lock claim action struct
+func NewClaimTimelock(
+	nonce uint64,
+	claimer string,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) *ClaimTimelock {
+	return &ClaimTimelock{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  claimer,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+	}
+}
+
+// Claimer returns the claimer address. It's the wrapper of Action.SrcAddr
+func (ct *ClaimTimelock) Claimer() string { return ct.SrcAddr() }
+
+// ByteStream returns a raw byte stream of the time lock claim action
+func (ct *ClaimTimelock) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(ct.Proto()))
+}
+
+// Proto converts ClaimTimelock to protobuf's ActionPb
+func (ct *ClaimTimelock) Proto() *iproto.ClaimTimelockPb {
+	return &iproto.ClaimTimelockPb{}
+}
+
+// LoadProto converts a protobuf's ActionPb to ClaimTimelock
+func (ct *ClaimTimelock) LoadProto(pbCT *iproto.ClaimTimelockPb) error {
+	if ct == nil {
+		return errors.New("nil action to load proto")
+	}
+	*ct = ClaimTimelock{}
+
+	if pbCT == nil {
+		return errors.New("empty action proto to load")
+	}
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a time lock claim
+func (ct *ClaimTimelock) IntrinsicGas() (uint64, error) { return ClaimTimelockIntrinsicGas, nil }
+
+// Cost returns the total cost of a time lock claim
+func (ct *ClaimTimelock) Cost() (*big.Int, error) {
+	intrinsicGas, err := ct.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the time lock claim")
+	}
+	return big.NewInt(0).Mul(ct.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
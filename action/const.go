@@ -19,6 +19,8 @@ var (
 	ErrOutOfGas = errors.New("Out of gas")
 	// ErrGasHigherThanLimit indicates the error of gas value
 	ErrGasHigherThanLimit = errors.New("invalid gas for action")
+	// ErrGasPriceLowerThanBaseFee indicates the error that the gas price of action doesn't cover the base fee
+	ErrGasPriceLowerThanBaseFee = errors.New("gas price lower than base fee")
 	// ErrTransfer indicates the error of transfer
 	ErrTransfer = errors.New("invalid transfer")
 	// ErrNonce indicates the error of nonce
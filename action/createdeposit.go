@@ -18,6 +18,24 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+func init() {
+	mustRegister("createDeposit", &codecFuncs{
+		newPayload: func() Payload { return &CreateDeposit{} },
+		oneof:      &iproto.ActionPb_CreateDeposit{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_CreateDeposit{CreateDeposit: act.(*CreateDeposit).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &CreateDeposit{}
+			if err := act.LoadProto(pbAct.GetCreateDeposit()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetCreateDeposit().Recipient, nil
+		},
+	})
+}
+
 const (
 	// CreateDepositIntrinsicGas represents the intrinsic gas for the deposit action
 	CreateDepositIntrinsicGas = uint64(10000)
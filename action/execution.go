@@ -19,6 +19,24 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+func init() {
+	mustRegister("execution", &codecFuncs{
+		newPayload: func() Payload { return &Execution{} },
+		oneof:      &iproto.ActionPb_Execution{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_Execution{Execution: act.(*Execution).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &Execution{}
+			if err := act.LoadProto(pbAct.GetExecution()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetExecution().Contract, nil
+		},
+	})
+}
+
 const (
 	// EmptyAddress is the empty string
 	EmptyAddress = ""
@@ -13,6 +13,13 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+const (
+	// FailureReceiptStatus is the status that an action failed
+	FailureReceiptStatus = uint64(0)
+	// SuccessReceiptStatus is the status that an action succeeded
+	SuccessReceiptStatus = uint64(1)
+)
+
 // Receipt represents the result of a contract
 type Receipt struct {
 	ReturnValue     []byte
@@ -0,0 +1,66 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+// MemoType is the type of a transfer memo
+type MemoType int32
+
+const (
+	// NoteMemo is a UTF-8 text note
+	NoteMemo MemoType = MemoType(iproto.MemoPb_NOTE)
+	// TagMemo is a destination tag, by which transfers are indexed and searchable
+	TagMemo MemoType = MemoType(iproto.MemoPb_TAG)
+	// EncryptedNoteMemo is a note encrypted to the recipient, which is opaque to the chain
+	EncryptedNoteMemo MemoType = MemoType(iproto.MemoPb_ENCRYPTED_NOTE)
+)
+
+// Memo is a typed memo attached to a transfer
+type Memo struct {
+	Type MemoType
+	Data []byte
+}
+
+// Size returns the size of the memo data
+func (m *Memo) Size() uint32 {
+	if m == nil {
+		return 0
+	}
+	return uint32(len(m.Data))
+}
+
+// Tag returns the destination tag if it's a tag memo
+func (m *Memo) Tag() (string, bool) {
+	if m == nil || m.Type != TagMemo {
+		return "", false
+	}
+	return string(m.Data), true
+}
+
+// Proto converts Memo to protobuf's MemoPb
+func (m *Memo) Proto() *iproto.MemoPb {
+	if m == nil {
+		return nil
+	}
+	return &iproto.MemoPb{
+		Type: iproto.MemoPb_MemoType(m.Type),
+		Data: m.Data,
+	}
+}
+
+func memoFromProto(pbMemo *iproto.MemoPb) *Memo {
+	if pbMemo == nil {
+		return nil
+	}
+	return &Memo{
+		Type: MemoType(pbMemo.GetType()),
+		Data: pbMemo.GetData(),
+	}
+}
@@ -0,0 +1,216 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+func init() {
+	mustRegister("submitProposal", &codecFuncs{
+		newPayload: func() Payload { return &SubmitProposal{} },
+		oneof:      &iproto.ActionPb_SubmitProposal{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_SubmitProposal{SubmitProposal: act.(*SubmitProposal).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &SubmitProposal{}
+			if err := act.LoadProto(pbAct.GetSubmitProposal()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+	mustRegister("voteProposal", &codecFuncs{
+		newPayload: func() Payload { return &VoteProposal{} },
+		oneof:      &iproto.ActionPb_VoteProposal{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_VoteProposal{VoteProposal: act.(*VoteProposal).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &VoteProposal{}
+			if err := act.LoadProto(pbAct.GetVoteProposal()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+}
+
+const (
+	// SubmitProposalIntrinsicGas represents the intrinsic gas for the proposal submission action
+	SubmitProposalIntrinsicGas = uint64(10000)
+	// VoteProposalIntrinsicGas represents the intrinsic gas for the proposal vote action
+	VoteProposalIntrinsicGas = uint64(10000)
+)
+
+// SubmitProposal represents the action of a candidate to propose changing a chain parameter to the value
+type SubmitProposal struct {
+	AbstractAction
+
+	parameter string
+	value     uint64
+}
+
+// NewSubmitProposal instantiates a proposal submission action struct
+func NewSubmitProposal(
+	nonce uint64,
+	proposer string,
+	parameter string,
+	value uint64,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) *SubmitProposal {
+	return &SubmitProposal{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  proposer,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		parameter: parameter,
+		value:     value,
+	}
+}
+
+// Proposer returns the proposer address. It's the wrapper of Action.SrcAddr
+func (sp *SubmitProposal) Proposer() string { return sp.SrcAddr() }
+
+// Parameter returns the name of the chain parameter to change
+func (sp *SubmitProposal) Parameter() string { return sp.parameter }
+
+// Value returns the proposed value of the chain parameter
+func (sp *SubmitProposal) Value() uint64 { return sp.value }
+
+// ByteStream returns a raw byte stream of the proposal submission action
+func (sp *SubmitProposal) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(sp.Proto()))
+}
+
+// Proto converts SubmitProposal to protobuf's ActionPb
+func (sp *SubmitProposal) Proto() *iproto.SubmitProposalPb {
+	return &iproto.SubmitProposalPb{
+		Parameter: sp.parameter,
+		Value:     sp.value,
+	}
+}
+
+// LoadProto converts a protobuf's ActionPb to SubmitProposal
+func (sp *SubmitProposal) LoadProto(pbSP *iproto.SubmitProposalPb) error {
+	if sp == nil {
+		return errors.New("nil action to load proto")
+	}
+	*sp = SubmitProposal{}
+
+	if pbSP == nil {
+		return errors.New("empty action proto to load")
+	}
+	sp.parameter = pbSP.Parameter
+	sp.value = pbSP.Value
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a proposal submission
+func (sp *SubmitProposal) IntrinsicGas() (uint64, error) { return SubmitProposalIntrinsicGas, nil }
+
+// Cost returns the total cost of a proposal submission
+func (sp *SubmitProposal) Cost() (*big.Int, error) {
+	intrinsicGas, err := sp.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the proposal submission")
+	}
+	return big.NewInt(0).Mul(sp.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
+
+// VoteProposal represents the action of a candidate to approve or reject a proposal within its voting period
+type VoteProposal struct {
+	AbstractAction
+
+	id      uint64
+	approve bool
+}
+
+// NewVoteProposal instantiates a proposal vote action struct
+func NewVoteProposal(
+	nonce uint64,
+	voter string,
+	id uint64,
+	approve bool,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) *VoteProposal {
+	return &VoteProposal{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  voter,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		id:      id,
+		approve: approve,
+	}
+}
+
+// Voter returns the voter address. It's the wrapper of Action.SrcAddr
+func (vp *VoteProposal) Voter() string { return vp.SrcAddr() }
+
+// ID returns the id of the proposal to vote on
+func (vp *VoteProposal) ID() uint64 { return vp.id }
+
+// Approve returns true if the vote approves the proposal
+func (vp *VoteProposal) Approve() bool { return vp.approve }
+
+// ByteStream returns a raw byte stream of the proposal vote action
+func (vp *VoteProposal) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(vp.Proto()))
+}
+
+// Proto converts VoteProposal to protobuf's ActionPb
+func (vp *VoteProposal) Proto() *iproto.VoteProposalPb {
+	return &iproto.VoteProposalPb{
+		Id:      vp.id,
+		Approve: vp.approve,
+	}
+}
+
+// LoadProto converts a protobuf's ActionPb to VoteProposal
+func (vp *VoteProposal) LoadProto(pbVP *iproto.VoteProposalPb) error {
+	if vp == nil {
+		return errors.New("nil action to load proto")
+	}
+	*vp = VoteProposal{}
+
+	if pbVP == nil {
+		return errors.New("empty action proto to load")
+	}
+	vp.id = pbVP.Id
+	vp.approve = pbVP.Approve
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a proposal vote
+func (vp *VoteProposal) IntrinsicGas() (uint64, error) { return VoteProposalIntrinsicGas, nil }
+
+// Cost returns the total cost of a proposal vote
+func (vp *VoteProposal) Cost() (*big.Int, error) {
+	intrinsicGas, err := vp.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the proposal vote")
+	}
+	return big.NewInt(0).Mul(vp.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestProposalProto(t *testing.T) {
+	t.Parallel()
+
+	proposer := testaddress.IotxAddrinfo["producer"]
+
+	sp1 := NewSubmitProposal(1, proposer.RawAddress, "numCandidates", 36, 10, big.NewInt(100))
+	assert.Equal(t, proposer.RawAddress, sp1.Proposer())
+	cost, err := sp1.Cost()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(int64(SubmitProposalIntrinsicGas)*100), cost)
+
+	// Round trip through a sealed envelope
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetGasLimit(10).SetGasPrice(big.NewInt(100)).SetAction(sp1).Build()
+	selp, err := Sign(elp, proposer.RawAddress, proposer.PrivateKey)
+	require.NoError(t, err)
+	var selp2 SealedEnvelope
+	actPb, err := selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	sp2, ok := selp2.Action().(*SubmitProposal)
+	require.True(t, ok)
+	assert.Equal(t, "numCandidates", sp2.Parameter())
+	assert.Equal(t, uint64(36), sp2.Value())
+	kind, ok := KindOf(sp2)
+	require.True(t, ok)
+	assert.Equal(t, "submitProposal", kind)
+
+	elp = bd.SetNonce(2).SetAction(NewVoteProposal(2, proposer.RawAddress, 3, true, 10, big.NewInt(100))).Build()
+	selp, err = Sign(elp, proposer.RawAddress, proposer.PrivateKey)
+	require.NoError(t, err)
+	actPb, err = selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	vp, ok := selp2.Action().(*VoteProposal)
+	require.True(t, ok)
+	assert.Equal(t, proposer.RawAddress, vp.Voter())
+	assert.Equal(t, uint64(3), vp.ID())
+	assert.True(t, vp.Approve())
+}
@@ -9,6 +9,7 @@ package account
 import (
 	"context"
 	"math/big"
+	"unicode/utf8"
 
 	"github.com/CoderZhi/go-ethereum/core/vm"
 	"github.com/pkg/errors"
@@ -16,12 +17,19 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/iotxaddress"
 	"github.com/iotexproject/iotex-core/state"
 )
 
-// TransferSizeLimit is the maximum size of transfer allowed
-const TransferSizeLimit = 32 * 1024
+const (
+	// TransferSizeLimit is the maximum size of transfer allowed
+	TransferSizeLimit = 32 * 1024
+	// MemoSizeLimit is the maximum size of the memo of a transfer
+	MemoSizeLimit = 512
+	// MemoTagSizeLimit is the maximum size of a destination tag memo
+	MemoTagSizeLimit = 64
+)
 
 // handleTransfer handles a transfer
 func (p *Protocol) handleTransfer(act action.Action, raCtx protocol.RunActionsCtx, sm protocol.StateManager) error {
@@ -38,6 +46,9 @@ func (p *Protocol) handleTransfer(act action.Action, raCtx protocol.RunActionsCt
 		if err != nil {
 			return errors.Wrapf(err, "failed to load or create the account of sender %s", tsf.Sender())
 		}
+		// the balance changes of sender and producer, which their votees' weights change by
+		spent := big.NewInt(0).Set(tsf.Amount())
+		compensated := big.NewInt(0)
 
 		if raCtx.EnableGasCharge {
 			// Load or create account for producer
@@ -53,7 +64,10 @@ func (p *Protocol) handleTransfer(act action.Action, raCtx protocol.RunActionsCt
 				return vm.ErrOutOfGas
 			}
 
-			gasFee := big.NewInt(0).Mul(tsf.GasPrice(), big.NewInt(0).SetUint64(gas))
+			gasFee, tip, err := GasFee(raCtx, tsf.GasPrice(), gas)
+			if err != nil {
+				return err
+			}
 			if big.NewInt(0).Add(tsf.Amount(), gasFee).Cmp(sender.Balance) == 1 {
 				return errors.Wrapf(state.ErrNotEnoughBalance, "failed to verify the Balance of sender %s", tsf.Sender())
 			}
@@ -62,10 +76,12 @@ func (p *Protocol) handleTransfer(act action.Action, raCtx protocol.RunActionsCt
 			if err := sender.SubBalance(gasFee); err != nil {
 				return errors.Wrapf(err, "failed to charge the gas for sender %s", tsf.Sender())
 			}
-			// compensate block producer gas
-			if err := producer.AddBalance(gasFee); err != nil {
+			spent.Add(spent, gasFee)
+			// compensate block producer the tip, while the base fee is burned
+			if err := producer.AddBalance(tip); err != nil {
 				return errors.Wrapf(err, "failed to compensate gas to producer")
 			}
+			compensated.Set(tip)
 			// Put updated producer's state to trie
 			if err := StoreAccount(sm, raCtx.ProducerAddr, producer); err != nil {
 				return errors.Wrap(err, "failed to update pending account changes to trie")
@@ -85,25 +101,18 @@ func (p *Protocol) handleTransfer(act action.Action, raCtx protocol.RunActionsCt
 		if err := StoreAccount(sm, tsf.Sender(), sender); err != nil {
 			return errors.Wrap(err, "failed to update pending account changes to trie")
 		}
+		if !raCtx.IsActive(genesis.VoteeWeightSync) {
+			// Before the votee weight sync, only the amount counted for the votee of sender
+			spent.Set(tsf.Amount())
+			compensated.SetInt64(0)
+		}
 		// Update sender votes
-		if len(sender.Votee) > 0 {
-			// sender already voted to a different person
-			voteeOfSender, err := LoadOrCreateAccount(sm, sender.Votee, big.NewInt(0))
-			if err != nil {
-				return errors.Wrapf(err, "failed to load or create the account of sender's votee %s", sender.Votee)
-			}
-			voteeOfSender.VotingWeight.Sub(voteeOfSender.VotingWeight, tsf.Amount())
-			// put updated state of sender's votee to trie
-			if err := StoreAccount(sm, sender.Votee, voteeOfSender); err != nil {
-				return errors.Wrap(err, "failed to update pending account changes to trie")
-			}
-
-			// Update candidate
-			if voteeOfSender.IsCandidate {
-				if err := candidatesutil.LoadAndUpdateCandidates(sm, sender.Votee, voteeOfSender.VotingWeight); err != nil {
-					return errors.Wrap(err, "failed to load and update candidates")
-				}
-			}
+		if err := candidatesutil.LoadAndUpdateVoteeWeight(sm, tsf.Sender(), big.NewInt(0).Neg(spent)); err != nil {
+			return errors.Wrap(err, "failed to update the weight of sender's votee")
+		}
+		// Update producer votes
+		if err := candidatesutil.LoadAndUpdateVoteeWeight(sm, raCtx.ProducerAddr, compensated); err != nil {
+			return errors.Wrap(err, "failed to update the weight of producer's votee")
 		}
 	}
 	// check recipient
@@ -119,23 +128,8 @@ func (p *Protocol) handleTransfer(act action.Action, raCtx protocol.RunActionsCt
 		return errors.Wrap(err, "failed to update pending account changes to trie")
 	}
 	// Update recipient votes
-	if len(recipient.Votee) > 0 {
-		// recipient already voted to a different person
-		voteeOfRecipient, err := LoadOrCreateAccount(sm, recipient.Votee, big.NewInt(0))
-		if err != nil {
-			return errors.Wrapf(err, "failed to load or create the account of recipient's votee %s", recipient.Votee)
-		}
-		voteeOfRecipient.VotingWeight.Add(voteeOfRecipient.VotingWeight, tsf.Amount())
-		// put updated state of recipient's votee to trie
-		if err := StoreAccount(sm, recipient.Votee, voteeOfRecipient); err != nil {
-			return errors.Wrap(err, "failed to update pending account changes to trie")
-		}
-
-		if voteeOfRecipient.IsCandidate {
-			if err := candidatesutil.LoadAndUpdateCandidates(sm, recipient.Votee, voteeOfRecipient.VotingWeight); err != nil {
-				return errors.Wrap(err, "failed to load and update candidates")
-			}
-		}
+	if err := candidatesutil.LoadAndUpdateVoteeWeight(sm, tsf.Recipient(), tsf.Amount()); err != nil {
+		return errors.Wrap(err, "failed to update the weight of recipient's votee")
 	}
 	return nil
 }
@@ -161,6 +155,10 @@ func (p *Protocol) validateTransfer(ctx context.Context, act action.Action) erro
 	if tsf.TotalSize() > TransferSizeLimit {
 		return errors.Wrap(action.ErrActPool, "oversized data")
 	}
+	// Reject malformed memo
+	if err := validateMemo(tsf.Memo()); err != nil {
+		return err
+	}
 	// Reject transfer of negative amount
 	if tsf.Amount().Sign() < 0 {
 		return errors.Wrap(action.ErrBalance, "negative value")
@@ -171,3 +169,36 @@ func (p *Protocol) validateTransfer(ctx context.Context, act action.Action) erro
 	}
 	return nil
 }
+
+// validateMemo validates the typed memo of a transfer
+func validateMemo(memo *action.Memo) error {
+	if memo == nil {
+		return nil
+	}
+	if memo.Size() > MemoSizeLimit {
+		return errors.Wrap(action.ErrTransfer, "oversized memo")
+	}
+	switch memo.Type {
+	case action.NoteMemo:
+		if !utf8.Valid(memo.Data) {
+			return errors.Wrap(action.ErrTransfer, "memo note is not valid UTF-8")
+		}
+	case action.TagMemo:
+		if len(memo.Data) == 0 || len(memo.Data) > MemoTagSizeLimit {
+			return errors.Wrapf(action.ErrTransfer, "memo tag size is not in [1, %d]", MemoTagSizeLimit)
+		}
+		// Tags are restricted to printable ASCII characters, so that they are safe to be indexed and searched
+		for _, c := range memo.Data {
+			if c < 0x21 || c > 0x7e {
+				return errors.Wrap(action.ErrTransfer, "memo tag contains non-printable character")
+			}
+		}
+	case action.EncryptedNoteMemo:
+		if len(memo.Data) == 0 {
+			return errors.Wrap(action.ErrTransfer, "empty encrypted memo note")
+		}
+	default:
+		return errors.Wrapf(action.ErrTransfer, "unknown memo type %d", memo.Type)
+	}
+	return nil
+}
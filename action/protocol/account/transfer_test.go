@@ -17,6 +17,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/iotxaddress"
 	"github.com/iotexproject/iotex-core/state"
@@ -95,6 +96,117 @@ func TestProtocol_HandleTransfer(t *testing.T) {
 	require.Equal("2", s4.VotingWeight.String())
 }
 
+func TestProtocol_HandleTransferWithBaseFee(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+
+	p := NewProtocol()
+	sender := testaddress.IotxAddrinfo["alfa"].RawAddress
+	recipient := testaddress.IotxAddrinfo["bravo"].RawAddress
+	producer := testaddress.IotxAddrinfo["producer"].RawAddress
+	pkHash, err := iotxaddress.AddressToPKHash(sender)
+	require.NoError(err)
+	require.NoError(ws.PutState(pkHash, &state.Account{Balance: big.NewInt(1000000), VotingWeight: big.NewInt(0)}))
+
+	gasLimit := uint64(1000000)
+	runCtx := func(height uint64) context.Context {
+		return protocol.WithRunActionsCtx(context.Background(),
+			protocol.RunActionsCtx{
+				BlockHeight:     height,
+				ProducerAddr:    producer,
+				GasLimit:        &gasLimit,
+				EnableGasCharge: true,
+				BaseFee:         big.NewInt(3),
+				ForkSchedule:    genesis.ForkSchedule{genesis.VoteeWeightSync: 2},
+			})
+	}
+	pkHash, err := iotxaddress.AddressToPKHash(votee)
+	require.NoError(err)
+	weightOfVotee := func() *big.Int {
+		var acct state.Account
+		require.NoError(sf.State(pkHash, &acct))
+		return acct.VotingWeight
+	}
+
+	// Before the votee weight sync, the gas doesn't count for the votee
+	tsf, err := action.NewTransfer(uint64(1), big.NewInt(2), sender, recipient, []byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	_, err = p.Handle(runCtx(1), tsf, ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	require.Equal(big.NewInt(1000000), weightOfVotee())
+
+	// Since then, only the burned base fee is gone from the weight of the votee
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	tsf, err = action.NewTransfer(uint64(2), big.NewInt(2), sender, recipient, []byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	_, err = p.Handle(runCtx(2), tsf, ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	require.Equal(big.NewInt(1000000-3*int64(action.TransferBaseIntrinsicGas)), weightOfVotee())
+}
+
+func TestProtocol_HandleTransferVoteeWeights(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+
+	p := NewProtocol()
+	sender := testaddress.IotxAddrinfo["alfa"].RawAddress
+	recipient := testaddress.IotxAddrinfo["bravo"].RawAddress
+	producer := testaddress.IotxAddrinfo["producer"].RawAddress
+	votee := testaddress.IotxAddrinfo["charlie"].RawAddress
+	putAccount := func(addr string, acct *state.Account) {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		require.NoError(ws.PutState(pkHash, acct))
+	}
+	// The sender, the recipient and the producer all vote for the same votee
+	putAccount(sender, &state.Account{Balance: big.NewInt(1000000), VotingWeight: big.NewInt(0), Votee: votee})
+	putAccount(recipient, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(0), Votee: votee})
+	putAccount(producer, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(0), Votee: votee})
+	putAccount(votee, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(1000000)})
+
+	gasLimit := uint64(1000000)
+	ctx = protocol.WithRunActionsCtx(context.Background(),
+		protocol.RunActionsCtx{
+			ProducerAddr:    producer,
+			GasLimit:        &gasLimit,
+			EnableGasCharge: true,
+			BaseFee:         big.NewInt(3),
+		})
+	tsf, err := action.NewTransfer(uint64(1), big.NewInt(2), sender, recipient, []byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	_, err = p.Handle(ctx, tsf, ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+
+	// Only the burned base fee is gone from the weight of the votee
+	pkHash, err := iotxaddress.AddressToPKHash(votee)
+	require.NoError(err)
+	var acct state.Account
+	require.NoError(sf.State(pkHash, &acct))
+	require.Equal(big.NewInt(1000000-3*int64(action.TransferBaseIntrinsicGas)), acct.VotingWeight)
+}
+
 func TestProtocol_ValidateTransfer(t *testing.T) {
 	require := require.New(t)
 	protocol := NewProtocol()
@@ -130,4 +242,18 @@ func TestProtocol_ValidateTransfer(t *testing.T) {
 	err = protocol.Validate(context.Background(), tsf)
 	require.Error(err)
 	require.True(strings.Contains(err.Error(), "error when validating recipient's address"))
+	// Case V: Malformed memo
+	for _, memo := range []*action.Memo{
+		{Type: action.NoteMemo, Data: []byte{0xff, 0xfe}},
+		{Type: action.NoteMemo, Data: make([]byte, MemoSizeLimit+1)},
+		{Type: action.TagMemo, Data: []byte{}},
+		{Type: action.TagMemo, Data: []byte("tag with space")},
+		{Type: action.EncryptedNoteMemo},
+		{Type: action.MemoType(100), Data: []byte("unknown")},
+	} {
+		tsf, err = action.NewTransferWithMemo(1, big.NewInt(1), "1", "2", nil, memo, uint64(100000), big.NewInt(0))
+		require.NoError(err)
+		err = protocol.Validate(context.Background(), tsf)
+		require.Equal(action.ErrTransfer, errors.Cause(err))
+	}
 }
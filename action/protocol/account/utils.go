@@ -6,7 +6,18 @@
 
 package account
 
-import "github.com/iotexproject/iotex-core/state"
+import (
+	"math/big"
+
+	"github.com/CoderZhi/go-ethereum/core/vm"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/state"
+)
 
 type noncer interface {
 	Nonce() uint64
@@ -18,3 +29,125 @@ func SetNonce(i noncer, state *state.Account) {
 		state.Nonce = i.Nonce()
 	}
 }
+
+// GasFee returns the fee of the gas at the gas price, and the tip compensated to the block producer out of it. The rest
+// of the fee, which is the base fee of the block for the gas, is burned.
+func GasFee(raCtx protocol.RunActionsCtx, gasPrice *big.Int, gas uint64) (*big.Int, *big.Int, error) {
+	baseFee := raCtx.BaseFee
+	if baseFee == nil {
+		baseFee = big.NewInt(0)
+	}
+	if gasPrice.Cmp(baseFee) < 0 {
+		return nil, nil, errors.Wrapf(
+			action.ErrGasPriceLowerThanBaseFee,
+			"gas price %s is lower than base fee %s",
+			gasPrice,
+			baseFee,
+		)
+	}
+	gasAmount := big.NewInt(0).SetUint64(gas)
+	fee := big.NewInt(0).Mul(gasPrice, gasAmount)
+	tip := big.NewInt(0).Mul(big.NewInt(0).Sub(gasPrice, baseFee), gasAmount)
+	return fee, tip, nil
+}
+
+// GasCharged is the action which the intrinsic gas is charged for
+type GasCharged interface {
+	Hash() hash.Hash32B
+	Nonce() uint64
+	GasPrice() *big.Int
+	IntrinsicGas() (uint64, error)
+}
+
+// ChargeGas charges the intrinsic gas of the action from the payer and compensates the tip to the block producer. The
+// payer's account is not stored, which is left to the caller. It returns the gas fee and the tip, which are zero if the
+// gas charge is disabled.
+func ChargeGas(
+	act GasCharged,
+	payerAddr string,
+	payer *state.Account,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*big.Int, *big.Int, error) {
+	if !raCtx.EnableGasCharge {
+		return big.NewInt(0), big.NewInt(0), nil
+	}
+	producer, err := LoadOrCreateAccount(sm, raCtx.ProducerAddr, big.NewInt(0))
+	if err != nil {
+		return nil, nil, errors.Wrapf(
+			err,
+			"failed to load or create the account of block producer %s",
+			raCtx.ProducerAddr,
+		)
+	}
+	gas, err := act.IntrinsicGas()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to get intrinsic gas for action hash %x", act.Hash())
+	}
+	if *raCtx.GasLimit < gas {
+		return nil, nil, vm.ErrOutOfGas
+	}
+	gasFee, tip, err := GasFee(raCtx, act.GasPrice(), gas)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := payer.SubBalance(gasFee); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to charge the gas for %s", payerAddr)
+	}
+	if err := producer.AddBalance(tip); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to compensate gas to producer")
+	}
+	if err := StoreAccount(sm, raCtx.ProducerAddr, producer); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	*raCtx.GasLimit -= gas
+	return gasFee, tip, nil
+}
+
+// UpdateVoteeWeights updates the weights of the votees of the payer and the block producer on their balance changes,
+// after their accounts are put into trie
+func UpdateVoteeWeights(
+	sm protocol.StateManager,
+	payerAddr string,
+	payerDelta *big.Int,
+	producerAddr string,
+	tip *big.Int,
+) error {
+	if err := candidatesutil.LoadAndUpdateVoteeWeight(sm, payerAddr, payerDelta); err != nil {
+		return errors.Wrapf(err, "failed to update the weight of the votee of %s", payerAddr)
+	}
+	if err := candidatesutil.LoadAndUpdateVoteeWeight(sm, producerAddr, tip); err != nil {
+		return errors.Wrapf(err, "failed to update the weight of the votee of %s", producerAddr)
+	}
+	return nil
+}
+
+// FailureReceipt settles the action which fails on the state after its intrinsic gas is charged from the payer by
+// ChargeGas, so that the action doesn't halt the block but still pays for the gas and consumes the nonce. The payer
+// must not be mutated by the action other than charging the gas.
+func FailureReceipt(
+	act GasCharged,
+	payerAddr string,
+	payer *state.Account,
+	gasFee *big.Int,
+	tip *big.Int,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	gas, err := act.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get intrinsic gas for action hash %x", act.Hash())
+	}
+	SetNonce(act, payer)
+	if err := StoreAccount(sm, payerAddr, payer); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if err := UpdateVoteeWeights(sm, payerAddr, big.NewInt(0).Neg(gasFee), raCtx.ProducerAddr, tip); err != nil {
+		return nil, err
+	}
+	return &action.Receipt{
+		Hash:        act.Hash(),
+		Status:      action.FailureReceiptStatus,
+		GasConsumed: gas,
+	}, nil
+}
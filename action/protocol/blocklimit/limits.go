@@ -0,0 +1,81 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocklimit
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+)
+
+// ErrBlockLimits indicates error for the block limits
+var ErrBlockLimits = errors.New("invalid block limits")
+
+// Limits defines the limits of a block
+type Limits struct {
+	// GasLimit is the limit of the total gas limit of the actions in a block
+	GasLimit uint64
+	// SizeLimit is the limit of the total size in bytes of the actions in a block
+	SizeLimit uint64
+}
+
+// DefaultLimits returns the block limits defined in genesis
+func DefaultLimits() Limits {
+	return Limits{
+		GasLimit:  genesis.BlockGasLimit,
+		SizeLimit: genesis.BlockSizeLimit,
+	}
+}
+
+// Verify checks whether the actions fit in a block. Coinbase transfers are not counted.
+func (l Limits) Verify(acts []action.SealedEnvelope) error {
+	var gasLimit, size uint64
+	for _, selp := range acts {
+		if tsf, ok := selp.Action().(*action.Transfer); ok && tsf.IsCoinbase() {
+			continue
+		}
+		gasLimit += selp.GasLimit()
+		if gasLimit < selp.GasLimit() || gasLimit > l.GasLimit {
+			return errors.Wrapf(ErrBlockLimits, "gas limit of actions exceeds block gas limit %d", l.GasLimit)
+		}
+		actSize, err := Size(selp)
+		if err != nil {
+			return err
+		}
+		size += actSize
+		if size > l.SizeLimit {
+			return errors.Wrapf(ErrBlockLimits, "size of actions exceeds block size limit %d", l.SizeLimit)
+		}
+	}
+	return nil
+}
+
+// Size returns the size in bytes of the action counted against the block size limit
+func Size(selp action.SealedEnvelope) (uint64, error) {
+	actPb, err := selp.Proto()
+	if err != nil {
+		return 0, err
+	}
+	return uint64(proto.Size(actPb)), nil
+}
+
+// Load returns the block limits at the height, which are governed by the governance protocol, and default to the ones
+// defined in genesis
+func Load(sr governance.StateReader, height uint64) (Limits, error) {
+	gasLimit, err := governance.Parameter(sr, governance.BlockGasLimit, height, genesis.BlockGasLimit)
+	if err != nil {
+		return Limits{}, errors.Wrap(err, "error when loading block gas limit")
+	}
+	sizeLimit, err := governance.Parameter(sr, governance.BlockSizeLimit, height, genesis.BlockSizeLimit)
+	if err != nil {
+		return Limits{}, errors.Wrap(err, "error when loading block size limit")
+	}
+	return Limits{GasLimit: gasLimit, SizeLimit: sizeLimit}, nil
+}
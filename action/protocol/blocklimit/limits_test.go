@@ -0,0 +1,92 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blocklimit
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestLimits(t *testing.T) {
+	require := require.New(t)
+
+	alfa := testaddress.IotxAddrinfo["alfa"]
+	bravo := testaddress.IotxAddrinfo["bravo"]
+	tsf1, err := testutil.SignedTransfer(alfa, bravo, 1, big.NewInt(1), nil, 400000, big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(alfa, bravo, 2, big.NewInt(1), nil, 600000, big.NewInt(1))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(alfa, bravo, 3, big.NewInt(1), nil, 1, big.NewInt(1))
+	require.NoError(err)
+	coinbase := action.NewCoinBaseTransfer(0, big.NewInt(10), alfa.RawAddress)
+	bd := &action.EnvelopeBuilder{}
+	elp := bd.SetNonce(0).SetDestinationAddress(alfa.RawAddress).SetGasLimit(genesis.ActionGasLimit).
+		SetAction(coinbase).Build()
+	selp := action.FakeSeal(elp, alfa.RawAddress, alfa.PublicKey)
+
+	limits := Limits{GasLimit: 1000000, SizeLimit: genesis.BlockSizeLimit}
+	require.NoError(limits.Verify([]action.SealedEnvelope{selp, tsf1, tsf2}))
+	err = limits.Verify([]action.SealedEnvelope{selp, tsf1, tsf2, tsf3})
+	require.Equal(ErrBlockLimits, errors.Cause(err))
+
+	size1, err := Size(tsf1)
+	require.NoError(err)
+	size2, err := Size(tsf2)
+	require.NoError(err)
+	limits = Limits{GasLimit: genesis.BlockGasLimit, SizeLimit: size1 + size2}
+	require.NoError(limits.Verify([]action.SealedEnvelope{selp, tsf1, tsf2}))
+	err = limits.Verify([]action.SealedEnvelope{selp, tsf1, tsf2, tsf3})
+	require.Equal(ErrBlockLimits, errors.Cause(err))
+}
+
+func TestLoad(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+
+	// Genesis limits are used until they are governed
+	limits, err := Load(sf, 1)
+	require.NoError(err)
+	require.Equal(DefaultLimits(), limits)
+
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	require.NoError(governance.SetGenesisParameters(ws, governance.Parameters{
+		governance.BlockGasLimit:  2 * genesis.BlockGasLimit,
+		governance.BlockSizeLimit: 1024,
+	}))
+	require.NoError(sf.Commit(ws))
+	limits, err = Load(sf, 1)
+	require.NoError(err)
+	require.Equal(Limits{GasLimit: 2 * genesis.BlockGasLimit, SizeLimit: 1024}, limits)
+
+	// The limits are validated as governance parameters
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	err = governance.SetGenesisParameters(ws, governance.Parameters{governance.BlockGasLimit: genesis.ActionGasLimit - 1})
+	require.Equal(governance.ErrProposal, errors.Cause(err))
+	err = governance.SetGenesisParameters(ws, governance.Parameters{governance.BlockSizeLimit: 0})
+	require.Equal(governance.ErrProposal, errors.Cause(err))
+}
@@ -8,8 +8,10 @@ package protocol
 
 import (
 	"context"
+	"math/big"
 	"sync"
 
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/keypair"
 )
@@ -34,6 +36,15 @@ type RunActionsCtx struct {
 	GasLimit *uint64
 	// whether disable gas charge
 	EnableGasCharge bool
+	// base fee per gas burned out of the gas fee, and only the rest is compensated to producer
+	BaseFee *big.Int
+	// heights since which the features changing the protocol behavior are active
+	ForkSchedule genesis.ForkSchedule
+}
+
+// IsActive returns true if the feature is active at the height of block containing those actions
+func (ra RunActionsCtx) IsActive(feature string) bool {
+	return ra.ForkSchedule.IsActive(feature, ra.BlockHeight)
 }
 
 // ValidateActionsCtx provides action validators with auxiliary information.
@@ -44,6 +55,13 @@ type ValidateActionsCtx struct {
 	BlockHeight uint64
 	// public key of producer who compose those actions
 	ProducerAddr string
+	// heights since which the features changing the protocol behavior are active
+	ForkSchedule genesis.ForkSchedule
+}
+
+// IsActive returns true if the feature is active at the height of block containing those actions
+func (va *ValidateActionsCtx) IsActive(feature string) bool {
+	return va.ForkSchedule.IsActive(feature, va.BlockHeight)
 }
 
 // WithRunActionsCtx add RunActionsCtx into context.
@@ -41,9 +41,9 @@ func MakeTransfer(db vm.StateDB, fromHash, toHash common.Address, amount *big.In
 
 const (
 	// FailureStatus is the status that contract execution failed
-	FailureStatus = uint64(0)
+	FailureStatus = action.FailureReceiptStatus
 	// SuccessStatus is the status that contract execution success
-	SuccessStatus = uint64(1)
+	SuccessStatus = action.SuccessReceiptStatus
 )
 
 // Params is the context and parameters
@@ -150,13 +150,92 @@ func ExecuteContract(
 	cm protocol.ChainManager,
 	gasLimit *uint64,
 	enableGasCharge bool,
+	baseFee *big.Int,
+	forkSchedule genesis.ForkSchedule,
 ) (*action.Receipt, error) {
+	return executeContract(
+		blkHeight,
+		blkHash,
+		producerPubKey,
+		blkTimeStamp,
+		sm,
+		execution,
+		cm,
+		gasLimit,
+		enableGasCharge,
+		baseFee,
+		forkSchedule,
+		nil,
+	)
+}
+
+// TraceContract processes a transfer which contains a contract as ExecuteContract does, and records the trace of it
+func TraceContract(
+	blkHeight uint64,
+	blkHash hash.Hash32B,
+	producerPubKey keypair.PublicKey,
+	blkTimeStamp int64,
+	sm protocol.StateManager,
+	execution *action.Execution,
+	cm protocol.ChainManager,
+	gasLimit *uint64,
+	enableGasCharge bool,
+	baseFee *big.Int,
+	forkSchedule genesis.ForkSchedule,
+) (*action.Receipt, *Trace, error) {
+	tracer := NewTracer(cm.ChainID())
+	receipt, err := executeContract(
+		blkHeight,
+		blkHash,
+		producerPubKey,
+		blkTimeStamp,
+		sm,
+		execution,
+		cm,
+		gasLimit,
+		enableGasCharge,
+		baseFee,
+		forkSchedule,
+		tracer,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	return receipt, tracer.Trace(), nil
+}
+
+func executeContract(
+	blkHeight uint64,
+	blkHash hash.Hash32B,
+	producerPubKey keypair.PublicKey,
+	blkTimeStamp int64,
+	sm protocol.StateManager,
+	execution *action.Execution,
+	cm protocol.ChainManager,
+	gasLimit *uint64,
+	enableGasCharge bool,
+	baseFee *big.Int,
+	forkSchedule genesis.ForkSchedule,
+	tracer vm.Tracer,
+) (*action.Receipt, error) {
+	if baseFee == nil {
+		baseFee = big.NewInt(0)
+	}
+	if enableGasCharge && execution.GasPrice().Cmp(baseFee) < 0 {
+		return nil, errors.Wrapf(
+			action.ErrGasPriceLowerThanBaseFee,
+			"gas price %s is lower than base fee %s",
+			execution.GasPrice(),
+			baseFee,
+		)
+	}
 	stateDB := NewStateDBAdapter(cm, sm, blkHeight, blkHash, execution.Hash())
+	stateDB.syncVoteeWeight = forkSchedule.IsActive(genesis.VoteeWeightSync, blkHeight)
 	ps, err := NewParams(blkHeight, producerPubKey, blkTimeStamp, execution, stateDB)
 	if err != nil {
 		return nil, err
 	}
-	retval, depositGas, remainingGas, contractAddress, err := executeInEVM(ps, stateDB, gasLimit)
+	retval, depositGas, remainingGas, contractAddress, err := executeInEVM(ps, stateDB, gasLimit, tracer)
 	if !enableGasCharge {
 		remainingGas = depositGas
 	}
@@ -178,7 +257,9 @@ func ExecuteContract(
 		stateDB.AddBalance(ps.context.Origin, remainingValue)
 	}
 	if depositGas-remainingGas > 0 {
-		gasValue := new(big.Int).Mul(new(big.Int).SetUint64(depositGas-remainingGas), ps.context.GasPrice)
+		// Only the tip is compensated to the producer, while the base fee is burned
+		tip := new(big.Int).Sub(ps.context.GasPrice, baseFee)
+		gasValue := new(big.Int).Mul(new(big.Int).SetUint64(depositGas-remainingGas), tip)
 		stateDB.AddBalance(ps.context.Coinbase, gasValue)
 	}
 
@@ -191,6 +272,32 @@ func ExecuteContract(
 	return receipt, err
 }
 
+// DeployContract puts the contract with the code and the storage at the address without executing its constructor,
+// which is used to set up the contracts in the genesis block
+func DeployContract(
+	sm protocol.StateManager,
+	addr hash.PKHash,
+	code []byte,
+	storage map[hash.Hash32B]hash.Hash32B,
+) error {
+	stateDB := NewStateDBAdapter(nil, sm, 0, hash.ZeroHash32B, hash.ZeroHash32B)
+	contract, err := stateDB.Contract(addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load contract %x", addr)
+	}
+	if len(contract.SelfState().CodeHash) > 0 {
+		return errors.Errorf("contract %x already exists", addr)
+	}
+	evmAddr := common.BytesToAddress(addr[:])
+	stateDB.SetCode(evmAddr, code)
+	for k, v := range storage {
+		if err := stateDB.setContractState(addr, k, v); err != nil {
+			return err
+		}
+	}
+	return stateDB.commitContracts()
+}
+
 func getChainConfig() *params.ChainConfig {
 	var chainConfig params.ChainConfig
 	// chainConfig.ChainID
@@ -199,12 +306,21 @@ func getChainConfig() *params.ChainConfig {
 	return &chainConfig
 }
 
-func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, gasLimit *uint64) ([]byte, uint64, uint64, string, error) {
+func executeInEVM(
+	evmParams *Params,
+	stateDB *StateDBAdapter,
+	gasLimit *uint64,
+	tracer vm.Tracer,
+) ([]byte, uint64, uint64, string, error) {
 	remainingGas := evmParams.gas
 	if err := securityDeposit(evmParams, stateDB, gasLimit); err != nil {
 		return nil, 0, 0, action.EmptyAddress, err
 	}
 	var config vm.Config
+	if tracer != nil {
+		config.Debug = true
+		config.Tracer = tracer
+	}
 	chainConfig := getChainConfig()
 	evm := vm.NewEVM(evmParams.context, stateDB, chainConfig, config)
 	intriGas, err := intrinsicGas(evmParams.data)
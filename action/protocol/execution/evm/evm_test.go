@@ -7,12 +7,17 @@
 package evm
 
 import (
+	"context"
 	"testing"
 
+	"github.com/CoderZhi/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state/factory"
 )
 
 func TestLogReceipt(t *testing.T) {
@@ -50,3 +55,32 @@ func TestLogReceipt(t *testing.T) {
 	require.Equal(len(receipt.Logs), len(actualReceipt.Logs))
 	require.Equal(receipt.Hash, actualReceipt.Hash)
 }
+
+func TestDeployContract(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+
+	addr := byteutil.BytesTo20B(hash.Hash160b([]byte("genesis contract")))
+	code := []byte("genesis contract code")
+	key := byteutil.BytesTo32B(hash.Hash256b([]byte("key")))
+	value := byteutil.BytesTo32B(hash.Hash256b([]byte("value")))
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	require.NoError(DeployContract(ws, addr, code, map[hash.Hash32B]hash.Hash32B{key: value}))
+	require.Error(DeployContract(ws, addr, code, nil))
+	require.NoError(sf.Commit(ws))
+
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	stateDB := NewStateDBAdapter(nil, ws, 1, hash.ZeroHash32B, hash.ZeroHash32B)
+	evmAddr := common.BytesToAddress(addr[:])
+	require.Equal(code, stateDB.GetCode(evmAddr))
+	require.Equal(common.BytesToHash(value[:]), stateDB.GetState(evmAddr, common.BytesToHash(key[:])))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/iotxaddress"
@@ -56,6 +57,8 @@ type (
 		preimageSnapshot map[int]preimageMap
 		dao              db.KVStore
 		cb               db.CachedBatch
+		// syncVoteeWeight is true if the balance changes count for the votees since the votee weight sync
+		syncVoteeWeight bool
 	}
 )
 
@@ -121,6 +124,16 @@ func (stateDB *StateDBAdapter) SubBalance(evmAddr common.Address, amount *big.In
 	if err := account.StoreAccount(stateDB.sm, addr.IotxAddress(), state); err != nil {
 		log.L().Error("Failed to update pending account changes to trie.", zap.Error(err))
 	}
+	if stateDB.syncVoteeWeight {
+		if err := candidatesutil.LoadAndUpdateVoteeWeight(
+			stateDB.sm,
+			addr.IotxAddress(),
+			new(big.Int).Neg(amount),
+		); err != nil {
+			log.L().Error("Failed to update the weight of votee.", zap.Error(err))
+			stateDB.logError(err)
+		}
+	}
 	// stateDB.GetBalance(evmAddr)
 }
 
@@ -143,6 +156,12 @@ func (stateDB *StateDBAdapter) AddBalance(evmAddr common.Address, amount *big.In
 	if err := account.StoreAccount(stateDB.sm, addr.IotxAddress(), state); err != nil {
 		log.L().Error("Failed to update pending account changes to trie.", zap.Error(err))
 	}
+	if stateDB.syncVoteeWeight {
+		if err := candidatesutil.LoadAndUpdateVoteeWeight(stateDB.sm, addr.IotxAddress(), amount); err != nil {
+			log.L().Error("Failed to update the weight of votee.", zap.Error(err))
+			stateDB.logError(err)
+		}
+	}
 }
 
 // GetBalance gets the balance of account
@@ -0,0 +1,270 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"bytes"
+	"math/big"
+	"time"
+
+	"github.com/CoderZhi/go-ethereum/common"
+	"github.com/CoderZhi/go-ethereum/core/vm"
+
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// revertSelector is the selector of Error(string), which encodes the reason of a revert
+var revertSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+type (
+	// Trace is the record of executing a contract
+	Trace struct {
+		Steps        []*TraceStep
+		Calls        []*TraceCall
+		StorageDiffs []*StorageDiff
+		Output       []byte
+		GasUsed      uint64
+		Error        string
+		RevertReason string
+	}
+
+	// TraceStep is an opcode executed, along with the stack before executing it
+	TraceStep struct {
+		Pc      uint64
+		Op      string
+		Gas     uint64
+		GasCost uint64
+		Depth   int
+		Stack   []*big.Int
+		Error   string
+	}
+
+	// TraceCall is a call frame entered, which is either the execution itself at depth 1, or a call or a creation
+	// made by a contract. The callee of a creation made by a contract is empty, because it's not known yet.
+	TraceCall struct {
+		Type  string
+		From  string
+		To    string
+		Input []byte
+		Gas   uint64
+		Value *big.Int
+		Depth int
+	}
+
+	// StorageDiff is a storage slot of a contract changed by the execution
+	StorageDiff struct {
+		Address  string
+		Key      hash.Hash32B
+		Original hash.Hash32B
+		Current  hash.Hash32B
+	}
+
+	// Tracer implements vm.Tracer to record the opcodes, the call frames and the storage changes of an execution
+	Tracer struct {
+		chainID uint32
+		stateDB vm.StateDB
+		trace   Trace
+		// storage is the storage slots changed, in the order of the storage diffs
+		storage []storageSlot
+	}
+
+	storageSlot struct {
+		addr common.Address
+		key  common.Hash
+	}
+)
+
+var _ vm.Tracer = (*Tracer)(nil)
+
+// NewTracer creates a tracer of the executions on the chain
+func NewTracer(chainID uint32) *Tracer {
+	return &Tracer{chainID: chainID}
+}
+
+// Trace returns the trace recorded
+func (t *Tracer) Trace() *Trace { return &t.trace }
+
+// CaptureStart records the call frame of the execution
+func (t *Tracer) CaptureStart(
+	from common.Address,
+	to common.Address,
+	create bool,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+) error {
+	call := &TraceCall{
+		Type:  vm.CALL.String(),
+		From:  t.address(from),
+		To:    t.address(to),
+		Input: append([]byte{}, input...),
+		Gas:   gas,
+		Value: new(big.Int).Set(value),
+		Depth: 1,
+	}
+	if create {
+		call.Type = vm.CREATE.String()
+	}
+	t.trace.Calls = append(t.trace.Calls, call)
+	return nil
+}
+
+// CaptureState records the opcode to execute, and the call frame or the storage slot it's going to enter or change
+func (t *Tracer) CaptureState(
+	env *vm.EVM,
+	pc uint64,
+	op vm.OpCode,
+	gas, cost uint64,
+	memory *vm.Memory,
+	stack *vm.Stack,
+	contract *vm.Contract,
+	depth int,
+	err error,
+) error {
+	t.stateDB = env.StateDB
+	step := &TraceStep{
+		Pc:      pc,
+		Op:      op.String(),
+		Gas:     gas,
+		GasCost: cost,
+		Depth:   depth,
+	}
+	for _, v := range stack.Data() {
+		step.Stack = append(step.Stack, new(big.Int).Set(v))
+	}
+	if err != nil {
+		step.Error = err.Error()
+	}
+	t.trace.Steps = append(t.trace.Steps, step)
+
+	from := t.address(contract.Address())
+	switch op {
+	case vm.CALL, vm.CALLCODE:
+		if len(stack.Data()) >= 3 {
+			t.trace.Calls = append(t.trace.Calls, &TraceCall{
+				Type:  op.String(),
+				From:  from,
+				To:    t.address(common.BigToAddress(stack.Back(1))),
+				Gas:   stack.Back(0).Uint64(),
+				Value: new(big.Int).Set(stack.Back(2)),
+				Depth: depth + 1,
+			})
+		}
+	case vm.DELEGATECALL, vm.STATICCALL:
+		if len(stack.Data()) >= 2 {
+			t.trace.Calls = append(t.trace.Calls, &TraceCall{
+				Type:  op.String(),
+				From:  from,
+				To:    t.address(common.BigToAddress(stack.Back(1))),
+				Gas:   stack.Back(0).Uint64(),
+				Value: big.NewInt(0),
+				Depth: depth + 1,
+			})
+		}
+	case vm.CREATE, vm.CREATE2:
+		if len(stack.Data()) >= 1 {
+			t.trace.Calls = append(t.trace.Calls, &TraceCall{
+				Type:  op.String(),
+				From:  from,
+				Gas:   gas,
+				Value: new(big.Int).Set(stack.Back(0)),
+				Depth: depth + 1,
+			})
+		}
+	case vm.SSTORE:
+		if len(stack.Data()) >= 1 {
+			t.touchStorage(contract.Address(), common.BigToHash(stack.Back(0)))
+		}
+	}
+	return nil
+}
+
+// CaptureFault records the error of the opcode failed
+func (t *Tracer) CaptureFault(
+	env *vm.EVM,
+	pc uint64,
+	op vm.OpCode,
+	gas, cost uint64,
+	memory *vm.Memory,
+	stack *vm.Stack,
+	contract *vm.Contract,
+	depth int,
+	err error,
+) error {
+	if len(t.trace.Steps) > 0 && err != nil {
+		t.trace.Steps[len(t.trace.Steps)-1].Error = err.Error()
+	}
+	return nil
+}
+
+// CaptureEnd records the result of the execution, and the storage slots changed by it
+func (t *Tracer) CaptureEnd(output []byte, gasUsed uint64, _ time.Duration, err error) error {
+	t.trace.Output = append([]byte{}, output...)
+	t.trace.GasUsed = gasUsed
+	if err != nil {
+		t.trace.Error = err.Error()
+		t.trace.RevertReason = revertReason(output)
+	}
+	if t.stateDB == nil {
+		return nil
+	}
+	var diffs []*StorageDiff
+	for i, slot := range t.storage {
+		diff := t.trace.StorageDiffs[i]
+		current := t.stateDB.GetState(slot.addr, slot.key)
+		diff.Current = byteutil.BytesTo32B(current[:])
+		if diff.Current != diff.Original {
+			diffs = append(diffs, diff)
+		}
+	}
+	t.trace.StorageDiffs = diffs
+	return nil
+}
+
+// touchStorage records the original value of the storage slot before it's changed for the first time
+func (t *Tracer) touchStorage(addr common.Address, key common.Hash) {
+	for _, slot := range t.storage {
+		if slot.addr == addr && slot.key == key {
+			return
+		}
+	}
+	original := t.stateDB.GetState(addr, key)
+	t.storage = append(t.storage, storageSlot{addr: addr, key: key})
+	t.trace.StorageDiffs = append(t.trace.StorageDiffs, &StorageDiff{
+		Address:  t.address(addr),
+		Key:      byteutil.BytesTo32B(key[:]),
+		Original: byteutil.BytesTo32B(original[:]),
+	})
+}
+
+func (t *Tracer) address(addr common.Address) string {
+	return address.New(t.chainID, addr.Bytes()).IotxAddress()
+}
+
+// revertReason decodes the reason of a failure from the output encoded as calling Error(string) by a revert, and
+// returns empty if the output isn't encoded so
+func revertReason(output []byte) string {
+	if len(output) < len(revertSelector) || !bytes.Equal(output[:len(revertSelector)], revertSelector) {
+		return ""
+	}
+	data := output[len(revertSelector):]
+	if len(data) < 32 {
+		return ""
+	}
+	offset := new(big.Int).SetBytes(data[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(data))-32 {
+		return ""
+	}
+	start := offset.Uint64() + 32
+	length := new(big.Int).SetBytes(data[offset.Uint64():start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(data))-start {
+		return ""
+	}
+	return string(data[start : start+length.Uint64()])
+}
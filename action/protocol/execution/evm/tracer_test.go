@@ -0,0 +1,124 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+	ta "github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestTraceContract(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	cm := mock_chainmanager.NewMockChainManager(ctrl)
+	cm.EXPECT().ChainID().Return(uint32(1)).AnyTimes()
+	cm.EXPECT().GetHashByHeight(gomock.Any()).Return(hash.ZeroHash32B, nil).AnyTimes()
+
+	// The contract storing 0x2a at the slot 0
+	storeAddr := byteutil.BytesTo20B(hash.Hash160b([]byte("store")))
+	storeCode, err := hex.DecodeString("602a60005500")
+	require.NoError(err)
+	// The contract reverting with the reason "no"
+	revertAddr := byteutil.BytesTo20B(hash.Hash160b([]byte("revert")))
+	revertCode, err := hex.DecodeString("7f08c379a000000000000000000000000000000000000000000000000000000000" +
+		"6000527f0000002000000000000000000000000000000000000000000000000000000000" +
+		"6020527f000000026e6f00000000000000000000000000000000000000000000000000006040526064" +
+		"6000fd")
+	require.NoError(err)
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	require.NoError(DeployContract(ws, storeAddr, storeCode, nil))
+	require.NoError(DeployContract(ws, revertAddr, revertCode, nil))
+	executor := ta.IotxAddrinfo["producer"].RawAddress
+	_, err = account.LoadOrCreateAccount(ws, executor, big.NewInt(0))
+	require.NoError(err)
+
+	trace := func(contract hash.PKHash, nonce uint64) *Trace {
+		exec, err := action.NewExecution(
+			executor,
+			address.New(1, contract[:]).IotxAddress(),
+			nonce,
+			big.NewInt(0),
+			100000,
+			big.NewInt(0),
+			nil,
+		)
+		require.NoError(err)
+		gasLimit := uint64(1000000)
+		receipt, trace, err := TraceContract(1, hash.ZeroHash32B, ta.IotxAddrinfo["producer"].PublicKey, 0, ws, exec,
+			cm, &gasLimit, false, nil, nil)
+		require.NoError(err)
+		require.Equal(exec.Hash(), receipt.Hash)
+		return trace
+	}
+
+	storeTrace := trace(storeAddr, 1)
+	require.Equal([]string{"PUSH1", "PUSH1", "SSTORE", "STOP"}, opsOf(storeTrace))
+	require.Equal(1, len(storeTrace.Calls))
+	require.Equal("CALL", storeTrace.Calls[0].Type)
+	require.Equal(executor, storeTrace.Calls[0].From)
+	require.Equal(address.New(1, storeAddr[:]).IotxAddress(), storeTrace.Calls[0].To)
+	require.Equal(1, len(storeTrace.StorageDiffs))
+	require.Equal(hash.ZeroHash32B, storeTrace.StorageDiffs[0].Key)
+	require.Equal(hash.ZeroHash32B, storeTrace.StorageDiffs[0].Original)
+	require.Equal(byte(0x2a), storeTrace.StorageDiffs[0].Current[31])
+	require.Empty(storeTrace.Error)
+
+	revertTrace := trace(revertAddr, 2)
+	require.Equal("REVERT", opsOf(revertTrace)[len(revertTrace.Steps)-1])
+	require.NotEmpty(revertTrace.Error)
+	require.Equal("no", revertTrace.RevertReason)
+	require.Empty(revertTrace.StorageDiffs)
+}
+
+func TestRevertReason(t *testing.T) {
+	require := require.New(t)
+
+	encoded, err := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000002" +
+		"6e6f000000000000000000000000000000000000000000000000000000000000")
+	require.NoError(err)
+	require.Equal("no", revertReason(encoded))
+	require.Equal("", revertReason(nil))
+	require.Equal("", revertReason(encoded[:4]))
+	require.Equal("", revertReason(encoded[:68]))
+	wrongSelector := append([]byte{0x00}, encoded[1:]...)
+	require.Equal("", revertReason(wrongSelector))
+}
+
+func opsOf(trace *Trace) []string {
+	var ops []string
+	for _, step := range trace.Steps {
+		ops = append(ops, step.Op)
+	}
+	return ops
+}
@@ -42,7 +42,7 @@ func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.St
 		return nil, errors.New("failed to get RunActionsCtx")
 	}
 	receipt, err := evm.ExecuteContract(raCtx.BlockHeight, raCtx.BlockHash, raCtx.ProducerPubKey, raCtx.BlockTimeStamp,
-		sm, exec, p.cm, raCtx.GasLimit, raCtx.EnableGasCharge)
+		sm, exec, p.cm, raCtx.GasLimit, raCtx.EnableGasCharge, raCtx.BaseFee, raCtx.ForkSchedule)
 
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to execute contract")
@@ -124,19 +124,19 @@ func (sct *smartContractTest) prepareBlockchain(
 	cfg := config.Default
 	cfg.Chain.EnableGasCharge = true
 	cfg.Explorer.Enabled = true
-	bc := blockchain.NewBlockchain(
+	bc, err := blockchain.NewBlockchain(
 		cfg,
 		blockchain.InMemDaoOption(),
 		blockchain.InMemStateFactoryOption(),
 	)
-	r.NotNil(bc)
+	r.NoError(err)
 	bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
 	bc.Validator().AddActionValidators(account.NewProtocol(), NewProtocol(bc))
 	sf := bc.GetFactory()
 	r.NotNil(sf)
 	sf.AddActionHandlers(NewProtocol(bc))
 	r.NoError(bc.Start(ctx))
-	ws, err := sf.NewWorkingSet()
+	ws, err = sf.NewWorkingSet()
 	r.NoError(err)
 	for acct, supply := range sct.prepare {
 		_, err = account.LoadOrCreateAccount(ws, acct, supply)
@@ -219,7 +219,8 @@ func TestProtocol_Handle(t *testing.T) {
 		cfg.Chain.ChainDBPath = testDBPath
 		cfg.Chain.EnableGasCharge = true
 		cfg.Explorer.Enabled = true
-		bc := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+		bc, err := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+		require.NoError(err)
 		bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
 		bc.Validator().AddActionValidators(account.NewProtocol(), NewProtocol(bc))
 		sf := bc.GetFactory()
@@ -394,7 +395,8 @@ func TestProtocol_Handle(t *testing.T) {
 		cfg.Chain.ChainDBPath = testDBPath
 		cfg.Chain.EnableGasCharge = true
 		cfg.Explorer.Enabled = true
-		bc := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+		bc, err := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+		require.NoError(err)
 		bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
 		bc.Validator().AddActionValidators(account.NewProtocol(), NewProtocol(bc))
 		sf := bc.GetFactory()
@@ -543,7 +545,8 @@ func TestProtocol_Handle(t *testing.T) {
 		cfg.Chain.TrieDBPath = testTriePath
 		cfg.Chain.ChainDBPath = testDBPath
 		cfg.Explorer.Enabled = true
-		bc := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+		bc, err := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+		require.NoError(err)
 		bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
 		bc.Validator().AddActionValidators(account.NewProtocol(), NewProtocol(bc))
 		require.NoError(bc.Start(ctx))
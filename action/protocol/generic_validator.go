@@ -41,6 +41,17 @@ func (v *GenericValidator) Validate(ctx context.Context, act action.SealedEnvelo
 			return nil
 		}
 	}
+	// Reject the action introduced by a feature not active yet, which is checked against the next block if the action
+	// is not validated in a block
+	height, forkSchedule := vaCtx.BlockHeight, vaCtx.ForkSchedule
+	if !validateInBlock {
+		height, forkSchedule = v.cm.TipHeight()+1, v.cm.ForkSchedule()
+	}
+	for _, feature := range requiredFeatures(act.Action()) {
+		if !forkSchedule.IsActive(feature, height) {
+			return errors.Wrapf(action.ErrAction, "feature %s is not active at height %d", feature, height)
+		}
+	}
 	// Reject over-gassed action
 	if act.GasLimit() > genesis.ActionGasLimit {
 		return errors.Wrap(action.ErrGasHigherThanLimit, "gas is higher than gas limit")
@@ -78,3 +89,30 @@ func (v *GenericValidator) Validate(ctx context.Context, act action.SealedEnvelo
 	}
 	return nil
 }
+
+// requiredFeatures returns the features introducing the action, including the ones of the payloads in a batch
+func requiredFeatures(act action.Action) []string {
+	switch act := act.(type) {
+	case *action.Transfer:
+		if act.Memo() != nil {
+			return []string{genesis.TransferMemo}
+		}
+	case *action.Batch:
+		features := []string{genesis.Batch}
+		for _, payload := range act.Payloads() {
+			features = append(features, requiredFeatures(payload)...)
+		}
+		return features
+	case *action.TimelockTransfer, *action.ClaimTimelock:
+		return []string{genesis.TimeLock}
+	case *action.ClaimReward:
+		return []string{genesis.Reward}
+	case *action.CreateStake, *action.Unstake, *action.Withdraw:
+		return []string{genesis.Staking}
+	case *action.RegisterCandidate, *action.UpdateCandidate, *action.UnregisterCandidate:
+		return []string{genesis.CandidateRegistration}
+	case *action.SubmitProposal, *action.VoteProposal:
+		return []string{genesis.Governance}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package protocol_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/test/mock/mock_chainmanager"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestGenericValidator_ForkGatedActions(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	staker := testaddress.IotxAddrinfo["alfa"]
+	candidate := testaddress.IotxAddrinfo["bravo"].RawAddress
+	forkSchedule := genesis.ForkSchedule{genesis.Staking: 3}
+	cm := mock_chainmanager.NewMockChainManager(ctrl)
+	cm.EXPECT().Nonce(gomock.Any()).Return(uint64(0), nil).AnyTimes()
+	cm.EXPECT().ForkSchedule().Return(forkSchedule).AnyTimes()
+	v := protocol.NewGenericValidator(cm)
+
+	bd := &action.EnvelopeBuilder{}
+	elp := bd.SetNonce(1).
+		SetDestinationAddress(candidate).
+		SetGasLimit(100000).
+		SetGasPrice(big.NewInt(0)).
+		SetAction(action.NewCreateStake(1, staker.RawAddress, candidate, big.NewInt(100), 0, 100000, big.NewInt(0))).
+		Build()
+	stake, err := action.Sign(elp, staker.RawAddress, staker.PrivateKey)
+	require.NoError(err)
+	inBlock := func(height uint64) context.Context {
+		nonceTracker := &sync.Map{}
+		nonceTracker.Store(staker.RawAddress, make([]uint64, 0))
+		return protocol.WithValidateActionsCtx(context.Background(), &protocol.ValidateActionsCtx{
+			NonceTracker: nonceTracker,
+			BlockHeight:  height,
+			ForkSchedule: forkSchedule,
+		})
+	}
+
+	// The stake action is rejected in the blocks before the staking fork
+	err = v.Validate(inBlock(2), stake)
+	require.Equal(action.ErrAction, errors.Cause(err))
+	require.NoError(v.Validate(inBlock(3), stake))
+
+	// Out of a block, it's checked against the next block
+	cm.EXPECT().TipHeight().Return(uint64(1)).Times(1)
+	err = v.Validate(context.Background(), stake)
+	require.Equal(action.ErrAction, errors.Cause(err))
+	cm.EXPECT().TipHeight().Return(uint64(2)).Times(1)
+	require.NoError(v.Validate(context.Background(), stake))
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package governance
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action/protocol/governance/governancepb"
+)
+
+// Ballot is the vote of a candidate on a proposal, weighted by the votes of the candidate when the proposal is
+// submitted
+type Ballot struct {
+	Voter   string
+	Approve bool
+	Weight  *big.Int
+}
+
+// Proposal is a proposal to change a chain parameter to the value. It could be voted on until the voting end height,
+// and takes effect since the activation height if it's accepted.
+type Proposal struct {
+	ID               uint64
+	Proposer         string
+	Parameter        string
+	Value            uint64
+	SubmitHeight     uint64
+	VotingEndHeight  uint64
+	ActivationHeight uint64
+	// Ballots is the ballots of the candidates when the proposal is submitted, in the order of their addresses. A
+	// candidate rejects the proposal until it votes to approve it.
+	Ballots []Ballot
+	// TotalWeight is the total votes of the candidates when the proposal is submitted, which is the total weight of
+	// the ballots
+	TotalWeight *big.Int
+}
+
+// Cast records the vote of the voter, which replaces the previous one of the voter. It returns false if the voter has
+// no ballot on the proposal.
+func (p *Proposal) Cast(voter string, approve bool) bool {
+	for i := range p.Ballots {
+		if p.Ballots[i].Voter == voter {
+			p.Ballots[i].Approve = approve
+			return true
+		}
+	}
+	return false
+}
+
+// Tally returns the total weights approving and rejecting the proposal
+func (p *Proposal) Tally() (*big.Int, *big.Int) {
+	approvals, rejections := big.NewInt(0), big.NewInt(0)
+	for _, b := range p.Ballots {
+		if b.Approve {
+			approvals.Add(approvals, b.Weight)
+		} else {
+			rejections.Add(rejections, b.Weight)
+		}
+	}
+	return approvals, rejections
+}
+
+// Accepted returns true if the voting period of the proposal is over at the height, and the weight approving it is
+// more than 2/3 of the total weight of the candidates when it's submitted
+func (p *Proposal) Accepted(height uint64) bool {
+	if height <= p.VotingEndHeight {
+		return false
+	}
+	approvals, _ := p.Tally()
+	quorum := big.NewInt(0)
+	if p.TotalWeight != nil {
+		quorum.Mul(p.TotalWeight, big.NewInt(2))
+	}
+	return approvals.Mul(approvals, big.NewInt(3)).Cmp(quorum) > 0
+}
+
+// Serialize serializes a proposal to binary.
+func (p *Proposal) Serialize() ([]byte, error) {
+	ballots := make([]*governancepb.Ballot, len(p.Ballots))
+	for i, b := range p.Ballots {
+		ballots[i] = &governancepb.Ballot{
+			Voter:   b.Voter,
+			Approve: b.Approve,
+		}
+		if b.Weight != nil {
+			ballots[i].Weight = b.Weight.Bytes()
+		}
+	}
+	gen := &governancepb.Proposal{
+		Id:               p.ID,
+		Proposer:         p.Proposer,
+		Parameter:        p.Parameter,
+		Value:            p.Value,
+		SubmitHeight:     p.SubmitHeight,
+		VotingEndHeight:  p.VotingEndHeight,
+		ActivationHeight: p.ActivationHeight,
+		Ballots:          ballots,
+	}
+	if p.TotalWeight != nil {
+		gen.TotalWeight = p.TotalWeight.Bytes()
+	}
+	return proto.Marshal(gen)
+}
+
+// Deserialize deserializes binary to a proposal.
+func (p *Proposal) Deserialize(data []byte) error {
+	gen := &governancepb.Proposal{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	var ballots []Ballot
+	for _, b := range gen.Ballots {
+		ballots = append(ballots, Ballot{
+			Voter:   b.Voter,
+			Approve: b.Approve,
+			Weight:  big.NewInt(0).SetBytes(b.Weight),
+		})
+	}
+	*p = Proposal{
+		ID:               gen.Id,
+		Proposer:         gen.Proposer,
+		Parameter:        gen.Parameter,
+		Value:            gen.Value,
+		SubmitHeight:     gen.SubmitHeight,
+		VotingEndHeight:  gen.VotingEndHeight,
+		ActivationHeight: gen.ActivationHeight,
+		Ballots:          ballots,
+		TotalWeight:      big.NewInt(0).SetBytes(gen.TotalWeight),
+	}
+	return nil
+}
+
+// ProposalIndex keeps the id of the next proposal to submit
+type ProposalIndex struct {
+	NextID uint64
+}
+
+// Serialize serializes a proposal index to binary.
+func (pi ProposalIndex) Serialize() ([]byte, error) {
+	return proto.Marshal(&governancepb.ProposalIndex{NextID: pi.NextID})
+}
+
+// Deserialize deserializes binary to a proposal index.
+func (pi *ProposalIndex) Deserialize(data []byte) error {
+	gen := &governancepb.ProposalIndex{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	*pi = ProposalIndex{NextID: gen.NextID}
+	return nil
+}
+
+// ParameterProposals is the proposals on a parameter. The proposals whose results are final are settled into the
+// value of the latest accepted one taking effect, and the others are kept pending by their ids in the order of
+// submission.
+type ParameterProposals struct {
+	// Settled is true if any accepted proposal on the parameter has been settled
+	Settled bool
+	// Value is the value of the settled proposal
+	Value uint64
+	// ActivationHeight is the activation height of the settled proposal
+	ActivationHeight uint64
+	PendingIDs       []uint64
+}
+
+// Serialize serializes the proposals on a parameter to binary.
+func (pp ParameterProposals) Serialize() ([]byte, error) {
+	return proto.Marshal(&governancepb.ParameterProposals{
+		Settled:          pp.Settled,
+		Value:            pp.Value,
+		ActivationHeight: pp.ActivationHeight,
+		PendingIDs:       pp.PendingIDs,
+	})
+}
+
+// Deserialize deserializes binary to the proposals on a parameter.
+func (pp *ParameterProposals) Deserialize(data []byte) error {
+	gen := &governancepb.ParameterProposals{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	*pp = ParameterProposals{
+		Settled:          gen.Settled,
+		Value:            gen.Value,
+		ActivationHeight: gen.ActivationHeight,
+		PendingIDs:       gen.PendingIDs,
+	}
+	return nil
+}
+
+// Parameters is the values of the governable parameters set in the genesis block, which override the default values
+// until proposals changing them are accepted
+type Parameters map[string]uint64
+
+// Serialize serializes parameters to binary, in the order of the names
+func (ps Parameters) Serialize() ([]byte, error) {
+	names := make([]string, 0, len(ps))
+	for name := range ps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	l := make([]*governancepb.Parameter, len(names))
+	for i, name := range names {
+		l[i] = &governancepb.Parameter{Name: name, Value: ps[name]}
+	}
+	return proto.Marshal(&governancepb.Parameters{Parameters: l})
+}
+
+// Deserialize deserializes binary to parameters.
+func (ps *Parameters) Deserialize(data []byte) error {
+	gen := &governancepb.Parameters{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	params := make(Parameters, len(gen.Parameters))
+	for _, p := range gen.Parameters {
+		params[p.Name] = p.Value
+	}
+	*ps = params
+	return nil
+}
@@ -0,0 +1,429 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package governance
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	// NumCandidates is the parameter of the number of candidates kept in the candidate pool, which overrides
+	// config.Chain.NumCandidates
+	NumCandidates = "numCandidates"
+	// EnableGasCharge is the parameter of whether the gas is charged, which is 1 if enabled or 0 otherwise and
+	// overrides config.Chain.EnableGasCharge
+	EnableGasCharge = "enableGasCharge"
+	// BlockGasLimit is the parameter of the total gas limit of the actions in a block, which defaults to
+	// genesis.BlockGasLimit
+	BlockGasLimit = "blockGasLimit"
+	// BlockSizeLimit is the parameter of the total size in bytes of the actions in a block, which defaults to
+	// genesis.BlockSizeLimit
+	BlockSizeLimit = "blockSizeLimit"
+	// MinSelfStake is the parameter of the minimum self-stake in IOTX a candidate has to lock on registration, which
+	// defaults to genesis.MinSelfStake
+	MinSelfStake = "minSelfStake"
+	// VotingPeriod is the parameter of the number of blocks a proposal could be voted on after its submission, which
+	// defaults to genesis.VotingPeriod
+	VotingPeriod = "votingPeriod"
+	// ActivationDelay is the parameter of the number of blocks an accepted proposal takes effect after the voting
+	// period, which defaults to genesis.ActivationDelay
+	ActivationDelay = "activationDelay"
+	// ElasticityMultiplier is the parameter of the ratio of the block gas limit to the gas target, which defaults to
+	// genesis.ElasticityMultiplier
+	ElasticityMultiplier = "elasticityMultiplier"
+	// BaseFeeChangeDenominator is the parameter bounding the change of the base fee from the parent block, which
+	// defaults to genesis.BaseFeeChangeDenominator
+	BaseFeeChangeDenominator = "baseFeeChangeDenominator"
+	// BlockReward is the parameter of the reward in IOTX granted to the producer of each block, which defaults to
+	// genesis.BlockReward
+	BlockReward = "blockReward"
+	// EpochReward is the parameter of the reward in IOTX split among the producers of an epoch, which defaults to
+	// genesis.EpochReward
+	EpochReward = "epochReward"
+	// VoterSharePercent is the parameter of the percentage of a delegate's epoch reward shared with its voters, which
+	// defaults to genesis.VoterSharePercent
+	VoterSharePercent = "voterSharePercent"
+)
+
+// MaxPendingProposals is the maximum number of the proposals on a parameter whose results are not final yet
+const MaxPendingProposals = 8
+
+// ErrProposal indicates error for a proposal submission or vote
+var ErrProposal = errors.New("invalid proposal action")
+
+// ProposalIndexKey is the key of the proposal index in the state factory
+var ProposalIndexKey = byteutil.BytesTo20B(hash.Hash160b([]byte("governance.proposalIndex")))
+
+// GenesisParametersKey is the key of the parameters set in the genesis block in the state factory
+var GenesisParametersKey = byteutil.BytesTo20B(hash.Hash160b([]byte("governance.genesisParameters")))
+
+// validators checks the values of the governable parameters
+var validators = map[string]func(uint64) error{
+	NumCandidates: func(v uint64) error {
+		if v == 0 {
+			return errors.Wrap(ErrProposal, "zero number of candidates")
+		}
+		return nil
+	},
+	EnableGasCharge: func(v uint64) error {
+		if v > 1 {
+			return errors.Wrap(ErrProposal, "value should be 0 or 1")
+		}
+		return nil
+	},
+	BlockGasLimit: func(v uint64) error {
+		if v < genesis.ActionGasLimit {
+			return errors.Wrapf(ErrProposal, "block gas limit is lower than action gas limit %d", genesis.ActionGasLimit)
+		}
+		return nil
+	},
+	BlockSizeLimit: func(v uint64) error {
+		if v == 0 {
+			return errors.Wrap(ErrProposal, "zero block size limit")
+		}
+		return nil
+	},
+	MinSelfStake: func(v uint64) error {
+		if v == 0 {
+			return errors.Wrap(ErrProposal, "zero min self-stake")
+		}
+		return nil
+	},
+	VotingPeriod: func(v uint64) error {
+		if v == 0 {
+			return errors.Wrap(ErrProposal, "zero voting period")
+		}
+		return nil
+	},
+	ActivationDelay: func(v uint64) error {
+		return nil
+	},
+	ElasticityMultiplier: func(v uint64) error {
+		if v == 0 {
+			return errors.Wrap(ErrProposal, "zero elasticity multiplier")
+		}
+		return nil
+	},
+	BaseFeeChangeDenominator: func(v uint64) error {
+		if v == 0 {
+			return errors.Wrap(ErrProposal, "zero base fee change denominator")
+		}
+		return nil
+	},
+	BlockReward: func(v uint64) error {
+		return nil
+	},
+	EpochReward: func(v uint64) error {
+		return nil
+	},
+	VoterSharePercent: func(v uint64) error {
+		if v > 100 {
+			return errors.Wrap(ErrProposal, "voter share is greater than 100 percent")
+		}
+		return nil
+	},
+}
+
+// ProposalKey returns the key of the proposal of the id in the state factory
+func ProposalKey(id uint64) hash.PKHash {
+	k := []byte("governance.proposal.")
+	k = append(k, byteutil.Uint64ToBytes(id)...)
+	return byteutil.BytesTo20B(hash.Hash160b(k))
+}
+
+// ParameterProposalsKey returns the key of the proposals on the parameter in the state factory
+func ParameterProposalsKey(name string) hash.PKHash {
+	return byteutil.BytesTo20B(hash.Hash160b([]byte("governance.parameter." + name)))
+}
+
+// StateReader reads the states by keys, which both the state factory and the working set implement
+type StateReader interface {
+	State(hash.PKHash, interface{}) error
+}
+
+// Protocol defines the protocol of the proposals submitted by the candidates to change the chain parameters, and the
+// votes of the candidates on them
+type Protocol struct{}
+
+// NewProtocol instantiates the protocol of governance
+func NewProtocol() *Protocol { return &Protocol{} }
+
+// Handle handles how to mutate the state db given the proposal submissions and votes
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	raCtx, ok := protocol.GetRunActionsCtx(ctx)
+	if !ok {
+		return nil, errors.New("failed to get action context")
+	}
+	switch act := act.(type) {
+	case *action.SubmitProposal:
+		receipt, err := p.handleSubmitProposal(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling proposal submission action")
+		}
+		return receipt, nil
+	case *action.VoteProposal:
+		receipt, err := p.handleVoteProposal(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling proposal vote action")
+		}
+		return receipt, nil
+	}
+	// The action is not handled by this handler or no error
+	return nil, nil
+}
+
+// Validate validates the proposal submissions
+func (p *Protocol) Validate(_ context.Context, act action.Action) error {
+	sp, ok := act.(*action.SubmitProposal)
+	if !ok {
+		return nil
+	}
+	return ValidateParameter(sp.Parameter(), sp.Value())
+}
+
+// ValidateParameter checks if the parameter is governable and the value is valid for it
+func ValidateParameter(name string, value uint64) error {
+	validate, ok := validators[name]
+	if !ok {
+		return errors.Wrapf(ErrProposal, "parameter %s is not governable", name)
+	}
+	return validate(value)
+}
+
+// SetGenesisParameters validates and stores the parameters set in the genesis block
+func SetGenesisParameters(sm protocol.StateManager, params Parameters) error {
+	for name, value := range params {
+		if err := ValidateParameter(name, value); err != nil {
+			return errors.Wrapf(err, "invalid genesis parameter %s", name)
+		}
+	}
+	return sm.PutState(GenesisParametersKey, params)
+}
+
+// Parameter returns the value of the parameter at the height, which is the value of the accepted proposal taking effect
+// the latest by the height, or the value set in the genesis block or the default value if there is none
+func Parameter(sr StateReader, name string, height uint64, defaultValue uint64) (uint64, error) {
+	value := defaultValue
+	var params Parameters
+	if err := sr.State(GenesisParametersKey, &params); err != nil {
+		if errors.Cause(err) != state.ErrStateNotExist {
+			return 0, errors.Wrap(err, "error when loading genesis parameters")
+		}
+	} else if v, ok := params[name]; ok {
+		value = v
+	}
+	var pp ParameterProposals
+	if err := sr.State(ParameterProposalsKey(name), &pp); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return value, nil
+		}
+		return 0, errors.Wrapf(err, "error when loading proposals on parameter %s", name)
+	}
+	var activationHeight uint64
+	if pp.Settled {
+		value, activationHeight = pp.Value, pp.ActivationHeight
+	}
+	for _, id := range pp.PendingIDs {
+		proposal, err := loadProposal(sr, id)
+		if err != nil {
+			return 0, err
+		}
+		if proposal.ActivationHeight <= height && proposal.Accepted(height) &&
+			proposal.ActivationHeight >= activationHeight {
+			value, activationHeight = proposal.Value, proposal.ActivationHeight
+		}
+	}
+	return value, nil
+}
+
+func (p *Protocol) handleSubmitProposal(
+	sp *action.SubmitProposal,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	proposer, err := account.LoadOrCreateAccount(sm, sp.Proposer(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of proposer %s", sp.Proposer())
+	}
+	gasFee, tip, err := account.ChargeGas(sp, sp.Proposer(), proposer, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := loadParameterProposals(sm, sp.Parameter())
+	if err != nil {
+		return nil, err
+	}
+	if err := settle(sm, &pp, raCtx.BlockHeight); err != nil {
+		return nil, err
+	}
+	// The submission fails without halting the block if the proposer is not a candidate, or there are too many
+	// proposals pending on the parameter
+	if !proposer.IsCandidate || len(pp.PendingIDs) >= MaxPendingProposals {
+		return account.FailureReceipt(sp, sp.Proposer(), proposer, gasFee, tip, raCtx, sm)
+	}
+	votingPeriod, err := Parameter(sm, VotingPeriod, raCtx.BlockHeight, genesis.VotingPeriod)
+	if err != nil {
+		return nil, err
+	}
+	activationDelay, err := Parameter(sm, ActivationDelay, raCtx.BlockHeight, genesis.ActivationDelay)
+	if err != nil {
+		return nil, err
+	}
+	ballots, totalWeight, err := candidateBallots(sm)
+	if err != nil {
+		return nil, err
+	}
+	var index ProposalIndex
+	if err := sm.State(ProposalIndexKey, &index); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return nil, errors.Wrap(err, "error when loading proposal index")
+	}
+	votingEndHeight := raCtx.BlockHeight + votingPeriod
+	proposal := Proposal{
+		ID:               index.NextID,
+		Proposer:         sp.Proposer(),
+		Parameter:        sp.Parameter(),
+		Value:            sp.Value(),
+		SubmitHeight:     raCtx.BlockHeight,
+		VotingEndHeight:  votingEndHeight,
+		ActivationHeight: votingEndHeight + 1 + activationDelay,
+		Ballots:          ballots,
+		TotalWeight:      totalWeight,
+	}
+	if err := sm.PutState(ProposalKey(proposal.ID), &proposal); err != nil {
+		return nil, err
+	}
+	pp.PendingIDs = append(pp.PendingIDs, proposal.ID)
+	if err := sm.PutState(ParameterProposalsKey(sp.Parameter()), pp); err != nil {
+		return nil, err
+	}
+	index.NextID++
+	if err := sm.PutState(ProposalIndexKey, index); err != nil {
+		return nil, err
+	}
+	return nil, setNonce(sp, sp.Proposer(), proposer, gasFee, tip, raCtx, sm)
+}
+
+func (p *Protocol) handleVoteProposal(
+	vp *action.VoteProposal,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	voter, err := account.LoadOrCreateAccount(sm, vp.Voter(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of voter %s", vp.Voter())
+	}
+	gasFee, tip, err := account.ChargeGas(vp, vp.Voter(), voter, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	proposal, err := loadProposal(sm, vp.ID())
+	if err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return nil, err
+	}
+	// The vote fails without halting the block if the proposal doesn't exist or its voting period is over, or the
+	// voter was not a candidate when the proposal was submitted
+	if proposal == nil || raCtx.BlockHeight > proposal.VotingEndHeight || !proposal.Cast(vp.Voter(), vp.Approve()) {
+		return account.FailureReceipt(vp, vp.Voter(), voter, gasFee, tip, raCtx, sm)
+	}
+	if err := setNonce(vp, vp.Voter(), voter, gasFee, tip, raCtx, sm); err != nil {
+		return nil, err
+	}
+	return nil, sm.PutState(ProposalKey(proposal.ID), proposal)
+}
+
+// setNonce updates the nonce of the sender after the gas is charged, and then updates the weights of their votees on
+// the balance changes
+func setNonce(
+	act account.GasCharged,
+	senderAddr string,
+	sender *state.Account,
+	gasFee *big.Int,
+	tip *big.Int,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) error {
+	account.SetNonce(act, sender)
+	if err := account.StoreAccount(sm, senderAddr, sender); err != nil {
+		return errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	return account.UpdateVoteeWeights(sm, senderAddr, big.NewInt(0).Neg(gasFee), raCtx.ProducerAddr, tip)
+}
+
+// settle settles the pending proposals on a parameter whose results are final at the height, which are the rejected
+// ones and the accepted ones taking effect, so that the settled value is the one taking effect the latest
+func settle(sm protocol.StateManager, pp *ParameterProposals, height uint64) error {
+	var pending []uint64
+	for _, id := range pp.PendingIDs {
+		proposal, err := loadProposal(sm, id)
+		if err != nil {
+			return err
+		}
+		if height <= proposal.VotingEndHeight {
+			pending = append(pending, id)
+			continue
+		}
+		if !proposal.Accepted(height) {
+			continue
+		}
+		if proposal.ActivationHeight > height {
+			pending = append(pending, id)
+			continue
+		}
+		if !pp.Settled || proposal.ActivationHeight >= pp.ActivationHeight {
+			pp.Settled, pp.Value, pp.ActivationHeight = true, proposal.Value, proposal.ActivationHeight
+		}
+	}
+	pp.PendingIDs = pending
+	return nil
+}
+
+// candidateBallots returns the ballots of the candidates weighted by their current votes, which reject the proposal
+// until they vote to approve it, and the total weight of the ballots. As both are taken from the same snapshot of the
+// candidates, the votes moving between the candidates during the voting period are never counted twice.
+func candidateBallots(sm protocol.StateManager) ([]Ballot, *big.Int, error) {
+	candidates, err := candidatesutil.GetMostRecentCandidateMap(sm)
+	if err != nil {
+		return nil, nil, err
+	}
+	ballots := make([]Ballot, 0, len(candidates))
+	total := big.NewInt(0)
+	for _, c := range candidates {
+		ballots = append(ballots, Ballot{Voter: c.Address, Weight: big.NewInt(0).Set(c.Votes)})
+		total.Add(total, c.Votes)
+	}
+	sort.Slice(ballots, func(i, j int) bool { return ballots[i].Voter < ballots[j].Voter })
+	return ballots, total, nil
+}
+
+func loadProposal(sr StateReader, id uint64) (*Proposal, error) {
+	var proposal Proposal
+	if err := sr.State(ProposalKey(id), &proposal); err != nil {
+		return nil, errors.Wrapf(err, "error when loading proposal %d", id)
+	}
+	return &proposal, nil
+}
+
+func loadParameterProposals(sm protocol.StateManager, name string) (ParameterProposals, error) {
+	var pp ParameterProposals
+	if err := sm.State(ParameterProposalsKey(name), &pp); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return ParameterProposals{}, errors.Wrapf(err, "error when loading proposals on parameter %s", name)
+	}
+	return pp, nil
+}
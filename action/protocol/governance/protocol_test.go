@@ -0,0 +1,283 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package governance
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestProposals(t *testing.T) {
+	require := require.New(t)
+
+	p1 := &Proposal{
+		ID:               1,
+		Proposer:         testaddress.IotxAddrinfo["alfa"].RawAddress,
+		Parameter:        NumCandidates,
+		Value:            36,
+		SubmitHeight:     10,
+		VotingEndHeight:  20,
+		ActivationHeight: 31,
+		Ballots: []Ballot{
+			{Voter: testaddress.IotxAddrinfo["alfa"].RawAddress, Approve: true, Weight: big.NewInt(300)},
+			{Voter: testaddress.IotxAddrinfo["bravo"].RawAddress, Approve: false, Weight: big.NewInt(200)},
+		},
+		TotalWeight: big.NewInt(600),
+	}
+	data, err := p1.Serialize()
+	require.NoError(err)
+	p2 := &Proposal{}
+	require.NoError(p2.Deserialize(data))
+	require.Equal(p1, p2)
+
+	approvals, rejections := p2.Tally()
+	require.Equal(big.NewInt(300), approvals)
+	require.Equal(big.NewInt(200), rejections)
+	// The approvals are more than the rejections, but not more than 2/3 of the total weight
+	require.False(p2.Accepted(21))
+	require.True(p2.Cast(testaddress.IotxAddrinfo["bravo"].RawAddress, true))
+	require.False(p2.Cast(testaddress.IotxAddrinfo["charlie"].RawAddress, true))
+	require.Equal(2, len(p2.Ballots))
+	require.False(p2.Accepted(20))
+	require.True(p2.Accepted(21))
+
+	pp1 := ParameterProposals{Settled: true, Value: 36, ActivationHeight: 31, PendingIDs: []uint64{2, 3}}
+	data, err = pp1.Serialize()
+	require.NoError(err)
+	var pp2 ParameterProposals
+	require.NoError(pp2.Deserialize(data))
+	require.Equal(pp1, pp2)
+
+	data, err = ProposalIndex{NextID: 4}.Serialize()
+	require.NoError(err)
+	var index ProposalIndex
+	require.NoError(index.Deserialize(data))
+	require.Equal(uint64(4), index.NextID)
+}
+
+func TestProtocol_Handle(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	p := NewProtocol()
+
+	alfa := testaddress.IotxAddrinfo["alfa"].RawAddress
+	bravo := testaddress.IotxAddrinfo["bravo"].RawAddress
+	charlie := testaddress.IotxAddrinfo["charlie"].RawAddress
+	delta := testaddress.IotxAddrinfo["delta"].RawAddress
+	producer := testaddress.IotxAddrinfo["producer"].RawAddress
+	putAccount := func(ws factory.WorkingSet, addr string, acct *state.Account) {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		require.NoError(ws.PutState(pkHash, acct))
+	}
+	gasLimit := uint64(1000000)
+	runCtx := func(height uint64, enableGasCharge bool) context.Context {
+		return protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+			BlockHeight:     height,
+			ProducerAddr:    producer,
+			GasLimit:        &gasLimit,
+			EnableGasCharge: enableGasCharge,
+		})
+	}
+	handle := func(height uint64, enableGasCharge bool, act action.Action) *action.Receipt {
+		ws, err := sf.NewWorkingSet()
+		require.NoError(err)
+		receipt, err := p.Handle(runCtx(height, enableGasCharge), act, ws)
+		require.NoError(err)
+		require.NoError(sf.Commit(ws))
+		return receipt
+	}
+	requireFailure := func(receipt *action.Receipt) {
+		require.NotNil(receipt)
+		require.Equal(action.FailureReceiptStatus, receipt.Status)
+	}
+	nonce := func(addr string) uint64 {
+		acct, err := sf.AccountState(addr)
+		require.NoError(err)
+		return acct.Nonce
+	}
+
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	require.NoError(SetGenesisParameters(ws, Parameters{VotingPeriod: 2, ActivationDelay: 1}))
+	putAccount(ws, alfa, &state.Account{
+		Balance:      big.NewInt(100000),
+		VotingWeight: big.NewInt(300),
+		IsCandidate:  true,
+	})
+	putAccount(ws, bravo, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(200), IsCandidate: true})
+	putAccount(ws, charlie, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(0)})
+	putAccount(ws, delta, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(100), IsCandidate: true})
+	require.NoError(ws.PutState(candidatesutil.ConstructKey(0), &state.CandidateList{
+		{Address: alfa, Votes: big.NewInt(300)},
+		{Address: bravo, Votes: big.NewInt(200)},
+		{Address: delta, Votes: big.NewInt(100)},
+	}))
+	require.NoError(sf.Commit(ws))
+
+	// Only the candidates could submit the proposals
+	require.Nil(handle(1, true, action.NewSubmitProposal(1, alfa, NumCandidates, 5, 100000, big.NewInt(1))))
+	require.Nil(handle(1, false, action.NewSubmitProposal(2, alfa, EnableGasCharge, 1, 100000, big.NewInt(0))))
+	requireFailure(handle(1, false, action.NewSubmitProposal(1, charlie, NumCandidates, 1, 100000, big.NewInt(0))))
+	require.Equal(uint64(1), nonce(charlie))
+	acct, err := sf.AccountState(alfa)
+	require.NoError(err)
+	require.Equal(big.NewInt(100000-int64(action.SubmitProposalIntrinsicGas)), acct.Balance)
+	require.Equal(uint64(2), acct.Nonce)
+
+	// The votes of bravo move to delta after the submissions, which doesn't change the weights of their ballots
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	putAccount(ws, bravo, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(0), IsCandidate: true})
+	putAccount(ws, delta, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(300), IsCandidate: true})
+	require.NoError(ws.PutState(candidatesutil.ConstructKey(0), &state.CandidateList{
+		{Address: alfa, Votes: big.NewInt(300)},
+		{Address: bravo, Votes: big.NewInt(0)},
+		{Address: delta, Votes: big.NewInt(300)},
+	}))
+	require.NoError(sf.Commit(ws))
+
+	// The proposal 0 is approved by 500 out of 600 after bravo changes its vote, and the proposal 1 is approved by
+	// 400 only, which is not more than 2/3 of the total weight
+	require.Nil(handle(2, false, action.NewVoteProposal(3, alfa, 0, true, 100000, big.NewInt(0))))
+	require.Nil(handle(2, false, action.NewVoteProposal(4, alfa, 1, true, 100000, big.NewInt(0))))
+	require.Nil(handle(2, false, action.NewVoteProposal(1, bravo, 0, false, 100000, big.NewInt(0))))
+	require.Nil(handle(3, false, action.NewVoteProposal(2, bravo, 0, true, 100000, big.NewInt(0))))
+	require.Nil(handle(3, false, action.NewVoteProposal(1, delta, 1, true, 100000, big.NewInt(0))))
+	// The votes fail on an expired or missing proposal, or from a non-candidate
+	requireFailure(handle(4, false, action.NewVoteProposal(3, bravo, 1, false, 100000, big.NewInt(0))))
+	requireFailure(handle(3, false, action.NewVoteProposal(4, bravo, 2, true, 100000, big.NewInt(0))))
+	requireFailure(handle(3, false, action.NewVoteProposal(2, charlie, 0, false, 100000, big.NewInt(0))))
+	require.Equal(uint64(4), nonce(bravo))
+	require.Equal(uint64(2), nonce(charlie))
+
+	proposal, err := loadProposal(sf, 0)
+	require.NoError(err)
+	approvals, rejections := proposal.Tally()
+	require.Equal(big.NewInt(500), approvals)
+	require.Equal(big.NewInt(100), rejections)
+	require.Equal(big.NewInt(600), proposal.TotalWeight)
+	proposal, err = loadProposal(sf, 1)
+	require.NoError(err)
+	approvals, _ = proposal.Tally()
+	require.Equal(big.NewInt(400), approvals)
+	require.False(proposal.Accepted(4))
+
+	// The accepted proposal takes effect after the voting period and the activation delay
+	value, err := Parameter(sf, NumCandidates, 4, 101)
+	require.NoError(err)
+	require.Equal(uint64(101), value)
+	value, err = Parameter(sf, NumCandidates, 5, 101)
+	require.NoError(err)
+	require.Equal(uint64(5), value)
+	value, err = Parameter(sf, EnableGasCharge, 10, 0)
+	require.NoError(err)
+	require.Equal(uint64(0), value)
+
+	// The proposals with final results are settled on the next submission on the parameter
+	require.Nil(handle(10, false, action.NewSubmitProposal(5, alfa, NumCandidates, 7, 100000, big.NewInt(0))))
+	var pp ParameterProposals
+	require.NoError(sf.State(ParameterProposalsKey(NumCandidates), &pp))
+	require.Equal(ParameterProposals{Settled: true, Value: 5, ActivationHeight: 5, PendingIDs: []uint64{2}}, pp)
+	value, err = Parameter(sf, NumCandidates, 10, 101)
+	require.NoError(err)
+	require.Equal(uint64(5), value)
+
+	// The number of the proposals pending on a parameter is capped
+	for i := uint64(0); i < MaxPendingProposals; i++ {
+		sp := action.NewSubmitProposal(6+i, alfa, BlockSizeLimit, 1024+i, 100000, big.NewInt(0))
+		require.Nil(handle(10, false, sp))
+	}
+	sp := action.NewSubmitProposal(6+MaxPendingProposals, alfa, BlockSizeLimit, 1, 100000, big.NewInt(0))
+	requireFailure(handle(10, false, sp))
+	sp = action.NewSubmitProposal(7+MaxPendingProposals, alfa, BlockSizeLimit, 1, 100000, big.NewInt(0))
+	require.Nil(handle(20, false, sp))
+	require.NoError(sf.State(ParameterProposalsKey(BlockSizeLimit), &pp))
+	require.Equal(ParameterProposals{PendingIDs: []uint64{3 + MaxPendingProposals}}, pp)
+	var index ProposalIndex
+	require.NoError(sf.State(ProposalIndexKey, &index))
+	require.Equal(uint64(4+MaxPendingProposals), index.NextID)
+}
+
+func TestProtocol_Validate(t *testing.T) {
+	require := require.New(t)
+
+	p := NewProtocol()
+	proposer := testaddress.IotxAddrinfo["alfa"].RawAddress
+	ctx := context.Background()
+
+	require.NoError(p.Validate(ctx, action.NewSubmitProposal(1, proposer, NumCandidates, 36, 100000, big.NewInt(1))))
+	err := p.Validate(ctx, action.NewSubmitProposal(1, proposer, NumCandidates, 0, 100000, big.NewInt(1)))
+	require.Equal(ErrProposal, errors.Cause(err))
+	err = p.Validate(ctx, action.NewSubmitProposal(1, proposer, EnableGasCharge, 2, 100000, big.NewInt(1)))
+	require.Equal(ErrProposal, errors.Cause(err))
+	err = p.Validate(ctx, action.NewSubmitProposal(1, proposer, VotingPeriod, 0, 100000, big.NewInt(1)))
+	require.Equal(ErrProposal, errors.Cause(err))
+	require.NoError(p.Validate(ctx, action.NewSubmitProposal(1, proposer, ActivationDelay, 0, 100000, big.NewInt(1))))
+	require.NoError(p.Validate(ctx, action.NewSubmitProposal(1, proposer, VoterSharePercent, 100, 100000, big.NewInt(1))))
+	err = p.Validate(ctx, action.NewSubmitProposal(1, proposer, VoterSharePercent, 101, 100000, big.NewInt(1)))
+	require.Equal(ErrProposal, errors.Cause(err))
+	err = p.Validate(ctx, action.NewSubmitProposal(1, proposer, "numDelegates", 36, 100000, big.NewInt(1)))
+	require.Equal(ErrProposal, errors.Cause(err))
+	require.NoError(p.Validate(ctx, action.NewVoteProposal(1, proposer, 0, true, 100000, big.NewInt(1))))
+}
+
+func TestGenesisParameters(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	err = SetGenesisParameters(ws, Parameters{NumCandidates: 0})
+	require.Equal(ErrProposal, errors.Cause(err))
+	err = SetGenesisParameters(ws, Parameters{"numDelegates": 36})
+	require.Equal(ErrProposal, errors.Cause(err))
+	require.NoError(SetGenesisParameters(ws, Parameters{NumCandidates: 36, EnableGasCharge: 0}))
+	require.NoError(sf.Commit(ws))
+
+	var params Parameters
+	require.NoError(sf.State(GenesisParametersKey, &params))
+	require.Equal(Parameters{NumCandidates: 36, EnableGasCharge: 0}, params)
+
+	// The genesis values override the default values
+	value, err := Parameter(sf, NumCandidates, 1, 101)
+	require.NoError(err)
+	require.Equal(uint64(36), value)
+	value, err = Parameter(sf, EnableGasCharge, 1, 1)
+	require.NoError(err)
+	require.Equal(uint64(0), value)
+	value, err = Parameter(sf, "unknown", 1, 7)
+	require.NoError(err)
+	require.Equal(uint64(7), value)
+}
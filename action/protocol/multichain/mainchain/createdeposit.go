@@ -14,6 +14,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/pkg/enc"
 	"github.com/iotexproject/iotex-core/pkg/hash"
@@ -42,12 +43,16 @@ func (p *Protocol) Deposit(subChainAddr address.Address, depositIndex uint64) (*
 	return &deposit, nil
 }
 
-func (p *Protocol) handleDeposit(deposit *action.CreateDeposit, sm protocol.StateManager) (*action.Receipt, error) {
+func (p *Protocol) handleDeposit(
+	deposit *action.CreateDeposit,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
 	account, subChainInOp, err := p.validateDeposit(deposit, sm)
 	if err != nil {
 		return nil, err
 	}
-	return p.mutateDeposit(deposit, account, subChainInOp, sm)
+	return p.mutateDeposit(deposit, account, subChainInOp, raCtx, sm)
 }
 
 func (p *Protocol) validateDeposit(deposit *action.CreateDeposit, sm protocol.StateManager) (*state.Account, InOperation, error) {
@@ -78,6 +83,7 @@ func (p *Protocol) mutateDeposit(
 	deposit *action.CreateDeposit,
 	acct *state.Account,
 	subChainInOp InOperation,
+	raCtx protocol.RunActionsCtx,
 	sm protocol.StateManager,
 ) (*action.Receipt, error) {
 	// Subtract the balance from sender account
@@ -87,6 +93,14 @@ func (p *Protocol) mutateDeposit(
 	if err := account.StoreAccount(sm, deposit.Sender(), acct); err != nil {
 		return nil, err
 	}
+	if err := candidatesutil.SyncVoteeWeight(
+		raCtx,
+		sm,
+		deposit.Sender(),
+		big.NewInt(0).Neg(deposit.Amount()),
+	); err != nil {
+		return nil, err
+	}
 
 	// Update sub-chain state
 	addr, err := address.BytesToAddress(subChainInOp.Addr)
@@ -157,6 +157,7 @@ func TestMutateDeposit(t *testing.T) {
 			ID:   2,
 			Addr: address.New(1, subChainAddr[:]).Bytes(),
 		},
+		protocol.RunActionsCtx{},
 		ws,
 	)
 	require.NoError(t, err)
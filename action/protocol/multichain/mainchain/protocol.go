@@ -47,10 +47,14 @@ func NewProtocol(rootChain blockchain.Blockchain) *Protocol {
 }
 
 // Handle handles how to mutate the state db given the multi-chain action on main-chain
-func (p *Protocol) Handle(_ context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	raCtx, ok := protocol.GetRunActionsCtx(ctx)
+	if !ok {
+		return nil, errors.New("failed to get action context")
+	}
 	switch act := act.(type) {
 	case *action.StartSubChain:
-		if err := p.handleStartSubChain(act, sm); err != nil {
+		if err := p.handleStartSubChain(act, raCtx, sm); err != nil {
 			return nil, errors.Wrapf(err, "error when handling start sub-chain action")
 		}
 	case *action.PutBlock:
@@ -58,7 +62,7 @@ func (p *Protocol) Handle(_ context.Context, act action.Action, sm protocol.Stat
 			return nil, errors.Wrapf(err, "error when handling put sub-chain block action")
 		}
 	case *action.CreateDeposit:
-		deposit, err := p.handleDeposit(act, sm)
+		deposit, err := p.handleDeposit(act, raCtx, sm)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error when handling deposit creation action")
 		}
@@ -29,9 +29,10 @@ func TestAddSubChainActions(t *testing.T) {
 
 	ctx := context.Background()
 	cfg := config.Default
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(t, err)
 	require.NoError(t, bc.Start(ctx))
-	_, err := bc.CreateState(
+	_, err = bc.CreateState(
 		testaddress.IotxAddrinfo["producer"].RawAddress,
 		big.NewInt(0).Mul(big.NewInt(10000000000), big.NewInt(blockchain.Iotx)),
 	)
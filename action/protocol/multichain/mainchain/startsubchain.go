@@ -14,6 +14,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/pkg/enc"
 	"github.com/iotexproject/iotex-core/pkg/hash"
@@ -21,12 +22,16 @@ import (
 	"github.com/iotexproject/iotex-core/state"
 )
 
-func (p *Protocol) handleStartSubChain(start *action.StartSubChain, sm protocol.StateManager) error {
+func (p *Protocol) handleStartSubChain(
+	start *action.StartSubChain,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) error {
 	account, subChainsInOp, err := p.validateStartSubChain(start, sm)
 	if err != nil {
 		return err
 	}
-	return p.mutateSubChainState(start, account, subChainsInOp, sm)
+	return p.mutateSubChainState(start, account, subChainsInOp, raCtx, sm)
 }
 
 func (p *Protocol) validateStartSubChain(
@@ -64,6 +69,7 @@ func (p *Protocol) mutateSubChainState(
 	start *action.StartSubChain,
 	acct *state.Account,
 	subChainsInOp SubChainsInOperation,
+	raCtx protocol.RunActionsCtx,
 	sm protocol.StateManager,
 ) error {
 	addr, err := createSubChainAddress(start.OwnerAddress(), start.Nonce())
@@ -90,6 +96,10 @@ func (p *Protocol) mutateSubChainState(
 	if err := account.StoreAccount(sm, start.OwnerAddress(), acct); err != nil {
 		return err
 	}
+	deposits := big.NewInt(0).Add(start.SecurityDeposit(), start.OperationDeposit())
+	if err := candidatesutil.SyncVoteeWeight(raCtx, sm, start.OwnerAddress(), deposits.Neg(deposits)); err != nil {
+		return err
+	}
 	subChainsInOp = subChainsInOp.Append(InOperation{
 		ID:   start.ChainID(),
 		Addr: address.New(p.rootChain.ChainID(), addr[:]).Bytes(),
@@ -297,8 +297,9 @@ func TestHandleStartSubChain(t *testing.T) {
 	require.NoError(t, err)
 
 	// Handle the action
+	raCtx := protocol.RunActionsCtx{}
 	protocol := NewProtocol(chain)
-	require.NoError(t, protocol.handleStartSubChain(start, ws))
+	require.NoError(t, protocol.handleStartSubChain(start, raCtx, ws))
 	require.NoError(t, sf.Commit(ws))
 
 	// Check the owner state
@@ -326,7 +327,8 @@ func TestNoStartSubChainInGenesis(t *testing.T) {
 	cfg := config.Default
 
 	ctx := context.Background()
-	bc := blockchain.NewBlockchain(cfg, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(cfg, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(t, err)
 	p := NewProtocol(bc)
 	bc.GetFactory().AddActionHandlers(p)
 	require.NoError(t, bc.Start(ctx))
@@ -342,7 +344,8 @@ func TestStartSubChainInGenesis(t *testing.T) {
 	cfg.Chain.EnableSubChainStartInGenesis = true
 
 	ctx := context.Background()
-	bc := blockchain.NewBlockchain(cfg, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(cfg, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(t, err)
 	p := NewProtocol(bc)
 	bc.GetFactory().AddActionHandlers(p)
 	require.NoError(t, bc.Start(ctx))
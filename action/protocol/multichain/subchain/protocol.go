@@ -16,6 +16,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/explorer/idl/explorer"
@@ -42,13 +43,17 @@ func NewProtocol(chain blockchain.Blockchain, mainChainAPI explorer.Explorer) *P
 }
 
 // Handle handles how to mutate the state db given the multi-chain action on sub-chain
-func (p *Protocol) Handle(_ context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	raCtx, ok := protocol.GetRunActionsCtx(ctx)
+	if !ok {
+		return nil, errors.New("failed to get action context")
+	}
 	switch act := act.(type) {
 	case *action.SettleDeposit:
 		if err := p.validateDeposit(act, sm); err != nil {
 			return nil, errors.Wrapf(err, "error when handling deposit settlement action")
 		}
-		if err := p.mutateDeposit(act, sm); err != nil {
+		if err := p.mutateDeposit(act, raCtx, sm); err != nil {
 			return nil, errors.Wrapf(err, "error when handling deposit settlement action")
 		}
 	}
@@ -99,7 +104,11 @@ func (p *Protocol) validateDeposit(deposit *action.SettleDeposit, sm protocol.St
 	}
 }
 
-func (p *Protocol) mutateDeposit(deposit *action.SettleDeposit, sm protocol.StateManager) error {
+func (p *Protocol) mutateDeposit(
+	deposit *action.SettleDeposit,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) error {
 	// Update the deposit index
 	depositAddr := depositAddress(deposit.Index())
 	var depositIndex DepositIndex
@@ -125,7 +134,10 @@ func (p *Protocol) mutateDeposit(deposit *action.SettleDeposit, sm protocol.Stat
 	if err := recipient.AddBalance(deposit.Amount()); err != nil {
 		return err
 	}
-	return account.StoreAccount(sm, deposit.Recipient(), recipient)
+	if err := account.StoreAccount(sm, deposit.Recipient(), recipient); err != nil {
+		return err
+	}
+	return candidatesutil.SyncVoteeWeight(raCtx, sm, deposit.Recipient(), deposit.Amount())
 }
 
 func depositAddress(index uint64) hash.PKHash {
@@ -17,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/explorer/idl/explorer"
@@ -28,7 +29,8 @@ import (
 func TestValidateDeposit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ctx := context.Background()
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(t, err)
 	require.NoError(t, bc.Start(ctx))
 	exp := mock_explorer.NewMockExplorer(ctrl)
 
@@ -49,7 +51,7 @@ func TestValidateDeposit(t *testing.T) {
 	}()
 
 	exp.EXPECT().GetDeposits(gomock.Any(), gomock.Any(), gomock.Any()).Return([]explorer.Deposit{}, nil).Times(1)
-	err := protocol.validateDeposit(deposit, nil)
+	err = protocol.validateDeposit(deposit, nil)
 	require.Error(t, err)
 	assert.True(t, strings.Contains(err.Error(), "deposits found instead of"))
 
@@ -89,11 +91,12 @@ func TestValidateDeposit(t *testing.T) {
 func TestMutateDeposit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	ctx := context.Background()
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(t, err)
 	require.NoError(t, bc.Start(ctx))
 	exp := mock_explorer.NewMockExplorer(ctrl)
 
-	protocol := NewProtocol(bc, exp)
+	p := NewProtocol(bc, exp)
 	deposit := action.NewSettleDeposit(
 		1,
 		big.NewInt(1000),
@@ -111,7 +114,7 @@ func TestMutateDeposit(t *testing.T) {
 
 	ws, err := bc.GetFactory().NewWorkingSet()
 	require.NoError(t, err)
-	require.NoError(t, protocol.mutateDeposit(deposit, ws))
+	require.NoError(t, p.mutateDeposit(deposit, protocol.RunActionsCtx{}, ws))
 	require.NoError(t, bc.GetFactory().Commit(ws))
 
 	account1, err := bc.GetFactory().AccountState(testaddress.IotxAddrinfo["producer"].RawAddress)
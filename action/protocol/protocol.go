@@ -9,7 +9,10 @@ package protocol
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/state"
@@ -26,6 +29,20 @@ type ActionValidator interface {
 	Validate(context.Context, action.Action) error
 }
 
+// ValidateAction validates the action with the validator. The payloads of a batch are validated one by one.
+func ValidateAction(ctx context.Context, validator ActionValidator, act action.Action) error {
+	batch, ok := act.(*action.Batch)
+	if !ok {
+		return validator.Validate(ctx, act)
+	}
+	for _, payload := range batch.Payloads() {
+		if err := validator.Validate(ctx, payload); err != nil {
+			return errors.Wrap(err, "error when validating payload of batch")
+		}
+	}
+	return nil
+}
+
 // ActionEnvelopeValidator is the interface of validating an action
 type ActionEnvelopeValidator interface {
 	Validate(context.Context, action.SealedEnvelope) error
@@ -48,6 +65,10 @@ type ChainManager interface {
 	StateByAddr(address string) (*state.Account, error)
 	// Nonce returns the nonce if the account exists
 	Nonce(addr string) (uint64, error)
+	// TipHeight returns tip block's height
+	TipHeight() uint64
+	// ForkSchedule returns the fork schedule of the genesis spec
+	ForkSchedule() genesis.ForkSchedule
 }
 
 // StateManager defines the state DB interface atop IoTeX blockchain
@@ -0,0 +1,127 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package reward
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action/protocol/reward/rewardpb"
+)
+
+// Account is the reward account of a delegate or a voter, which holds the rewards granted but not claimed yet
+type Account struct {
+	Balance *big.Int
+}
+
+// Serialize serializes account to binary.
+func (a Account) Serialize() ([]byte, error) {
+	gen := &rewardpb.Account{}
+	if a.Balance != nil {
+		gen.Balance = a.Balance.Bytes()
+	}
+	return proto.Marshal(gen)
+}
+
+// Deserialize deserializes binary to account.
+func (a *Account) Deserialize(data []byte) error {
+	gen := &rewardpb.Account{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	a.Balance = big.NewInt(0).SetBytes(gen.Balance)
+	return nil
+}
+
+// Production is the number of blocks a producer has produced in an epoch
+type Production struct {
+	Producer string
+	Count    uint64
+}
+
+// Productivity is the productions of an epoch
+type Productivity []Production
+
+// Total returns the total number of blocks produced
+func (p Productivity) Total() uint64 {
+	var total uint64
+	for _, prod := range p {
+		total += prod.Count
+	}
+	return total
+}
+
+// Add counts a block produced by the producer
+func (p Productivity) Add(producer string) Productivity {
+	for i := range p {
+		if p[i].Producer == producer {
+			p[i].Count++
+			return p
+		}
+	}
+	return append(p, Production{Producer: producer, Count: 1})
+}
+
+// Serialize serializes productivity to binary.
+func (p Productivity) Serialize() ([]byte, error) {
+	productions := make([]*rewardpb.Production, len(p))
+	for i := range p {
+		productions[i] = &rewardpb.Production{Producer: p[i].Producer, Count: p[i].Count}
+	}
+	return proto.Marshal(&rewardpb.Productivity{Productions: productions})
+}
+
+// Deserialize deserializes binary to productivity.
+func (p *Productivity) Deserialize(data []byte) error {
+	gen := &rewardpb.Productivity{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	productivity := make(Productivity, len(gen.Productions))
+	for i, prod := range gen.Productions {
+		productivity[i] = Production{Producer: prod.Producer, Count: prod.Count}
+	}
+	*p = productivity
+	return nil
+}
+
+// Voters is the list of accounts which have voted for a delegate, in the order of their first vote
+type Voters []string
+
+// Contains returns true if the voter is in the list
+func (vs Voters) Contains(voter string) bool {
+	for _, v := range vs {
+		if v == voter {
+			return true
+		}
+	}
+	return false
+}
+
+// Add appends the voter to the list if it's not there yet
+func (vs Voters) Add(voter string) Voters {
+	if vs.Contains(voter) {
+		return vs
+	}
+	return append(vs, voter)
+}
+
+// Serialize serializes voters to binary.
+func (vs Voters) Serialize() ([]byte, error) {
+	return proto.Marshal(&rewardpb.Voters{Voters: vs})
+}
+
+// Deserialize deserializes binary to voters.
+func (vs *Voters) Deserialize(data []byte) error {
+	gen := &rewardpb.Voters{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	*vs = gen.Voters
+	return nil
+}
@@ -0,0 +1,416 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package reward
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// MaxVotersPerDelegate is the max number of the voters recorded for a delegate to share its epoch rewards, so that the
+// cost of granting the epoch rewards is bounded
+const MaxVotersPerDelegate = 256
+
+// ErrReward indicates error for a reward claim
+var ErrReward = errors.New("invalid reward claim")
+
+// ProductivityKey is the key of the productivity of the current epoch in the state factory
+var ProductivityKey = byteutil.BytesTo20B(hash.Hash160b([]byte("reward.productivity")))
+
+// Protocol defines the protocol of granting the block rewards and the epoch rewards out of the reward pool, and paying
+// out the rewards claimed
+type Protocol struct {
+	pool        string
+	epochLength uint64
+}
+
+// NewProtocol instantiates the protocol of rewards. The epoch length is defined in the genesis spec, and the rewards
+// are governed by the parameters of the chain.
+func NewProtocol(cfg config.Config) (*Protocol, error) {
+	spec, err := blockchain.GenesisSpec(cfg.Chain)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load genesis spec")
+	}
+	epochLength := spec.EpochLength
+	if epochLength == 0 {
+		epochLength = genesis.DefaultEpochLength
+	}
+	return &Protocol{
+		pool:        PoolAddress(cfg.Chain.ID),
+		epochLength: epochLength,
+	}, nil
+}
+
+// Handle handles how to mutate the state db given the coinbase transfers, the votes and the reward claims
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	raCtx, ok := protocol.GetRunActionsCtx(ctx)
+	if !ok {
+		return nil, errors.New("failed to get action context")
+	}
+	// No reward is granted or recorded before the feature is active
+	if !raCtx.IsActive(genesis.Reward) {
+		return nil, nil
+	}
+	switch act := act.(type) {
+	case *action.Transfer:
+		if !act.IsCoinbase() {
+			return nil, nil
+		}
+		if err := p.grantBlockReward(raCtx, sm); err != nil {
+			return nil, errors.Wrap(err, "error when granting block reward")
+		}
+	case *action.Vote:
+		if err := p.handleVote(act, sm); err != nil {
+			return nil, errors.Wrap(err, "error when recording voter")
+		}
+	case *action.ClaimReward:
+		if err := p.handleClaimReward(act, raCtx, sm); err != nil {
+			return nil, errors.Wrap(err, "error when handling reward claim action")
+		}
+	}
+	// The action is not handled by this handler or no error
+	return nil, nil
+}
+
+// Validate validates the reward claim actions
+func (p *Protocol) Validate(_ context.Context, act action.Action) error {
+	cr, ok := act.(*action.ClaimReward)
+	if !ok {
+		return nil
+	}
+	if cr.Amount() == nil || cr.Amount().Sign() <= 0 {
+		return errors.Wrap(ErrReward, "non-positive amount")
+	}
+	return nil
+}
+
+// PoolAddress returns the address of the reward pool on the chain. The pool is funded by transferring to the address.
+func PoolAddress(chainID uint32) string {
+	return address.New(chainID, hash.Hash160b([]byte("reward.pool"))).IotxAddress()
+}
+
+// UnclaimedBalance returns the confirmed rewards granted to the address but not claimed yet
+func UnclaimedBalance(sf factory.Factory, addr string) (*big.Int, error) {
+	key, err := AccountKey(addr)
+	if err != nil {
+		return nil, err
+	}
+	var acct Account
+	if err := sf.State(key, &acct); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return big.NewInt(0), nil
+		}
+		return nil, errors.Wrapf(err, "error when loading reward account of %s", addr)
+	}
+	return acct.Balance, nil
+}
+
+// AccountKey returns the key of the reward account of the address in the state factory
+func AccountKey(addr string) (hash.PKHash, error) {
+	return addressKey("reward.account.", addr)
+}
+
+// VotersKey returns the key of the voters of the delegate in the state factory
+func VotersKey(delegate string) (hash.PKHash, error) {
+	return addressKey("reward.voters.", delegate)
+}
+
+func addressKey(prefix string, addr string) (hash.PKHash, error) {
+	addrHash, err := iotxaddress.AddressToPKHash(addr)
+	if err != nil {
+		return hash.ZeroPKHash, errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	var stream []byte
+	stream = append(stream, []byte(prefix)...)
+	stream = append(stream, addrHash[:]...)
+	return byteutil.BytesTo20B(hash.Hash160b(stream)), nil
+}
+
+// grantBlockReward grants the block reward to the producer, and counts the block in the productivity of the epoch.
+// At the end of an epoch, the epoch reward is split among the producers of the epoch by the blocks they produced.
+func (p *Protocol) grantBlockReward(raCtx protocol.RunActionsCtx, sm protocol.StateManager) error {
+	pool, err := account.LoadOrCreateAccount(sm, p.pool, big.NewInt(0))
+	if err != nil {
+		return errors.Wrap(err, "failed to load or create the account of reward pool")
+	}
+	blockReward, err := rewardParameter(sm, governance.BlockReward, raCtx.BlockHeight, genesis.BlockReward)
+	if err != nil {
+		return err
+	}
+	blockReward = minBig(blockReward, pool.Balance)
+	if err := p.grant(sm, raCtx.ProducerAddr, blockReward); err != nil {
+		return err
+	}
+	if err := pool.SubBalance(blockReward); err != nil {
+		return errors.Wrap(err, "failed to update the Balance of reward pool")
+	}
+	var productivity Productivity
+	recorded := true
+	if err := sm.State(ProductivityKey, &productivity); err != nil {
+		if errors.Cause(err) != state.ErrStateNotExist {
+			return errors.Wrap(err, "error when loading productivity")
+		}
+		recorded = false
+	}
+	productivity = productivity.Add(raCtx.ProducerAddr)
+	if raCtx.BlockHeight == 0 || raCtx.BlockHeight%p.epochLength != 0 {
+		if err := sm.PutState(ProductivityKey, productivity); err != nil {
+			return err
+		}
+		return account.StoreAccount(sm, p.pool, pool)
+	}
+
+	epochReward, err := rewardParameter(sm, governance.EpochReward, raCtx.BlockHeight, genesis.EpochReward)
+	if err != nil {
+		return err
+	}
+	voterSharePercent, err := governance.Parameter(
+		sm,
+		governance.VoterSharePercent,
+		raCtx.BlockHeight,
+		genesis.VoterSharePercent,
+	)
+	if err != nil {
+		return err
+	}
+	epochReward = minBig(epochReward, pool.Balance)
+	total := big.NewInt(0).SetUint64(productivity.Total())
+	for _, prod := range productivity {
+		share := big.NewInt(0).SetUint64(prod.Count)
+		share.Mul(share, epochReward).Div(share, total)
+		if err := p.grantDelegate(sm, prod.Producer, share, voterSharePercent); err != nil {
+			return err
+		}
+		if err := pool.SubBalance(share); err != nil {
+			return errors.Wrap(err, "failed to update the Balance of reward pool")
+		}
+	}
+	if recorded {
+		if err := sm.DelState(ProductivityKey); err != nil {
+			return err
+		}
+	}
+	return account.StoreAccount(sm, p.pool, pool)
+}
+
+// grantDelegate grants the epoch reward share to the delegate. If the voter share is set, that percentage of it is
+// split among the voters still voting for the delegate, in proportion to their contributions to its voting weight.
+func (p *Protocol) grantDelegate(
+	sm protocol.StateManager,
+	delegate string,
+	reward *big.Int,
+	voterSharePercent uint64,
+) error {
+	remaining := big.NewInt(0).Set(reward)
+	if voterSharePercent > 0 {
+		voters, weights, err := p.currentVoters(sm, delegate)
+		if err != nil {
+			return err
+		}
+		totalWeight := big.NewInt(0)
+		for _, w := range weights {
+			totalWeight.Add(totalWeight, w)
+		}
+		if totalWeight.Sign() > 0 {
+			voterShare := big.NewInt(0).SetUint64(voterSharePercent)
+			voterShare.Mul(voterShare, reward).Div(voterShare, big.NewInt(100))
+			for i, voter := range voters {
+				r := big.NewInt(0).Mul(voterShare, weights[i])
+				r.Div(r, totalWeight)
+				if err := p.grant(sm, voter, r); err != nil {
+					return err
+				}
+				remaining.Sub(remaining, r)
+			}
+		}
+	}
+	return p.grant(sm, delegate, remaining)
+}
+
+// currentVoters returns the voters still voting for the delegate and their balances, and prunes the ones which have
+// changed their votes
+func (p *Protocol) currentVoters(sm protocol.StateManager, delegate string) (Voters, []*big.Int, error) {
+	key, err := VotersKey(delegate)
+	if err != nil {
+		return nil, nil, err
+	}
+	var voters Voters
+	if err := sm.State(key, &voters); err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return nil, nil, nil
+		}
+		return nil, nil, errors.Wrapf(err, "error when loading voters of %s", delegate)
+	}
+	return pruneVoters(sm, key, delegate, voters)
+}
+
+// pruneVoters removes the voters which have changed their votes from the voters of the delegate, and returns the rest
+// with their balances
+func pruneVoters(
+	sm protocol.StateManager,
+	key hash.PKHash,
+	delegate string,
+	voters Voters,
+) (Voters, []*big.Int, error) {
+	var current Voters
+	var weights []*big.Int
+	for _, voter := range voters {
+		addrHash, err := iotxaddress.AddressToPKHash(voter)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to convert address to public key hash")
+		}
+		acct, err := account.LoadAccount(sm, addrHash)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to load the account of voter %s", voter)
+		}
+		if acct.Votee != delegate {
+			continue
+		}
+		current = append(current, voter)
+		weights = append(weights, acct.Balance)
+	}
+	if len(current) == len(voters) {
+		return current, weights, nil
+	}
+	if len(current) == 0 {
+		return nil, nil, sm.DelState(key)
+	}
+	return current, weights, sm.PutState(key, current)
+}
+
+// grant adds the reward to the reward account of the address
+func (p *Protocol) grant(sm protocol.StateManager, addr string, reward *big.Int) error {
+	if reward.Sign() == 0 {
+		return nil
+	}
+	key, err := AccountKey(addr)
+	if err != nil {
+		return err
+	}
+	acct, err := loadAccount(sm, key)
+	if err != nil {
+		return err
+	}
+	acct.Balance.Add(acct.Balance, reward)
+	return sm.PutState(key, acct)
+}
+
+func (p *Protocol) handleVote(v *action.Vote, sm protocol.StateManager) error {
+	if v.Votee() == "" {
+		return nil
+	}
+	key, err := VotersKey(v.Votee())
+	if err != nil {
+		return err
+	}
+	var voters Voters
+	if err := sm.State(key, &voters); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return errors.Wrapf(err, "error when loading voters of %s", v.Votee())
+	}
+	if voters.Contains(v.Voter()) {
+		return nil
+	}
+	if len(voters) >= MaxVotersPerDelegate {
+		// Make room by pruning the voters which have changed their votes. If there is still no room, the voter doesn't
+		// share the epoch rewards of the delegate.
+		if voters, _, err = pruneVoters(sm, key, v.Votee(), voters); err != nil {
+			return err
+		}
+		if len(voters) >= MaxVotersPerDelegate {
+			return nil
+		}
+	}
+	return sm.PutState(key, voters.Add(v.Voter()))
+}
+
+func (p *Protocol) handleClaimReward(
+	cr *action.ClaimReward,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) error {
+	claimer, err := account.LoadOrCreateAccount(sm, cr.Claimer(), big.NewInt(0))
+	if err != nil {
+		return errors.Wrapf(err, "failed to load or create the account of claimer %s", cr.Claimer())
+	}
+	gasFee, tip, err := account.ChargeGas(cr, cr.Claimer(), claimer, raCtx, sm)
+	if err != nil {
+		return err
+	}
+	key, err := AccountKey(cr.Claimer())
+	if err != nil {
+		return err
+	}
+	acct, err := loadAccount(sm, key)
+	if err != nil {
+		return err
+	}
+	amount := minBig(cr.Amount(), acct.Balance)
+	if err := claimer.AddBalance(amount); err != nil {
+		return errors.Wrapf(err, "failed to update the Balance of claimer %s", cr.Claimer())
+	}
+	account.SetNonce(cr, claimer)
+	if err := account.StoreAccount(sm, cr.Claimer(), claimer); err != nil {
+		return errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if err := account.UpdateVoteeWeights(
+		sm,
+		cr.Claimer(),
+		big.NewInt(0).Sub(amount, gasFee),
+		raCtx.ProducerAddr,
+		tip,
+	); err != nil {
+		return err
+	}
+	if amount.Sign() == 0 {
+		return nil
+	}
+	acct.Balance.Sub(acct.Balance, amount)
+	if acct.Balance.Sign() == 0 {
+		return sm.DelState(key)
+	}
+	return sm.PutState(key, acct)
+}
+
+// rewardParameter returns the reward in rau at the height, which is governed in IOTX
+func rewardParameter(sr governance.StateReader, name string, height uint64, defaultValue uint64) (*big.Int, error) {
+	iotx, err := governance.Parameter(sr, name, height, defaultValue)
+	if err != nil {
+		return nil, err
+	}
+	return big.NewInt(0).Mul(big.NewInt(0).SetUint64(iotx), big.NewInt(1e18)), nil
+}
+
+func loadAccount(sm protocol.StateManager, key hash.PKHash) (*Account, error) {
+	acct := Account{Balance: big.NewInt(0)}
+	if err := sm.State(key, &acct); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return nil, errors.Wrapf(err, "error when loading reward account %x", key)
+	}
+	return &acct, nil
+}
+
+func minBig(a, b *big.Int) *big.Int {
+	if a.Cmp(b) <= 0 {
+		return big.NewInt(0).Set(a)
+	}
+	return big.NewInt(0).Set(b)
+}
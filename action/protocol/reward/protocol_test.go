@@ -0,0 +1,279 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package reward
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestRewardState(t *testing.T) {
+	t.Parallel()
+
+	acct1 := Account{Balance: big.NewInt(100)}
+	data, err := acct1.Serialize()
+	require.NoError(t, err)
+	var acct2 Account
+	require.NoError(t, acct2.Deserialize(data))
+	require.Equal(t, acct1, acct2)
+
+	var prod1 Productivity
+	prod1 = prod1.Add("a").Add("b").Add("a")
+	require.Equal(t, Productivity{{Producer: "a", Count: 2}, {Producer: "b", Count: 1}}, prod1)
+	require.Equal(t, uint64(3), prod1.Total())
+	data, err = prod1.Serialize()
+	require.NoError(t, err)
+	var prod2 Productivity
+	require.NoError(t, prod2.Deserialize(data))
+	require.Equal(t, prod1, prod2)
+
+	var voters1 Voters
+	voters1 = voters1.Add("a").Add("b").Add("a")
+	require.Equal(t, Voters{"a", "b"}, voters1)
+	require.True(t, voters1.Contains("b"))
+	require.False(t, voters1.Contains("c"))
+	data, err = voters1.Serialize()
+	require.NoError(t, err)
+	var voters2 Voters
+	require.NoError(t, voters2.Deserialize(data))
+	require.Equal(t, voters1, voters2)
+}
+
+func TestProtocol_Handle(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	cfg := config.Default
+	p, err := NewProtocol(cfg)
+	require.NoError(err)
+	require.Equal(genesis.DefaultEpochLength, p.epochLength)
+	p.epochLength = 2
+
+	alfa := testaddress.IotxAddrinfo["alfa"].RawAddress
+	bravo := testaddress.IotxAddrinfo["bravo"].RawAddress
+	charlie := testaddress.IotxAddrinfo["charlie"].RawAddress
+	delta := testaddress.IotxAddrinfo["delta"].RawAddress
+	echo := testaddress.IotxAddrinfo["echo"].RawAddress
+	producer := testaddress.IotxAddrinfo["producer"].RawAddress
+	putAccount := func(ws factory.WorkingSet, addr string, acct *state.Account) {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		require.NoError(ws.PutState(pkHash, acct))
+	}
+	accountOf := func(addr string) state.Account {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		var acct state.Account
+		require.NoError(sf.State(pkHash, &acct))
+		return acct
+	}
+	unclaimedOf := func(addr string) *big.Int {
+		balance, err := UnclaimedBalance(sf, addr)
+		require.NoError(err)
+		return balance
+	}
+	iotx := func(n int64) *big.Int {
+		return big.NewInt(0).Mul(big.NewInt(n), big.NewInt(1e18))
+	}
+	gasLimit := uint64(1000000)
+	runCtx := func(height uint64, producer string, enableGasCharge bool) context.Context {
+		return protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+			BlockHeight:     height,
+			ProducerAddr:    producer,
+			GasLimit:        &gasLimit,
+			EnableGasCharge: enableGasCharge,
+			ForkSchedule:    genesis.ForkSchedule{genesis.Reward: 1},
+		})
+	}
+
+	// Nothing is granted or recorded before the feature is active
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	putAccount(ws, PoolAddress(cfg.Chain.ID), &state.Account{Balance: iotx(1000), VotingWeight: big.NewInt(0)})
+	_, err = p.Handle(runCtx(0, alfa, false), action.NewCoinBaseTransfer(0, big.NewInt(0), alfa), ws)
+	require.NoError(err)
+	v, err := action.NewVote(1, charlie, bravo, 100000, big.NewInt(0))
+	require.NoError(err)
+	_, err = p.Handle(runCtx(0, producer, false), v, ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	require.Equal(big.NewInt(0), unclaimedOf(alfa))
+	require.Equal(iotx(1000), accountOf(PoolAddress(cfg.Chain.ID)).Balance)
+	key, err := VotersKey(bravo)
+	require.NoError(err)
+	var voters Voters
+	require.Equal(state.ErrStateNotExist, errors.Cause(sf.State(key, &voters)))
+
+	// Set the rewards, and record the voters of bravo, of which echo has changed its vote to alfa since
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	require.NoError(governance.SetGenesisParameters(ws, governance.Parameters{
+		governance.BlockReward:       10,
+		governance.EpochReward:       100,
+		governance.VoterSharePercent: 50,
+	}))
+	putAccount(ws, alfa, &state.Account{Balance: big.NewInt(20000), VotingWeight: big.NewInt(0)})
+	putAccount(ws, charlie, &state.Account{Balance: big.NewInt(300), VotingWeight: big.NewInt(0), Votee: bravo})
+	putAccount(ws, delta, &state.Account{Balance: big.NewInt(100), VotingWeight: big.NewInt(0), Votee: bravo})
+	putAccount(ws, echo, &state.Account{Balance: big.NewInt(500), VotingWeight: big.NewInt(0), Votee: alfa})
+	for i, voter := range []string{charlie, delta, echo} {
+		v, err := action.NewVote(uint64(i+1), voter, bravo, 100000, big.NewInt(0))
+		require.NoError(err)
+		_, err = p.Handle(runCtx(1, producer, false), v, ws)
+		require.NoError(err)
+	}
+
+	// Block 1 is produced by alfa, and block 2 by bravo, which ends the epoch
+	_, err = p.Handle(runCtx(1, alfa, false), action.NewCoinBaseTransfer(1, big.NewInt(0), alfa), ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	require.Equal(iotx(10), unclaimedOf(alfa))
+	require.Equal(iotx(990), accountOf(PoolAddress(cfg.Chain.ID)).Balance)
+	var prod Productivity
+	require.NoError(sf.State(ProductivityKey, &prod))
+	require.Equal(Productivity{{Producer: alfa, Count: 1}}, prod)
+
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	_, err = p.Handle(runCtx(2, bravo, false), action.NewCoinBaseTransfer(2, big.NewInt(0), bravo), ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	require.Equal(iotx(880), accountOf(PoolAddress(cfg.Chain.ID)).Balance)
+	require.Equal(iotx(60), unclaimedOf(alfa))
+	// Half of the epoch reward of bravo is shared with charlie and delta by 3:1
+	share := big.NewInt(0).Div(iotx(75), big.NewInt(4))
+	require.Equal(share, unclaimedOf(charlie))
+	require.Equal(big.NewInt(0).Div(iotx(25), big.NewInt(4)), unclaimedOf(delta))
+	require.Equal(big.NewInt(0), unclaimedOf(echo))
+	require.Equal(iotx(25), unclaimedOf(bravo))
+	require.Equal(state.ErrStateNotExist, errors.Cause(sf.State(ProductivityKey, &prod)))
+	require.NoError(sf.State(key, &voters))
+	require.Equal(Voters{charlie, delta}, voters)
+
+	// Claim part of the rewards, and then claim more than the rest
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	_, err = p.Handle(runCtx(3, producer, true), action.NewClaimReward(1, alfa, iotx(40), 100000, big.NewInt(1)), ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	a := accountOf(alfa)
+	require.Equal(big.NewInt(0).Add(iotx(40), big.NewInt(20000-int64(action.ClaimRewardIntrinsicGas))), a.Balance)
+	require.Equal(uint64(1), a.Nonce)
+	require.Equal(big.NewInt(int64(action.ClaimRewardIntrinsicGas)), accountOf(producer).Balance)
+	require.Equal(iotx(20), unclaimedOf(alfa))
+
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	_, err = p.Handle(runCtx(4, producer, false), action.NewClaimReward(1, charlie, iotx(100), 100000, big.NewInt(1)), ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	require.Equal(big.NewInt(0).Add(share, big.NewInt(300)), accountOf(charlie).Balance)
+	require.Equal(share, accountOf(bravo).VotingWeight)
+	require.Equal(big.NewInt(0), unclaimedOf(charlie))
+}
+
+func TestProtocol_HandleVoteCap(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	p := &Protocol{}
+
+	delegate := testaddress.IotxAddrinfo["bravo"].RawAddress
+	voter := testaddress.IotxAddrinfo["alfa"].RawAddress
+	stale := testaddress.IotxAddrinfo["charlie"].RawAddress
+	key, err := VotersKey(delegate)
+	require.NoError(err)
+	putVoter := func(ws factory.WorkingSet, addr string) {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		require.NoError(ws.PutState(pkHash, &state.Account{
+			Balance:      big.NewInt(1),
+			VotingWeight: big.NewInt(0),
+			Votee:        delegate,
+		}))
+	}
+	voters := make(Voters, MaxVotersPerDelegate)
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	for i := range voters {
+		voters[i] = address.New(config.Default.Chain.ID, hash.Hash160b([]byte{byte(i), byte(i >> 8)})).IotxAddress()
+		putVoter(ws, voters[i])
+	}
+	voters[0] = stale
+	require.NoError(ws.PutState(key, voters))
+	ctx = protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+		ForkSchedule: genesis.ForkSchedule{genesis.Reward: 0},
+	})
+
+	// The voter takes the place of the stale one which doesn't vote for the delegate
+	v, err := action.NewVote(1, voter, delegate, 100000, big.NewInt(0))
+	require.NoError(err)
+	_, err = p.Handle(ctx, v, ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	var current Voters
+	require.NoError(sf.State(key, &current))
+	require.Equal(MaxVotersPerDelegate, len(current))
+	require.False(current.Contains(stale))
+	require.True(current.Contains(voter))
+
+	// No more voter is recorded once the list is full of current voters
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	putVoter(ws, voter)
+	v, err = action.NewVote(1, stale, delegate, 100000, big.NewInt(0))
+	require.NoError(err)
+	_, err = p.Handle(ctx, v, ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	require.NoError(sf.State(key, &current))
+	require.Equal(MaxVotersPerDelegate, len(current))
+	require.False(current.Contains(stale))
+}
+
+func TestProtocol_Validate(t *testing.T) {
+	require := require.New(t)
+
+	p := &Protocol{}
+	claimer := testaddress.IotxAddrinfo["alfa"].RawAddress
+	ctx := context.Background()
+
+	cr := action.NewClaimReward(1, claimer, big.NewInt(0), 100000, big.NewInt(1))
+	require.Equal(ErrReward, errors.Cause(p.Validate(ctx, cr)))
+	cr = action.NewClaimReward(1, claimer, big.NewInt(-1), 100000, big.NewInt(1))
+	require.Equal(ErrReward, errors.Cause(p.Validate(ctx, cr)))
+	cr = action.NewClaimReward(1, claimer, big.NewInt(1), 100000, big.NewInt(1))
+	require.NoError(p.Validate(ctx, cr))
+}
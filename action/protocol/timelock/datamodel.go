@@ -0,0 +1,104 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package timelock
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/iotexproject/iotex-core/action/protocol/timelock/timelockpb"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// Lock represents a time-locked transfer pending to be released to the recipient
+type Lock struct {
+	Hash          hash.Hash32B
+	Sender        string
+	Amount        *big.Int
+	ReleaseHeight uint64
+	ReleaseTime   int64
+}
+
+// Released returns true if the lock is released at the given block height and timestamp
+func (l Lock) Released(height uint64, timestamp int64) bool {
+	return height >= l.ReleaseHeight && timestamp >= l.ReleaseTime
+}
+
+// Locks is a list of Lock of the same recipient, in the order of creation
+type Locks []Lock
+
+// Total returns the sum of the amount of the locks
+func (ls Locks) Total() *big.Int {
+	total := big.NewInt(0)
+	for _, l := range ls {
+		total.Add(total, l.Amount)
+	}
+	return total
+}
+
+// CountFrom returns the number of the locks from the sender
+func (ls Locks) CountFrom(sender string) int {
+	count := 0
+	for _, l := range ls {
+		if l.Sender == sender {
+			count++
+		}
+	}
+	return count
+}
+
+// Split splits the locks into the released ones and the pending ones at the given block height and timestamp
+func (ls Locks) Split(height uint64, timestamp int64) (Locks, Locks) {
+	var released, pending Locks
+	for _, l := range ls {
+		if l.Released(height, timestamp) {
+			released = append(released, l)
+		} else {
+			pending = append(pending, l)
+		}
+	}
+	return released, pending
+}
+
+// Serialize serializes list to binary.
+func (ls Locks) Serialize() ([]byte, error) {
+	l := make([]*timelockpb.Lock, len(ls))
+	for i := range ls {
+		l[i] = &timelockpb.Lock{
+			Hash:          ls[i].Hash[:],
+			Sender:        ls[i].Sender,
+			ReleaseHeight: ls[i].ReleaseHeight,
+			ReleaseTime:   ls[i].ReleaseTime,
+		}
+		if ls[i].Amount != nil {
+			l[i].Amount = ls[i].Amount.Bytes()
+		}
+	}
+	return proto.Marshal(&timelockpb.Locks{Locks: l})
+}
+
+// Deserialize deserializes binary to list.
+func (ls *Locks) Deserialize(data []byte) error {
+	gen := &timelockpb.Locks{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	l := make(Locks, len(gen.Locks))
+	for i, v := range gen.Locks {
+		l[i] = Lock{
+			Hash:          byteutil.BytesTo32B(v.Hash),
+			Sender:        v.Sender,
+			Amount:        big.NewInt(0).SetBytes(v.Amount),
+			ReleaseHeight: v.ReleaseHeight,
+			ReleaseTime:   v.ReleaseTime,
+		}
+	}
+	*ls = l
+	return nil
+}
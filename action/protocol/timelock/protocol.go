@@ -0,0 +1,216 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package timelock
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+const (
+	// MaxLocksPerSender is the max number of the pending locks from a sender to a recipient, so that a sender cannot
+	// flood the locks of a recipient, while the locks from the other senders are not blocked
+	MaxLocksPerSender = 32
+	// MinLockAmount is the min amount of a time-locked transfer, so that flooding a recipient with locks from many
+	// senders is not made of dust
+	MinLockAmount = blockchain.MRau
+)
+
+// ErrTimelock indicates error for a time-locked transfer
+var ErrTimelock = errors.New("invalid time-locked transfer")
+
+// Protocol defines the protocol of handling time-locked transfers
+type Protocol struct {
+	sf factory.Factory
+}
+
+// NewProtocol instantiates the protocol of time-locked transfers
+func NewProtocol(bc blockchain.Blockchain) *Protocol { return &Protocol{sf: bc.GetFactory()} }
+
+// Handle handles how to mutate the state db given the time-locked transfer actions
+func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
+	raCtx, ok := protocol.GetRunActionsCtx(ctx)
+	if !ok {
+		return nil, errors.New("failed to get action context")
+	}
+	switch act := act.(type) {
+	case *action.TimelockTransfer:
+		receipt, err := p.handleTimelockTransfer(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling time-locked transfer action")
+		}
+		return receipt, nil
+	case *action.ClaimTimelock:
+		if err := p.handleClaimTimelock(act, raCtx, sm); err != nil {
+			return nil, errors.Wrap(err, "error when handling time lock claim action")
+		}
+	}
+	// The action is not handled by this handler or no error
+	return nil, nil
+}
+
+// Validate validates the time-locked transfer actions
+func (p *Protocol) Validate(_ context.Context, act action.Action) error {
+	tt, ok := act.(*action.TimelockTransfer)
+	if !ok {
+		return nil
+	}
+	if tt.Amount().Cmp(big.NewInt(MinLockAmount)) < 0 {
+		return errors.Wrapf(ErrTimelock, "amount %s is less than %d", tt.Amount(), MinLockAmount)
+	}
+	if tt.ReleaseHeight() == 0 && tt.ReleaseTime() <= 0 {
+		return errors.Wrap(ErrTimelock, "neither release height nor release time is set")
+	}
+	if tt.ReleaseTime() < 0 {
+		return errors.Wrap(ErrTimelock, "negative release time")
+	}
+	if _, err := iotxaddress.GetPubkeyHash(tt.Recipient()); err != nil {
+		return errors.Wrapf(err, "error when validating recipient's address %s", tt.Recipient())
+	}
+	return nil
+}
+
+// Locks returns the confirmed pending locks of the recipient
+func (p *Protocol) Locks(recipient string) (Locks, error) {
+	key, err := LocksKey(recipient)
+	if err != nil {
+		return nil, err
+	}
+	var locks Locks
+	if err := p.sf.State(key, &locks); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return nil, errors.Wrapf(err, "error when loading locks of %s", recipient)
+	}
+	return locks, nil
+}
+
+// LocksKey returns the key of the pending locks of the recipient in the state factory
+func LocksKey(recipient string) (hash.PKHash, error) {
+	addrHash, err := iotxaddress.AddressToPKHash(recipient)
+	if err != nil {
+		return hash.ZeroPKHash, errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	var stream []byte
+	stream = append(stream, []byte("timelocks.")...)
+	stream = append(stream, addrHash[:]...)
+	return byteutil.BytesTo20B(hash.Hash160b(stream)), nil
+}
+
+func (p *Protocol) handleTimelockTransfer(
+	tt *action.TimelockTransfer,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	sender, err := account.LoadOrCreateAccount(sm, tt.Sender(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of sender %s", tt.Sender())
+	}
+	gasFee, tip, err := account.ChargeGas(tt, tt.Sender(), sender, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	key, err := LocksKey(tt.Recipient())
+	if err != nil {
+		return nil, err
+	}
+	locks, err := loadLocks(sm, key)
+	if err != nil {
+		return nil, err
+	}
+	// The transfer fails without halting the block if the sender cannot afford it or the sender has too many pending
+	// locks to the recipient
+	if tt.Amount().Cmp(sender.Balance) == 1 || locks.CountFrom(tt.Sender()) >= MaxLocksPerSender {
+		return account.FailureReceipt(tt, tt.Sender(), sender, gasFee, tip, raCtx, sm)
+	}
+	if err := sender.SubBalance(tt.Amount()); err != nil {
+		return nil, errors.Wrapf(err, "failed to update the Balance of sender %s", tt.Sender())
+	}
+	account.SetNonce(tt, sender)
+	if err := account.StoreAccount(sm, tt.Sender(), sender); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	// The locked amount doesn't count for the votee of the sender any more
+	spent := big.NewInt(0).Add(tt.Amount(), gasFee)
+	if err := account.UpdateVoteeWeights(sm, tt.Sender(), spent.Neg(spent), raCtx.ProducerAddr, tip); err != nil {
+		return nil, err
+	}
+	locks = append(locks, Lock{
+		Hash:          tt.Hash(),
+		Sender:        tt.Sender(),
+		Amount:        tt.Amount(),
+		ReleaseHeight: tt.ReleaseHeight(),
+		ReleaseTime:   tt.ReleaseTime(),
+	})
+	return nil, sm.PutState(key, locks)
+}
+
+func (p *Protocol) handleClaimTimelock(
+	ct *action.ClaimTimelock,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) error {
+	claimer, err := account.LoadOrCreateAccount(sm, ct.Claimer(), big.NewInt(0))
+	if err != nil {
+		return errors.Wrapf(err, "failed to load or create the account of claimer %s", ct.Claimer())
+	}
+	gasFee, tip, err := account.ChargeGas(ct, ct.Claimer(), claimer, raCtx, sm)
+	if err != nil {
+		return err
+	}
+	key, err := LocksKey(ct.Claimer())
+	if err != nil {
+		return err
+	}
+	locks, err := loadLocks(sm, key)
+	if err != nil {
+		return err
+	}
+	released, pending := locks.Split(raCtx.BlockHeight, raCtx.BlockTimeStamp)
+	amount := released.Total()
+	if err := claimer.AddBalance(amount); err != nil {
+		return errors.Wrapf(err, "failed to update the Balance of claimer %s", ct.Claimer())
+	}
+	account.SetNonce(ct, claimer)
+	if err := account.StoreAccount(sm, ct.Claimer(), claimer); err != nil {
+		return errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if err := account.UpdateVoteeWeights(
+		sm,
+		ct.Claimer(),
+		big.NewInt(0).Sub(amount, gasFee),
+		raCtx.ProducerAddr,
+		tip,
+	); err != nil {
+		return err
+	}
+	if len(released) == 0 {
+		return nil
+	}
+	if len(pending) == 0 {
+		return sm.DelState(key)
+	}
+	return sm.PutState(key, pending)
+}
+
+func loadLocks(sm protocol.StateManager, key hash.PKHash) (Locks, error) {
+	var locks Locks
+	if err := sm.State(key, &locks); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return nil, errors.Wrapf(err, "error when loading locks of %x", key)
+	}
+	return locks, nil
+}
@@ -0,0 +1,259 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package timelock
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestLocksState(t *testing.T) {
+	t.Parallel()
+
+	locks1 := Locks{
+		{
+			Hash:          hash.Hash32B{1},
+			Sender:        testaddress.IotxAddrinfo["producer"].RawAddress,
+			Amount:        big.NewInt(10),
+			ReleaseHeight: 100,
+		},
+		{
+			Hash:        hash.Hash32B{2},
+			Sender:      testaddress.IotxAddrinfo["alfa"].RawAddress,
+			Amount:      big.NewInt(20),
+			ReleaseTime: 1546300800,
+		},
+	}
+	data, err := locks1.Serialize()
+	require.NoError(t, err)
+	var locks2 Locks
+	require.NoError(t, locks2.Deserialize(data))
+	require.Equal(t, locks1, locks2)
+	require.Equal(t, big.NewInt(30), locks2.Total())
+	require.Equal(t, 1, locks2.CountFrom(testaddress.IotxAddrinfo["alfa"].RawAddress))
+	require.Equal(t, 0, locks2.CountFrom(testaddress.IotxAddrinfo["bravo"].RawAddress))
+
+	released, pending := locks2.Split(100, 1546300799)
+	require.Equal(t, Locks{locks1[0]}, released)
+	require.Equal(t, Locks{locks1[1]}, pending)
+}
+
+func TestProtocol_Handle(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	p := &Protocol{sf: sf}
+
+	sender := testaddress.IotxAddrinfo["alfa"].RawAddress
+	recipient := testaddress.IotxAddrinfo["bravo"].RawAddress
+	votee := testaddress.IotxAddrinfo["charlie"].RawAddress
+	producer := testaddress.IotxAddrinfo["producer"].RawAddress
+	putAccount := func(ws factory.WorkingSet, addr string, acct *state.Account) {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		require.NoError(ws.PutState(pkHash, acct))
+	}
+	accountOf := func(addr string) state.Account {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		var acct state.Account
+		require.NoError(sf.State(pkHash, &acct))
+		return acct
+	}
+
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	balance := 10 * blockchain.MRau
+	putAccount(ws, sender, &state.Account{
+		Balance:      big.NewInt(balance),
+		VotingWeight: big.NewInt(0),
+		Votee:        votee,
+	})
+	putAccount(ws, votee, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(balance)})
+	amount1 := big.NewInt(blockchain.MRau)
+	amount2 := big.NewInt(2 * blockchain.MRau)
+	tt1 := action.NewTimelockTransfer(1, amount1, sender, recipient, 10, 0, 100000, big.NewInt(1))
+	tt2 := action.NewTimelockTransfer(2, amount2, sender, recipient, 0, 1546300800, 100000, big.NewInt(1))
+	gasLimit := uint64(1000000)
+	ctx = protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+		BlockHeight:     1,
+		ProducerAddr:    producer,
+		GasLimit:        &gasLimit,
+		EnableGasCharge: true,
+	})
+	for _, tt := range []*action.TimelockTransfer{tt1, tt2} {
+		require.NoError(p.Validate(ctx, tt))
+		_, err = p.Handle(ctx, tt, ws)
+		require.NoError(err)
+	}
+	require.NoError(sf.Commit(ws))
+
+	gas := int64(2 * action.TimelockTransferIntrinsicGas)
+	s := accountOf(sender)
+	require.Equal(big.NewInt(balance-3*blockchain.MRau-gas), s.Balance)
+	require.Equal(uint64(2), s.Nonce)
+	require.Equal(big.NewInt(balance-3*blockchain.MRau-gas), accountOf(votee).VotingWeight)
+	require.Equal(big.NewInt(gas), accountOf(producer).Balance)
+	locks, err := p.Locks(recipient)
+	require.NoError(err)
+	require.Equal(2, len(locks))
+	require.Equal(big.NewInt(3*blockchain.MRau), locks.Total())
+
+	// Only the lock released by height could be claimed
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	putAccount(ws, recipient, &state.Account{Balance: big.NewInt(100000), VotingWeight: big.NewInt(0)})
+	ctx = protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+		BlockHeight:    10,
+		BlockTimeStamp: 1546300799,
+		ProducerAddr:   producer,
+		GasLimit:       &gasLimit,
+	})
+	_, err = p.Handle(ctx, action.NewClaimTimelock(1, recipient, 100000, big.NewInt(1)), ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	require.Equal(big.NewInt(100000+blockchain.MRau), accountOf(recipient).Balance)
+	locks, err = p.Locks(recipient)
+	require.NoError(err)
+	require.Equal(1, len(locks))
+	require.Equal(amount2, locks[0].Amount)
+
+	// Claim the rest after the release time
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	ctx = protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+		BlockHeight:    11,
+		BlockTimeStamp: 1546300800,
+		ProducerAddr:   producer,
+		GasLimit:       &gasLimit,
+	})
+	_, err = p.Handle(ctx, action.NewClaimTimelock(2, recipient, 100000, big.NewInt(1)), ws)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	r := accountOf(recipient)
+	require.Equal(big.NewInt(100000+3*blockchain.MRau), r.Balance)
+	require.Equal(uint64(2), r.Nonce)
+	locks, err = p.Locks(recipient)
+	require.NoError(err)
+	require.Equal(0, len(locks))
+}
+
+func TestProtocol_HandleFailure(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	p := &Protocol{sf: sf}
+
+	sender := testaddress.IotxAddrinfo["alfa"].RawAddress
+	recipient := testaddress.IotxAddrinfo["bravo"].RawAddress
+	other := testaddress.IotxAddrinfo["charlie"].RawAddress
+	producer := testaddress.IotxAddrinfo["producer"].RawAddress
+	senderHash, err := iotxaddress.AddressToPKHash(sender)
+	require.NoError(err)
+	otherHash, err := iotxaddress.AddressToPKHash(other)
+	require.NoError(err)
+	key, err := LocksKey(recipient)
+	require.NoError(err)
+
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	balance := 2 * blockchain.MRau
+	require.NoError(ws.PutState(senderHash, &state.Account{Balance: big.NewInt(balance), VotingWeight: big.NewInt(0)}))
+	require.NoError(ws.PutState(otherHash, &state.Account{Balance: big.NewInt(balance), VotingWeight: big.NewInt(0)}))
+	locks := make(Locks, MaxLocksPerSender)
+	for i := range locks {
+		locks[i] = Lock{
+			Hash:          hash.Hash32B{byte(i)},
+			Sender:        sender,
+			Amount:        big.NewInt(MinLockAmount),
+			ReleaseHeight: 100,
+		}
+	}
+	require.NoError(ws.PutState(key, locks))
+	gasLimit := uint64(1000000)
+	ctx = protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+		BlockHeight:     1,
+		ProducerAddr:    producer,
+		GasLimit:        &gasLimit,
+		EnableGasCharge: true,
+	})
+	// Neither the transfer exceeding the balance nor the one from a sender with too many locks to the recipient halts
+	// the block
+	tt1 := action.NewTimelockTransfer(1, big.NewInt(balance), sender, recipient, 10, 0, 100000, big.NewInt(1))
+	tt2 := action.NewTimelockTransfer(2, big.NewInt(MinLockAmount), sender, recipient, 10, 0, 100000, big.NewInt(1))
+	for _, tt := range []*action.TimelockTransfer{tt1, tt2} {
+		receipt, err := p.Handle(ctx, tt, ws)
+		require.NoError(err)
+		require.Equal(action.FailureReceiptStatus, receipt.Status)
+		require.Equal(action.TimelockTransferIntrinsicGas, receipt.GasConsumed)
+	}
+	// The locks from the sender don't block the ones from the other senders
+	tt3 := action.NewTimelockTransfer(1, big.NewInt(MinLockAmount), other, recipient, 10, 0, 100000, big.NewInt(1))
+	receipt, err := p.Handle(ctx, tt3, ws)
+	require.NoError(err)
+	require.Nil(receipt)
+	require.NoError(sf.Commit(ws))
+
+	var s state.Account
+	require.NoError(sf.State(senderHash, &s))
+	require.Equal(big.NewInt(balance-2*int64(action.TimelockTransferIntrinsicGas)), s.Balance)
+	require.Equal(uint64(2), s.Nonce)
+	locks, err = p.Locks(recipient)
+	require.NoError(err)
+	require.Equal(MaxLocksPerSender+1, len(locks))
+	require.Equal(1, locks.CountFrom(other))
+}
+
+func TestProtocol_Validate(t *testing.T) {
+	require := require.New(t)
+
+	p := &Protocol{}
+	sender := testaddress.IotxAddrinfo["alfa"].RawAddress
+	recipient := testaddress.IotxAddrinfo["bravo"].RawAddress
+	ctx := context.Background()
+
+	amount := big.NewInt(MinLockAmount)
+	tt := action.NewTimelockTransfer(1, big.NewInt(0), sender, recipient, 10, 0, 100000, big.NewInt(1))
+	require.Equal(ErrTimelock, errors.Cause(p.Validate(ctx, tt)))
+	tt = action.NewTimelockTransfer(1, big.NewInt(MinLockAmount-1), sender, recipient, 10, 0, 100000, big.NewInt(1))
+	require.Equal(ErrTimelock, errors.Cause(p.Validate(ctx, tt)))
+	tt = action.NewTimelockTransfer(1, amount, sender, recipient, 0, 0, 100000, big.NewInt(1))
+	require.Equal(ErrTimelock, errors.Cause(p.Validate(ctx, tt)))
+	tt = action.NewTimelockTransfer(1, amount, sender, recipient, 10, -1, 100000, big.NewInt(1))
+	require.Equal(ErrTimelock, errors.Cause(p.Validate(ctx, tt)))
+	tt = action.NewTimelockTransfer(1, amount, sender, "io1invalid", 10, 0, 100000, big.NewInt(1))
+	require.Error(p.Validate(ctx, tt))
+	tt = action.NewTimelockTransfer(1, amount, sender, recipient, 10, 0, 100000, big.NewInt(1))
+	require.NoError(p.Validate(ctx, tt))
+	require.NoError(p.Validate(ctx, action.NewClaimTimelock(1, recipient, 100000, big.NewInt(1))))
+}
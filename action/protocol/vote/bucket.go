@@ -0,0 +1,114 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package vote
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/votepb"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// Bucket represents an amount staked for a candidate, which is locked for the duration in days since its creation
+type Bucket struct {
+	Index       uint64
+	Candidate   string
+	Amount      *big.Int
+	Duration    uint32
+	CreateTime  int64
+	UnstakeTime int64
+}
+
+// Weight returns the voting weight the bucket counts for its candidate
+func (b Bucket) Weight() *big.Int { return candidatesutil.StakeWeight(b.Amount, b.Duration) }
+
+// Unstaked returns true if the bucket has been unstaked
+func (b Bucket) Unstaked() bool { return b.UnstakeTime > 0 }
+
+// Unlocked returns true if the lock duration of the bucket is over at the timestamp
+func (b Bucket) Unlocked(timestamp int64) bool {
+	return timestamp >= b.CreateTime+int64(b.Duration)*candidatesutil.StakeDurationUnit
+}
+
+// Withdrawable returns true if the bucket has been unstaked for the withdrawal waiting period at the timestamp
+func (b Bucket) Withdrawable(timestamp int64) bool {
+	return b.Unstaked() && timestamp >= b.UnstakeTime+WithdrawWaitingPeriod
+}
+
+// Buckets is the stake buckets of a staker, in the order of creation
+type Buckets struct {
+	// NextIndex is the index of the next bucket to create, so that the indices are never reused
+	NextIndex uint64
+	List      []Bucket
+}
+
+// Find returns the position of the bucket of the index in the list
+func (bs Buckets) Find(index uint64) (int, bool) {
+	for i, b := range bs.List {
+		if b.Index == index {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Serialize serializes buckets to binary.
+func (bs Buckets) Serialize() ([]byte, error) {
+	l := make([]*votepb.Bucket, len(bs.List))
+	for i, b := range bs.List {
+		l[i] = &votepb.Bucket{
+			Index:       b.Index,
+			Candidate:   b.Candidate,
+			Duration:    b.Duration,
+			CreateTime:  b.CreateTime,
+			UnstakeTime: b.UnstakeTime,
+		}
+		if b.Amount != nil {
+			l[i].Amount = b.Amount.Bytes()
+		}
+	}
+	return proto.Marshal(&votepb.Buckets{NextIndex: bs.NextIndex, Buckets: l})
+}
+
+// Deserialize deserializes binary to buckets.
+func (bs *Buckets) Deserialize(data []byte) error {
+	gen := &votepb.Buckets{}
+	if err := proto.Unmarshal(data, gen); err != nil {
+		return err
+	}
+	l := make([]Bucket, len(gen.Buckets))
+	for i, v := range gen.Buckets {
+		l[i] = Bucket{
+			Index:       v.Index,
+			Candidate:   v.Candidate,
+			Amount:      big.NewInt(0).SetBytes(v.Amount),
+			Duration:    v.Duration,
+			CreateTime:  v.CreateTime,
+			UnstakeTime: v.UnstakeTime,
+		}
+	}
+	*bs = Buckets{NextIndex: gen.NextIndex, List: l}
+	return nil
+}
+
+// BucketsKey returns the key of the stake buckets of the staker in the state factory
+func BucketsKey(staker string) (hash.PKHash, error) {
+	addrHash, err := iotxaddress.AddressToPKHash(staker)
+	if err != nil {
+		return hash.ZeroPKHash, errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	var stream []byte
+	stream = append(stream, []byte("stakes.")...)
+	stream = append(stream, addrHash[:]...)
+	return byteutil.BytesTo20B(hash.Hash160b(stream)), nil
+}
@@ -14,6 +14,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/iotxaddress"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
@@ -23,6 +24,24 @@ import (
 // CandidatesPrefix is the prefix of the key of candidateList
 const CandidatesPrefix = "Candidates."
 
+const (
+	// StakeDurationUnit is the unit of the stake lock durations in seconds, which is one day
+	StakeDurationUnit = int64(24 * 60 * 60)
+	// MaxStakeDuration is the longest stake lock duration in days
+	MaxStakeDuration = uint32(365)
+)
+
+// StakeWeight returns the voting weight of the amount staked for the duration in days. The weight gets a bonus linear
+// to the duration on top of the amount, which doubles the amount at the max duration.
+func StakeWeight(amount *big.Int, duration uint32) *big.Int {
+	if duration > MaxStakeDuration {
+		duration = MaxStakeDuration
+	}
+	bonus := big.NewInt(0).Mul(amount, big.NewInt(int64(duration)))
+	bonus.Div(bonus, big.NewInt(int64(MaxStakeDuration)))
+	return bonus.Add(bonus, amount)
+}
+
 // LoadAndAddCandidates loads candidates from trie and adds a new candidate
 func LoadAndAddCandidates(sm protocol.StateManager, vote *action.Vote) error {
 	candidateMap, err := GetMostRecentCandidateMap(sm)
@@ -63,6 +82,110 @@ func LoadAndUpdateCandidates(sm protocol.StateManager, addr string, votingWeight
 	return storeCandidates(candidateMap, sm)
 }
 
+// LoadAndRegisterCandidate loads candidates from trie and registers the sender of the candidate registration action
+// with its metadata and self-stake. A candidate which has self-nominated keeps its votes and creation height.
+func LoadAndRegisterCandidate(sm protocol.StateManager, rc *action.RegisterCandidate, votes *big.Int) error {
+	candidateMap, err := GetMostRecentCandidateMap(sm)
+	if err != nil {
+		return errors.Wrap(err, "failed to get most recent candidates from trie")
+	}
+	addrHash, err := iotxaddress.AddressToPKHash(rc.Candidate())
+	if err != nil {
+		return errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	candidate, ok := candidateMap[addrHash]
+	if !ok {
+		candidate = &state.Candidate{
+			Address:        rc.Candidate(),
+			Votes:          votes,
+			CreationHeight: sm.Height(),
+		}
+		candidateMap[addrHash] = candidate
+	}
+	setMetadata(candidate, &rc.CandidateMetadata)
+	candidate.SelfStake = rc.SelfStake()
+	candidate.LastUpdateHeight = sm.Height()
+	return storeCandidates(candidateMap, sm)
+}
+
+// LoadAndUpdateCandidateMetadata loads candidates from trie and replaces the metadata of an existing candidate
+func LoadAndUpdateCandidateMetadata(sm protocol.StateManager, addr string, metadata *action.CandidateMetadata) error {
+	candidateMap, err := GetMostRecentCandidateMap(sm)
+	if err != nil {
+		return errors.Wrap(err, "failed to get most recent candidates from trie")
+	}
+	addrHash, err := iotxaddress.AddressToPKHash(addr)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	candidate, ok := candidateMap[addrHash]
+	if !ok {
+		return errors.Wrapf(state.ErrStateNotExist, "candidate %s doesn't exist", addr)
+	}
+	setMetadata(candidate, metadata)
+	candidate.LastUpdateHeight = sm.Height()
+	return storeCandidates(candidateMap, sm)
+}
+
+// LoadCandidate loads candidates from trie and returns the candidate of the address, or state.ErrStateNotExist if
+// the address isn't a candidate
+func LoadCandidate(sm protocol.StateManager, addr string) (*state.Candidate, error) {
+	candidateMap, err := GetMostRecentCandidateMap(sm)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get most recent candidates from trie")
+	}
+	addrHash, err := iotxaddress.AddressToPKHash(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	candidate, ok := candidateMap[addrHash]
+	if !ok {
+		return nil, errors.Wrapf(state.ErrStateNotExist, "candidate %s doesn't exist", addr)
+	}
+	return candidate, nil
+}
+
+// LoadAndUpdateVoteeWeight adds delta to the voting weight of the votee of the voter and updates the candidate list,
+// as the balance of the voter changes by delta. It does nothing if the voter hasn't voted. The updated account of the
+// voter has to be put into trie before, because it's loaded again here.
+func LoadAndUpdateVoteeWeight(sm protocol.StateManager, voter string, delta *big.Int) error {
+	if delta.Sign() == 0 {
+		return nil
+	}
+	voterAcct, err := loadAccount(sm, voter)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the account of voter %s", voter)
+	}
+	if len(voterAcct.Votee) == 0 {
+		return nil
+	}
+	votee, err := loadAccount(sm, voterAcct.Votee)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load the account of votee %s", voterAcct.Votee)
+	}
+	votee.VotingWeight.Add(votee.VotingWeight, delta)
+	voteeHash, err := iotxaddress.AddressToPKHash(voterAcct.Votee)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	if err := sm.PutState(voteeHash, votee); err != nil {
+		return errors.Wrapf(err, "failed to put state for account %x", voteeHash)
+	}
+	if votee.IsCandidate {
+		return LoadAndUpdateCandidates(sm, voterAcct.Votee, votee.VotingWeight)
+	}
+	return nil
+}
+
+// SyncVoteeWeight does what LoadAndUpdateVoteeWeight does if the votee weight sync is active at the height of the
+// actions, before which such balance changes of the voter didn't count for its votee
+func SyncVoteeWeight(raCtx protocol.RunActionsCtx, sm protocol.StateManager, voter string, delta *big.Int) error {
+	if !raCtx.IsActive(genesis.VoteeWeightSync) {
+		return nil
+	}
+	return LoadAndUpdateVoteeWeight(sm, voter, delta)
+}
+
 // GetMostRecentCandidateMap gets the most recent candidateMap from trie
 func GetMostRecentCandidateMap(sm protocol.StateManager) (map[hash.PKHash]*state.Candidate, error) {
 	var sc state.CandidateList
@@ -127,6 +250,14 @@ func updateCandidate(
 	return nil
 }
 
+// setMetadata sets the metadata of a candidate
+func setMetadata(candidate *state.Candidate, metadata *action.CandidateMetadata) {
+	candidate.Name = metadata.Name()
+	candidate.OperatorAddress = metadata.OperatorAddress()
+	candidate.RewardAddress = metadata.RewardAddress()
+	candidate.PublicKey = metadata.PublicKey()
+}
+
 // storeCandidates puts updated candidates to trie
 func storeCandidates(candidateMap map[hash.PKHash]*state.Candidate, sm protocol.StateManager) error {
 	candidateList, err := state.MapToCandidates(candidateMap)
@@ -137,3 +268,18 @@ func storeCandidates(candidateMap map[hash.PKHash]*state.Candidate, sm protocol.
 	candidatesKey := ConstructKey(sm.Height())
 	return sm.PutState(candidatesKey, &candidateList)
 }
+
+func loadAccount(sm protocol.StateManager, addr string) (*state.Account, error) {
+	addrHash, err := iotxaddress.AddressToPKHash(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	var acct state.Account
+	if err := sm.State(addrHash, &acct); err != nil {
+		if errors.Cause(err) != state.ErrStateNotExist {
+			return nil, err
+		}
+		return &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(0)}, nil
+	}
+	return &acct, nil
+}
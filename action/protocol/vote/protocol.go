@@ -18,6 +18,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
 	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/iotxaddress"
 	"github.com/iotexproject/iotex-core/state"
 )
@@ -36,76 +37,135 @@ type Protocol struct {
 // NewProtocol instantiates the protocol of vote
 func NewProtocol(cm protocol.ChainManager) *Protocol { return &Protocol{cm: cm} }
 
-// Handle handles a vote
+// Handle handles a vote, the stake and the candidate registration actions
 func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.StateManager) (*action.Receipt, error) {
-	vote, ok := act.(*action.Vote)
-	if !ok {
-		return nil, nil
-	}
-
 	raCtx, ok := protocol.GetRunActionsCtx(ctx)
 	if !ok {
 		return nil, errors.New("failed to get action context")
 	}
+	switch act := act.(type) {
+	case *action.Vote:
+		if err := p.handleVote(act, raCtx, sm); err != nil {
+			return nil, err
+		}
+	case *action.CreateStake:
+		receipt, err := p.handleCreateStake(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling stake creation action")
+		}
+		return receipt, nil
+	case *action.Unstake:
+		receipt, err := p.handleUnstake(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling unstake action")
+		}
+		return receipt, nil
+	case *action.Withdraw:
+		receipt, err := p.handleWithdraw(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling stake withdrawal action")
+		}
+		return receipt, nil
+	case *action.RegisterCandidate:
+		receipt, err := p.handleRegisterCandidate(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling candidate registration action")
+		}
+		return receipt, nil
+	case *action.UpdateCandidate:
+		receipt, err := p.handleUpdateCandidate(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling candidate update action")
+		}
+		return receipt, nil
+	case *action.UnregisterCandidate:
+		receipt, err := p.handleUnregisterCandidate(act, raCtx, sm)
+		if err != nil {
+			return nil, errors.Wrap(err, "error when handling candidate unregistration action")
+		}
+		return receipt, nil
+	}
+	return nil, nil
+}
 
+func (p *Protocol) handleVote(vote *action.Vote, raCtx protocol.RunActionsCtx, sm protocol.StateManager) error {
 	voteFrom, err := account.LoadOrCreateAccount(sm, vote.Voter(), big.NewInt(0))
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to load or create the account of voter %s", vote.Voter())
+		return errors.Wrapf(err, "failed to load or create the account of voter %s", vote.Voter())
 	}
+	// the balance of voter which counts for its previous votee before the gas is charged
+	prevBalance := big.NewInt(0).Set(voteFrom.Balance)
+	compensated := big.NewInt(0)
 	if raCtx.EnableGasCharge {
 		// Load or create account for producer
 		producer, err := account.LoadOrCreateAccount(sm, raCtx.ProducerAddr, big.NewInt(0))
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to load or create the account of block producer %s", raCtx.ProducerAddr)
+			return errors.Wrapf(err, "failed to load or create the account of block producer %s", raCtx.ProducerAddr)
 		}
 		gas, err := vote.IntrinsicGas()
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to get intrinsic gas for vote hash %s", vote.Hash())
+			return errors.Wrapf(err, "failed to get intrinsic gas for vote hash %s", vote.Hash())
 		}
 		if *raCtx.GasLimit < gas {
-			return nil, vm.ErrOutOfGas
+			return vm.ErrOutOfGas
+		}
+		gasFee, tip, err := account.GasFee(raCtx, vote.GasPrice(), gas)
+		if err != nil {
+			return err
 		}
-		gasFee := big.NewInt(0).Mul(vote.GasPrice(), big.NewInt(0).SetUint64(gas))
 
 		if gasFee.Cmp(voteFrom.Balance) == 1 {
-			return nil, errors.Wrapf(state.ErrNotEnoughBalance, "failed to verify the Balance for gas of voter %s, %d, %d", vote.Voter(), gas, voteFrom.Balance)
+			return errors.Wrapf(state.ErrNotEnoughBalance, "failed to verify the Balance for gas of voter %s, %d, %d", vote.Voter(), gas, voteFrom.Balance)
 		}
 
 		// charge voter Gas
 		if err := voteFrom.SubBalance(gasFee); err != nil {
-			return nil, errors.Wrapf(err, "failed to charge the gas for voter %s", vote.Voter())
+			return errors.Wrapf(err, "failed to charge the gas for voter %s", vote.Voter())
 		}
-		// compensate block producer gas
-		if err := producer.AddBalance(gasFee); err != nil {
-			return nil, errors.Wrapf(err, "failed to compensate gas to producer")
+		// compensate block producer the tip, while the base fee is burned
+		if err := producer.AddBalance(tip); err != nil {
+			return errors.Wrapf(err, "failed to compensate gas to producer")
 		}
+		compensated.Set(tip)
 		// Put updated producer's state to trie
 		if err := account.StoreAccount(sm, raCtx.ProducerAddr, producer); err != nil {
-			return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+			return errors.Wrap(err, "failed to update pending account changes to trie")
 		}
 		*raCtx.GasLimit -= gas
 	}
+	if !raCtx.IsActive(genesis.VoteeWeightSync) {
+		// Before the votee weight sync, the previous votee lost the balance after the gas was charged, and the
+		// producer's votee didn't get the tip
+		prevBalance.Set(voteFrom.Balance)
+		compensated.SetInt64(0)
+	}
 	// Update voteFrom Nonce
 	account.SetNonce(vote, voteFrom)
 	prevVotee := voteFrom.Votee
 	voteFrom.Votee = vote.Votee()
 	if vote.Votee() == "" {
-		// unvote operation
-		voteFrom.IsCandidate = false
-		// Remove the candidate from candidateMap if the person is not a candidate anymore
-		if err := candidatesutil.LoadAndDeleteCandidates(sm, vote.Voter()); err != nil {
-			return nil, errors.Wrap(err, "failed to load and delete candidates")
+		// unvote operation, which doesn't affect a registered candidate until it's unregistered
+		registered, err := loadRegistered(sm, vote.Voter())
+		if err != nil {
+			return err
+		}
+		if registered == nil {
+			voteFrom.IsCandidate = false
+			// Remove the candidate from candidateMap if the person is not a candidate anymore
+			if err := candidatesutil.LoadAndDeleteCandidates(sm, vote.Voter()); err != nil {
+				return errors.Wrap(err, "failed to load and delete candidates")
+			}
 		}
 	} else if vote.Voter() == vote.Votee() {
 		// Vote to self: self-nomination
 		voteFrom.IsCandidate = true
 		if err := candidatesutil.LoadAndAddCandidates(sm, vote); err != nil {
-			return nil, errors.Wrap(err, "failed to load and add candidates")
+			return errors.Wrap(err, "failed to load and add candidates")
 		}
 	}
 	// Put updated voter's state to trie
 	if err := account.StoreAccount(sm, vote.Voter(), voteFrom); err != nil {
-		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+		return errors.Wrap(err, "failed to update pending account changes to trie")
 	}
 
 	// Update old votee's weight
@@ -113,17 +173,17 @@ func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.St
 		// voter already voted
 		oldVotee, err := account.LoadOrCreateAccount(sm, prevVotee, big.NewInt(0))
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to load or create the account of voter's old votee %s", prevVotee)
+			return errors.Wrapf(err, "failed to load or create the account of voter's old votee %s", prevVotee)
 		}
-		oldVotee.VotingWeight.Sub(oldVotee.VotingWeight, voteFrom.Balance)
+		oldVotee.VotingWeight.Sub(oldVotee.VotingWeight, prevBalance)
 		// Put updated state of voter's old votee to trie
 		if err := account.StoreAccount(sm, prevVotee, oldVotee); err != nil {
-			return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+			return errors.Wrap(err, "failed to update pending account changes to trie")
 		}
 		// Update candidate map
 		if oldVotee.IsCandidate {
 			if err := candidatesutil.LoadAndUpdateCandidates(sm, prevVotee, oldVotee.VotingWeight); err != nil {
-				return nil, errors.Wrap(err, "failed to load and update candidates")
+				return errors.Wrap(err, "failed to load and update candidates")
 			}
 		}
 	}
@@ -131,35 +191,45 @@ func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.St
 	if vote.Votee() != "" {
 		voteTo, err := account.LoadOrCreateAccount(sm, vote.Votee(), big.NewInt(0))
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to load or create the account of votee %s", vote.Votee())
+			return errors.Wrapf(err, "failed to load or create the account of votee %s", vote.Votee())
 		}
 		// Update new votee's weight
 		voteTo.VotingWeight.Add(voteTo.VotingWeight, voteFrom.Balance)
 
 		// Put updated votee's state to trie
 		if err := account.StoreAccount(sm, vote.Votee(), voteTo); err != nil {
-			return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+			return errors.Wrap(err, "failed to update pending account changes to trie")
 		}
 		// Update candidate map
 		if voteTo.IsCandidate {
 			if err := candidatesutil.LoadAndUpdateCandidates(sm, vote.Votee(), voteTo.VotingWeight); err != nil {
-				return nil, errors.Wrap(err, "failed to load and update candidates")
+				return errors.Wrap(err, "failed to load and update candidates")
 			}
 		}
 	}
-
-	return nil, nil
+	// Update the weight of producer's votee on the tip
+	return candidatesutil.LoadAndUpdateVoteeWeight(sm, raCtx.ProducerAddr, compensated)
 }
 
-// Validate validates a vote
+// Validate validates a vote, the stake creation and the candidate registration actions
 func (p *Protocol) Validate(_ context.Context, act action.Action) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	vote, ok := act.(*action.Vote)
-	if !ok {
-		return nil
+	switch act := act.(type) {
+	case *action.Vote:
+		return p.validateVote(act)
+	case *action.CreateStake:
+		return p.validateCreateStake(act)
+	case *action.RegisterCandidate:
+		return p.validateRegisterCandidate(act)
+	case *action.UpdateCandidate:
+		return validateMetadata(act.Candidate(), &act.CandidateMetadata)
 	}
+	return nil
+}
+
+func (p *Protocol) validateVote(vote *action.Vote) error {
 	// Reject oversized vote
 	if vote.TotalSize() > VoteSizeLimit {
 		return errors.Wrapf(action.ErrActPool, "oversized data")
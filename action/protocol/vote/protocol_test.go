@@ -132,9 +132,10 @@ func TestProtocol_Handle(t *testing.T) {
 
 func TestProtocol_Validate(t *testing.T) {
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(
+	_, err = bc.CreateState(
 		testaddress.IotxAddrinfo["producer"].RawAddress,
 		big.NewInt(0),
 	)
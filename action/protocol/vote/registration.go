@@ -0,0 +1,208 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package vote
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// MaxCandidateNameLength is the maximum length of the name of a candidate
+const MaxCandidateNameLength = 40
+
+// ErrCandidateRegistration indicates error for a candidate registration, update or unregistration action
+var ErrCandidateRegistration = errors.New("invalid candidate registration action")
+
+// MinSelfStake returns the minimum amount in rau a candidate has to lock from its balance as the self-stake on
+// registration at the height, which is governed in IOTX
+func MinSelfStake(sr governance.StateReader, height uint64) (*big.Int, error) {
+	iotx, err := governance.Parameter(sr, governance.MinSelfStake, height, genesis.MinSelfStake)
+	if err != nil {
+		return nil, err
+	}
+	return big.NewInt(0).Mul(big.NewInt(0).SetUint64(iotx), big.NewInt(1e18)), nil
+}
+
+func (p *Protocol) validateRegisterCandidate(rc *action.RegisterCandidate) error {
+	if rc.SelfStake().Sign() <= 0 {
+		return errors.Wrap(ErrCandidateRegistration, "non-positive self-stake")
+	}
+	return validateMetadata(rc.Candidate(), &rc.CandidateMetadata)
+}
+
+// validateMetadata checks the metadata of the candidate, whose public key has to be the one of the candidate address
+func validateMetadata(candidate string, metadata *action.CandidateMetadata) error {
+	if len(metadata.Name()) == 0 || len(metadata.Name()) > MaxCandidateNameLength {
+		return errors.Wrapf(ErrCandidateRegistration, "name should be 1 to %d bytes", MaxCandidateNameLength)
+	}
+	if _, err := iotxaddress.GetPubkeyHash(metadata.OperatorAddress()); err != nil {
+		return errors.Wrapf(err, "error when validating operator's address %s", metadata.OperatorAddress())
+	}
+	if _, err := iotxaddress.GetPubkeyHash(metadata.RewardAddress()); err != nil {
+		return errors.Wrapf(err, "error when validating reward address %s", metadata.RewardAddress())
+	}
+	if metadata.PublicKey() == keypair.ZeroPublicKey {
+		return errors.Wrap(ErrCandidateRegistration, "empty public key")
+	}
+	pkHash, err := iotxaddress.AddressToPKHash(candidate)
+	if err != nil {
+		return errors.Wrapf(err, "error when validating candidate's address %s", candidate)
+	}
+	if keypair.HashPubKey(metadata.PublicKey()) != pkHash {
+		return errors.Wrapf(ErrCandidateRegistration, "public key doesn't match candidate %s", candidate)
+	}
+	return nil
+}
+
+func (p *Protocol) handleRegisterCandidate(
+	rc *action.RegisterCandidate,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	candidate, err := account.LoadOrCreateAccount(sm, rc.Candidate(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of candidate %s", rc.Candidate())
+	}
+	gasFee, tip, err := account.ChargeGas(rc, rc.Candidate(), candidate, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := loadRegistered(sm, rc.Candidate())
+	if err != nil {
+		return nil, err
+	}
+	minSelfStake, err := MinSelfStake(sm, raCtx.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+	// The registration fails without halting the block if the candidate has been registered, or the self-stake is
+	// less than the minimum or more than the candidate could afford
+	if registered != nil || rc.SelfStake().Cmp(minSelfStake) < 0 || rc.SelfStake().Cmp(candidate.Balance) == 1 {
+		return account.FailureReceipt(rc, rc.Candidate(), candidate, gasFee, tip, raCtx, sm)
+	}
+	if err := candidate.SubBalance(rc.SelfStake()); err != nil {
+		return nil, errors.Wrapf(err, "failed to update the Balance of candidate %s", rc.Candidate())
+	}
+	account.SetNonce(rc, candidate)
+	candidate.IsCandidate = true
+	if err := account.StoreAccount(sm, rc.Candidate(), candidate); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	// The candidate has to be in the candidate list before any weight update on it
+	if err := candidatesutil.LoadAndRegisterCandidate(sm, rc, candidate.VotingWeight); err != nil {
+		return nil, errors.Wrap(err, "failed to load and register candidate")
+	}
+	// The self-stake doesn't count for the votee of the candidate by balance any more, but for the candidate itself
+	spent := big.NewInt(0).Add(rc.SelfStake(), gasFee)
+	if err := account.UpdateVoteeWeights(sm, rc.Candidate(), spent.Neg(spent), raCtx.ProducerAddr, tip); err != nil {
+		return nil, err
+	}
+	return nil, updateWeight(sm, rc.Candidate(), rc.SelfStake())
+}
+
+func (p *Protocol) handleUpdateCandidate(
+	uc *action.UpdateCandidate,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	candidate, err := account.LoadOrCreateAccount(sm, uc.Candidate(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of candidate %s", uc.Candidate())
+	}
+	gasFee, tip, err := account.ChargeGas(uc, uc.Candidate(), candidate, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := loadRegistered(sm, uc.Candidate())
+	if err != nil {
+		return nil, err
+	}
+	if registered == nil {
+		return account.FailureReceipt(uc, uc.Candidate(), candidate, gasFee, tip, raCtx, sm)
+	}
+	account.SetNonce(uc, candidate)
+	if err := account.StoreAccount(sm, uc.Candidate(), candidate); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if err := account.UpdateVoteeWeights(sm, uc.Candidate(), big.NewInt(0).Neg(gasFee), raCtx.ProducerAddr, tip); err != nil {
+		return nil, err
+	}
+	if err := candidatesutil.LoadAndUpdateCandidateMetadata(sm, uc.Candidate(), &uc.CandidateMetadata); err != nil {
+		return nil, errors.Wrap(err, "failed to load and update candidate metadata")
+	}
+	return nil, nil
+}
+
+func (p *Protocol) handleUnregisterCandidate(
+	urc *action.UnregisterCandidate,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	candidate, err := account.LoadOrCreateAccount(sm, urc.Candidate(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of candidate %s", urc.Candidate())
+	}
+	gasFee, tip, err := account.ChargeGas(urc, urc.Candidate(), candidate, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := loadRegistered(sm, urc.Candidate())
+	if err != nil {
+		return nil, err
+	}
+	if registered == nil {
+		return account.FailureReceipt(urc, urc.Candidate(), candidate, gasFee, tip, raCtx, sm)
+	}
+	if err := candidate.AddBalance(registered.SelfStake); err != nil {
+		return nil, errors.Wrapf(err, "failed to update the Balance of candidate %s", urc.Candidate())
+	}
+	account.SetNonce(urc, candidate)
+	candidate.IsCandidate = false
+	if err := account.StoreAccount(sm, urc.Candidate(), candidate); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if err := candidatesutil.LoadAndDeleteCandidates(sm, urc.Candidate()); err != nil {
+		return nil, errors.Wrap(err, "failed to load and delete candidates")
+	}
+	if err := updateWeight(sm, urc.Candidate(), big.NewInt(0).Neg(registered.SelfStake)); err != nil {
+		return nil, err
+	}
+	// The unlocked self-stake counts for the votee of the candidate by balance again
+	return nil, account.UpdateVoteeWeights(
+		sm,
+		urc.Candidate(),
+		big.NewInt(0).Sub(registered.SelfStake, gasFee),
+		raCtx.ProducerAddr,
+		tip,
+	)
+}
+
+// loadRegistered returns the candidate registered by the address, or nil if the address isn't a registered candidate
+func loadRegistered(sm protocol.StateManager, addr string) (*state.Candidate, error) {
+	candidate, err := candidatesutil.LoadCandidate(sm, addr)
+	if err != nil {
+		if errors.Cause(err) == state.ErrStateNotExist {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !candidate.Registered() {
+		return nil, nil
+	}
+	return candidate, nil
+}
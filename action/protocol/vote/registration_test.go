@@ -0,0 +1,192 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package vote
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestProtocol_HandleCandidateRegistration(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	p := NewProtocol(nil)
+
+	candidate := testaddress.IotxAddrinfo["alfa"]
+	voter := testaddress.IotxAddrinfo["bravo"].RawAddress
+	operator := testaddress.IotxAddrinfo["charlie"].RawAddress
+	producer := testaddress.IotxAddrinfo["producer"].RawAddress
+	accountOf := func(addr string) *state.Account {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		acct, err := account.LoadAccount(ws, pkHash)
+		require.NoError(err)
+		return acct
+	}
+	gasLimit := uint64(1000000)
+	runCtx := func(enableGasCharge bool) context.Context {
+		return protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+			ProducerAddr:    producer,
+			GasLimit:        &gasLimit,
+			EnableGasCharge: enableGasCharge,
+		})
+	}
+	requireFailure := func(receipt *action.Receipt, err error) {
+		require.NoError(err)
+		require.NotNil(receipt)
+		require.Equal(action.FailureReceiptStatus, receipt.Status)
+	}
+	// The min self-stake is lowered to 1 IOTX
+	require.NoError(governance.SetGenesisParameters(ws, governance.Parameters{governance.MinSelfStake: 1}))
+	selfStake, err := MinSelfStake(ws, 0)
+	require.NoError(err)
+	require.Equal(big.NewInt(1e18), selfStake)
+	// plus returns the self-stake plus n
+	plus := func(n int64) *big.Int { return big.NewInt(0).Add(selfStake, big.NewInt(n)) }
+	_, err = account.LoadOrCreateAccount(ws, candidate.RawAddress, plus(100000))
+	require.NoError(err)
+	_, err = account.LoadOrCreateAccount(ws, voter, big.NewInt(300))
+	require.NoError(err)
+	// The candidate has self-nominated before the registration
+	vote, err := action.NewVote(1, candidate.RawAddress, candidate.RawAddress, 100000, big.NewInt(0))
+	require.NoError(err)
+	_, err = p.Handle(runCtx(false), vote, ws)
+	require.NoError(err)
+	vote, err = action.NewVote(1, voter, candidate.RawAddress, 100000, big.NewInt(0))
+	require.NoError(err)
+	_, err = p.Handle(runCtx(false), vote, ws)
+	require.NoError(err)
+	require.Equal(plus(100300), accountOf(candidate.RawAddress).VotingWeight)
+
+	// A self-stake less than the min fails with only the gas charged
+	metadata := action.NewCandidateMetadata("alfa", operator, candidate.RawAddress, candidate.PublicKey)
+	lowStake := big.NewInt(0).Sub(selfStake, big.NewInt(1))
+	rc := action.NewRegisterCandidate(2, candidate.RawAddress, metadata, lowStake, 100000, big.NewInt(1))
+	requireFailure(p.Handle(runCtx(true), rc, ws))
+	c := accountOf(candidate.RawAddress)
+	require.Equal(plus(100000-int64(action.RegisterCandidateIntrinsicGas)), c.Balance)
+	require.Equal(uint64(2), c.Nonce)
+	// So does a self-stake more than the balance
+	rc = action.NewRegisterCandidate(3, candidate.RawAddress, metadata, plus(100000), 100000, big.NewInt(0))
+	requireFailure(p.Handle(runCtx(false), rc, ws))
+
+	// The self-stake is locked from the balance, but still counts for the candidate
+	rc = action.NewRegisterCandidate(4, candidate.RawAddress, metadata, selfStake, 100000, big.NewInt(1))
+	receipt, err := p.Handle(runCtx(true), rc, ws)
+	require.NoError(err)
+	require.Nil(receipt)
+	c = accountOf(candidate.RawAddress)
+	require.Equal(big.NewInt(100000-2*int64(action.RegisterCandidateIntrinsicGas)), c.Balance)
+	require.Equal(plus(100300-2*int64(action.RegisterCandidateIntrinsicGas)), c.VotingWeight)
+	require.Equal(uint64(4), c.Nonce)
+	require.True(c.IsCandidate)
+	registered, err := candidatesutil.LoadCandidate(ws, candidate.RawAddress)
+	require.NoError(err)
+	require.True(registered.Registered())
+	require.Equal("alfa", registered.Name)
+	require.Equal(operator, registered.OperatorAddress)
+	require.Equal(candidate.RawAddress, registered.RewardAddress)
+	require.Equal(candidate.PublicKey, registered.PublicKey)
+	require.Equal(selfStake, registered.SelfStake)
+	require.Equal(c.VotingWeight, registered.Votes)
+
+	// The candidate cannot register twice
+	rc = action.NewRegisterCandidate(5, candidate.RawAddress, metadata, selfStake, 100000, big.NewInt(0))
+	requireFailure(p.Handle(runCtx(false), rc, ws))
+
+	// Unvoting doesn't affect a registered candidate
+	vote, err = action.NewVote(6, candidate.RawAddress, "", 100000, big.NewInt(0))
+	require.NoError(err)
+	_, err = p.Handle(runCtx(false), vote, ws)
+	require.NoError(err)
+	c = accountOf(candidate.RawAddress)
+	require.True(c.IsCandidate)
+	require.Equal(plus(300), c.VotingWeight)
+
+	metadata = action.NewCandidateMetadata("renamed", operator, operator, candidate.PublicKey)
+	_, err = p.Handle(runCtx(false), action.NewUpdateCandidate(7, candidate.RawAddress, metadata, 100000, big.NewInt(0)), ws)
+	require.NoError(err)
+	registered, err = candidatesutil.LoadCandidate(ws, candidate.RawAddress)
+	require.NoError(err)
+	require.Equal("renamed", registered.Name)
+	require.Equal(operator, registered.RewardAddress)
+	require.Equal(selfStake, registered.SelfStake)
+	require.Equal(plus(300), registered.Votes)
+
+	// The self-stake is unlocked back to the balance on unregistration
+	_, err = p.Handle(runCtx(false), action.NewUnregisterCandidate(8, candidate.RawAddress, 100000, big.NewInt(0)), ws)
+	require.NoError(err)
+	c = accountOf(candidate.RawAddress)
+	require.Equal(plus(100000-2*int64(action.RegisterCandidateIntrinsicGas)), c.Balance)
+	require.Equal(big.NewInt(300), c.VotingWeight)
+	require.False(c.IsCandidate)
+	_, err = candidatesutil.LoadCandidate(ws, candidate.RawAddress)
+	require.Equal(state.ErrStateNotExist, errors.Cause(err))
+
+	// An address not registered cannot unregister or update
+	requireFailure(p.Handle(runCtx(false), action.NewUnregisterCandidate(9, candidate.RawAddress, 100000, big.NewInt(0)), ws))
+	uc := action.NewUpdateCandidate(10, candidate.RawAddress, metadata, 100000, big.NewInt(0))
+	requireFailure(p.Handle(runCtx(false), uc, ws))
+	require.Equal(uint64(10), accountOf(candidate.RawAddress).Nonce)
+}
+
+func TestProtocol_ValidateCandidateRegistration(t *testing.T) {
+	require := require.New(t)
+
+	p := NewProtocol(nil)
+	candidate := testaddress.IotxAddrinfo["alfa"]
+	operator := testaddress.IotxAddrinfo["charlie"].RawAddress
+	ctx := context.Background()
+
+	metadata := action.NewCandidateMetadata("alfa", operator, candidate.RawAddress, candidate.PublicKey)
+	rc := action.NewRegisterCandidate(1, candidate.RawAddress, metadata, big.NewInt(1), 100000, big.NewInt(1))
+	require.NoError(p.Validate(ctx, rc))
+	rc = action.NewRegisterCandidate(1, candidate.RawAddress, metadata, big.NewInt(0), 100000, big.NewInt(1))
+	require.Equal(ErrCandidateRegistration, errors.Cause(p.Validate(ctx, rc)))
+	// The public key has to be the one of the candidate
+	metadata = action.NewCandidateMetadata("alfa", operator, candidate.RawAddress, testaddress.IotxAddrinfo["bravo"].PublicKey)
+	rc = action.NewRegisterCandidate(1, candidate.RawAddress, metadata, big.NewInt(1), 100000, big.NewInt(1))
+	require.Equal(ErrCandidateRegistration, errors.Cause(p.Validate(ctx, rc)))
+	uc := action.NewUpdateCandidate(1, candidate.RawAddress, metadata, 100000, big.NewInt(1))
+	require.Equal(ErrCandidateRegistration, errors.Cause(p.Validate(ctx, uc)))
+
+	metadata = action.NewCandidateMetadata("", operator, candidate.RawAddress, candidate.PublicKey)
+	uc = action.NewUpdateCandidate(1, candidate.RawAddress, metadata, 100000, big.NewInt(1))
+	require.Equal(ErrCandidateRegistration, errors.Cause(p.Validate(ctx, uc)))
+	metadata = action.NewCandidateMetadata("alfa", "io1invalid", candidate.RawAddress, candidate.PublicKey)
+	uc = action.NewUpdateCandidate(1, candidate.RawAddress, metadata, 100000, big.NewInt(1))
+	require.Error(p.Validate(ctx, uc))
+	metadata = action.NewCandidateMetadata("alfa", operator, candidate.RawAddress, keypair.ZeroPublicKey)
+	uc = action.NewUpdateCandidate(1, candidate.RawAddress, metadata, 100000, big.NewInt(1))
+	require.Equal(ErrCandidateRegistration, errors.Cause(p.Validate(ctx, uc)))
+	require.NoError(p.Validate(ctx, action.NewUnregisterCandidate(1, candidate.RawAddress, 100000, big.NewInt(1))))
+}
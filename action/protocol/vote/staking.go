@@ -0,0 +1,217 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package vote
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// WithdrawWaitingPeriod is the period in seconds an unstaked bucket has to wait before being withdrawn
+const WithdrawWaitingPeriod = 3 * candidatesutil.StakeDurationUnit
+
+// ErrStake indicates error for a stake action
+var ErrStake = errors.New("invalid stake action")
+
+func (p *Protocol) validateCreateStake(cs *action.CreateStake) error {
+	if cs.Amount().Sign() <= 0 {
+		return errors.Wrap(ErrStake, "non-positive amount")
+	}
+	if cs.Duration() > candidatesutil.MaxStakeDuration {
+		return errors.Wrapf(ErrStake, "duration is longer than %d days", candidatesutil.MaxStakeDuration)
+	}
+	if _, err := iotxaddress.GetPubkeyHash(cs.Candidate()); err != nil {
+		return errors.Wrapf(err, "error when validating candidate's address %s", cs.Candidate())
+	}
+	candidate, err := p.cm.StateByAddr(cs.Candidate())
+	if err != nil {
+		return errors.Wrapf(err, "cannot find candidate's state: %s", cs.Candidate())
+	}
+	if !candidate.IsCandidate {
+		return errors.Wrapf(action.ErrVotee, "candidate has not self-nominated: %s", cs.Candidate())
+	}
+	return nil
+}
+
+func (p *Protocol) handleCreateStake(
+	cs *action.CreateStake,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	staker, err := account.LoadOrCreateAccount(sm, cs.Staker(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of staker %s", cs.Staker())
+	}
+	gasFee, tip, err := account.ChargeGas(cs, cs.Staker(), staker, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	// The stake fails without halting the block if the staker cannot afford it
+	if cs.Amount().Cmp(staker.Balance) == 1 {
+		return account.FailureReceipt(cs, cs.Staker(), staker, gasFee, tip, raCtx, sm)
+	}
+	if err := staker.SubBalance(cs.Amount()); err != nil {
+		return nil, errors.Wrapf(err, "failed to update the Balance of staker %s", cs.Staker())
+	}
+	account.SetNonce(cs, staker)
+	if err := account.StoreAccount(sm, cs.Staker(), staker); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	// The staked amount doesn't count for the votee of the staker by balance any more
+	spent := big.NewInt(0).Add(cs.Amount(), gasFee)
+	if err := account.UpdateVoteeWeights(sm, cs.Staker(), spent.Neg(spent), raCtx.ProducerAddr, tip); err != nil {
+		return nil, err
+	}
+
+	key, err := BucketsKey(cs.Staker())
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := loadBuckets(sm, key)
+	if err != nil {
+		return nil, err
+	}
+	bucket := Bucket{
+		Index:      buckets.NextIndex,
+		Candidate:  cs.Candidate(),
+		Amount:     cs.Amount(),
+		Duration:   cs.Duration(),
+		CreateTime: raCtx.BlockTimeStamp,
+	}
+	buckets.List = append(buckets.List, bucket)
+	buckets.NextIndex++
+	if err := sm.PutState(key, buckets); err != nil {
+		return nil, err
+	}
+	return nil, updateWeight(sm, bucket.Candidate, bucket.Weight())
+}
+
+func (p *Protocol) handleUnstake(
+	us *action.Unstake,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	staker, err := account.LoadOrCreateAccount(sm, us.Staker(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of staker %s", us.Staker())
+	}
+	gasFee, tip, err := account.ChargeGas(us, us.Staker(), staker, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	key, err := BucketsKey(us.Staker())
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := loadBuckets(sm, key)
+	if err != nil {
+		return nil, err
+	}
+	// The unstake fails without halting the block if the bucket doesn't exist, has been unstaked or is still locked
+	i, ok := buckets.Find(us.Index())
+	if !ok || buckets.List[i].Unstaked() || !buckets.List[i].Unlocked(raCtx.BlockTimeStamp) {
+		return account.FailureReceipt(us, us.Staker(), staker, gasFee, tip, raCtx, sm)
+	}
+	bucket := &buckets.List[i]
+	bucket.UnstakeTime = raCtx.BlockTimeStamp
+	account.SetNonce(us, staker)
+	if err := account.StoreAccount(sm, us.Staker(), staker); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if err := account.UpdateVoteeWeights(sm, us.Staker(), big.NewInt(0).Neg(gasFee), raCtx.ProducerAddr, tip); err != nil {
+		return nil, err
+	}
+	if err := sm.PutState(key, buckets); err != nil {
+		return nil, err
+	}
+	return nil, updateWeight(sm, bucket.Candidate, big.NewInt(0).Neg(bucket.Weight()))
+}
+
+func (p *Protocol) handleWithdraw(
+	w *action.Withdraw,
+	raCtx protocol.RunActionsCtx,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	staker, err := account.LoadOrCreateAccount(sm, w.Staker(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of staker %s", w.Staker())
+	}
+	gasFee, tip, err := account.ChargeGas(w, w.Staker(), staker, raCtx, sm)
+	if err != nil {
+		return nil, err
+	}
+	key, err := BucketsKey(w.Staker())
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := loadBuckets(sm, key)
+	if err != nil {
+		return nil, err
+	}
+	// The withdrawal fails without halting the block if the bucket doesn't exist or is not withdrawable yet
+	i, ok := buckets.Find(w.Index())
+	if !ok || !buckets.List[i].Withdrawable(raCtx.BlockTimeStamp) {
+		return account.FailureReceipt(w, w.Staker(), staker, gasFee, tip, raCtx, sm)
+	}
+	bucket := buckets.List[i]
+	if err := staker.AddBalance(bucket.Amount); err != nil {
+		return nil, errors.Wrapf(err, "failed to update the Balance of staker %s", w.Staker())
+	}
+	account.SetNonce(w, staker)
+	if err := account.StoreAccount(sm, w.Staker(), staker); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if err := account.UpdateVoteeWeights(
+		sm,
+		w.Staker(),
+		big.NewInt(0).Sub(bucket.Amount, gasFee),
+		raCtx.ProducerAddr,
+		tip,
+	); err != nil {
+		return nil, err
+	}
+	buckets.List = append(buckets.List[:i], buckets.List[i+1:]...)
+	return nil, sm.PutState(key, buckets)
+}
+
+// updateWeight adds delta to the voting weight of the candidate, and updates the candidate list accordingly
+func updateWeight(sm protocol.StateManager, addr string, delta *big.Int) error {
+	if delta.Sign() == 0 {
+		return nil
+	}
+	candidate, err := account.LoadOrCreateAccount(sm, addr, big.NewInt(0))
+	if err != nil {
+		return errors.Wrapf(err, "failed to load or create the account of %s", addr)
+	}
+	candidate.VotingWeight.Add(candidate.VotingWeight, delta)
+	if err := account.StoreAccount(sm, addr, candidate); err != nil {
+		return errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if candidate.IsCandidate {
+		if err := candidatesutil.LoadAndUpdateCandidates(sm, addr, candidate.VotingWeight); err != nil {
+			return errors.Wrap(err, "failed to load and update candidates")
+		}
+	}
+	return nil
+}
+
+func loadBuckets(sm protocol.StateManager, key hash.PKHash) (Buckets, error) {
+	var buckets Buckets
+	if err := sm.State(key, &buckets); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+		return Buckets{}, errors.Wrapf(err, "error when loading stake buckets of %x", key)
+	}
+	return buckets, nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package vote
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestBuckets(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, big.NewInt(100), candidatesutil.StakeWeight(big.NewInt(100), 0))
+	require.Equal(t, big.NewInt(120), candidatesutil.StakeWeight(big.NewInt(100), 73))
+	require.Equal(t, big.NewInt(200), candidatesutil.StakeWeight(big.NewInt(100), 365))
+	require.Equal(t, big.NewInt(200), candidatesutil.StakeWeight(big.NewInt(100), 1000))
+
+	bs1 := Buckets{
+		NextIndex: 3,
+		List: []Bucket{
+			{
+				Index:      0,
+				Candidate:  testaddress.IotxAddrinfo["alfa"].RawAddress,
+				Amount:     big.NewInt(100),
+				Duration:   7,
+				CreateTime: 1546300800,
+			},
+			{
+				Index:       2,
+				Candidate:   testaddress.IotxAddrinfo["bravo"].RawAddress,
+				Amount:      big.NewInt(200),
+				CreateTime:  1546300800,
+				UnstakeTime: 1546387200,
+			},
+		},
+	}
+	data, err := bs1.Serialize()
+	require.NoError(t, err)
+	var bs2 Buckets
+	require.NoError(t, bs2.Deserialize(data))
+	require.Equal(t, bs1, bs2)
+	i, ok := bs2.Find(2)
+	require.True(t, ok)
+	require.Equal(t, 1, i)
+	_, ok = bs2.Find(1)
+	require.False(t, ok)
+
+	b := bs2.List[0]
+	require.False(t, b.Unlocked(1546300800+7*candidatesutil.StakeDurationUnit-1))
+	require.True(t, b.Unlocked(1546300800+7*candidatesutil.StakeDurationUnit))
+	require.False(t, b.Withdrawable(1546300800+365*candidatesutil.StakeDurationUnit))
+	b = bs2.List[1]
+	require.False(t, b.Withdrawable(1546387200+WithdrawWaitingPeriod-1))
+	require.True(t, b.Withdrawable(1546387200+WithdrawWaitingPeriod))
+}
+
+func TestProtocol_HandleStake(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	p := NewProtocol(nil)
+
+	candidate := testaddress.IotxAddrinfo["alfa"].RawAddress
+	staker := testaddress.IotxAddrinfo["bravo"].RawAddress
+	producer := testaddress.IotxAddrinfo["producer"].RawAddress
+	accountOf := func(addr string) *state.Account {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		acct, err := account.LoadAccount(ws, pkHash)
+		require.NoError(err)
+		return acct
+	}
+	gasLimit := uint64(1000000)
+	runCtx := func(timestamp int64, enableGasCharge bool) context.Context {
+		return protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
+			BlockTimeStamp:  timestamp,
+			ProducerAddr:    producer,
+			GasLimit:        &gasLimit,
+			EnableGasCharge: enableGasCharge,
+		})
+	}
+	_, err = account.LoadOrCreateAccount(ws, candidate, big.NewInt(1000))
+	require.NoError(err)
+	_, err = account.LoadOrCreateAccount(ws, staker, big.NewInt(100000))
+	require.NoError(err)
+	vote, err := action.NewVote(1, candidate, candidate, 100000, big.NewInt(0))
+	require.NoError(err)
+	_, err = p.Handle(runCtx(0, false), vote, ws)
+	require.NoError(err)
+
+	// Stake 100 for 73 days, which counts 120 for the candidate
+	createTime := int64(1546300800)
+	cs := action.NewCreateStake(1, staker, candidate, big.NewInt(100), 73, 100000, big.NewInt(1))
+	_, err = p.Handle(runCtx(createTime, true), cs, ws)
+	require.NoError(err)
+	s := accountOf(staker)
+	require.Equal(big.NewInt(100000-100-int64(action.CreateStakeIntrinsicGas)), s.Balance)
+	require.Equal(uint64(1), s.Nonce)
+	require.Equal(big.NewInt(int64(action.CreateStakeIntrinsicGas)), accountOf(producer).Balance)
+	require.Equal(big.NewInt(1120), accountOf(candidate).VotingWeight)
+	candidates, err := candidatesutil.GetMostRecentCandidateMap(ws)
+	require.NoError(err)
+	pkHash, err := iotxaddress.AddressToPKHash(candidate)
+	require.NoError(err)
+	require.Equal(big.NewInt(1120), candidates[pkHash].Votes)
+	key, err := BucketsKey(staker)
+	require.NoError(err)
+	buckets, err := loadBuckets(ws, key)
+	require.NoError(err)
+	require.Equal(uint64(1), buckets.NextIndex)
+	require.Equal(1, len(buckets.List))
+
+	// The bucket can't be unstaked while locked, or withdrawn before the waiting period. Such actions fail with the
+	// nonce consumed, instead of halting the block.
+	requireFailure := func(receipt *action.Receipt, err error) {
+		require.NoError(err)
+		require.NotNil(receipt)
+		require.Equal(action.FailureReceiptStatus, receipt.Status)
+	}
+	unlockTime := createTime + 73*candidatesutil.StakeDurationUnit
+	requireFailure(p.Handle(runCtx(unlockTime-1, false), action.NewUnstake(2, staker, 0, 100000, big.NewInt(0)), ws))
+	requireFailure(p.Handle(runCtx(unlockTime, false), action.NewUnstake(3, staker, 1, 100000, big.NewInt(0)), ws))
+	require.Equal(uint64(3), accountOf(staker).Nonce)
+	receipt, err := p.Handle(runCtx(unlockTime, false), action.NewUnstake(4, staker, 0, 100000, big.NewInt(0)), ws)
+	require.NoError(err)
+	require.Nil(receipt)
+	require.Equal(big.NewInt(1000), accountOf(candidate).VotingWeight)
+	requireFailure(p.Handle(runCtx(unlockTime, false), action.NewUnstake(5, staker, 0, 100000, big.NewInt(0)), ws))
+
+	withdrawTime := unlockTime + WithdrawWaitingPeriod
+	requireFailure(p.Handle(runCtx(withdrawTime-1, false), action.NewWithdraw(6, staker, 0, 100000, big.NewInt(0)), ws))
+	receipt, err = p.Handle(runCtx(withdrawTime, false), action.NewWithdraw(7, staker, 0, 100000, big.NewInt(0)), ws)
+	require.NoError(err)
+	require.Nil(receipt)
+	s = accountOf(staker)
+	require.Equal(big.NewInt(100000-int64(action.CreateStakeIntrinsicGas)), s.Balance)
+	require.Equal(uint64(7), s.Nonce)
+	buckets, err = loadBuckets(ws, key)
+	require.NoError(err)
+	require.Equal(uint64(1), buckets.NextIndex)
+	require.Equal(0, len(buckets.List))
+
+	// Staking more than the balance only charges the gas
+	cs = action.NewCreateStake(8, staker, candidate, big.NewInt(100000), 73, 100000, big.NewInt(1))
+	receipt, err = p.Handle(runCtx(withdrawTime, true), cs, ws)
+	requireFailure(receipt, err)
+	require.Equal(action.CreateStakeIntrinsicGas, receipt.GasConsumed)
+	s = accountOf(staker)
+	require.Equal(big.NewInt(100000-2*int64(action.CreateStakeIntrinsicGas)), s.Balance)
+	require.Equal(uint64(8), s.Nonce)
+	buckets, err = loadBuckets(ws, key)
+	require.NoError(err)
+	require.Equal(0, len(buckets.List))
+}
+
+func TestProtocol_ValidateStake(t *testing.T) {
+	require := require.New(t)
+
+	p := NewProtocol(nil)
+	staker := testaddress.IotxAddrinfo["bravo"].RawAddress
+	candidate := testaddress.IotxAddrinfo["alfa"].RawAddress
+	ctx := context.Background()
+
+	cs := action.NewCreateStake(1, staker, candidate, big.NewInt(0), 7, 100000, big.NewInt(1))
+	require.Equal(ErrStake, errors.Cause(p.Validate(ctx, cs)))
+	duration := candidatesutil.MaxStakeDuration + 1
+	cs = action.NewCreateStake(1, staker, candidate, big.NewInt(1), duration, 100000, big.NewInt(1))
+	require.Equal(ErrStake, errors.Cause(p.Validate(ctx, cs)))
+	cs = action.NewCreateStake(1, staker, "io1invalid", big.NewInt(1), 7, 100000, big.NewInt(1))
+	require.Error(p.Validate(ctx, cs))
+	require.NoError(p.Validate(ctx, action.NewUnstake(1, staker, 0, 100000, big.NewInt(1))))
+	require.NoError(p.Validate(ctx, action.NewWithdraw(1, staker, 0, 100000, big.NewInt(1))))
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package vote
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+// StateReader reads the states by keys, which both the state factory and the working set implement
+type StateReader interface {
+	State(hash.PKHash, interface{}) error
+}
+
+// WeightDiff is the mismatch between the stored voting weight of an account and the one recomputed from scratch
+type WeightDiff struct {
+	Address  string
+	Stored   *big.Int
+	Expected *big.Int
+}
+
+// CheckWeights recomputes the voting weights of the votees of the given accounts from scratch, which are the sums of
+// the balances of their voters, the weights of the stake buckets for them and the self-stakes of the registered
+// candidates, and returns the mismatches with the stored voting weights in the order of the addresses. The accounts
+// should include all the voters and stakers.
+func CheckWeights(sr StateReader, addrs []string, candidates state.CandidateList) ([]WeightDiff, error) {
+	expected := make(map[string]*big.Int)
+	add := func(addr string, weight *big.Int) {
+		if _, ok := expected[addr]; !ok {
+			expected[addr] = big.NewInt(0)
+		}
+		expected[addr].Add(expected[addr], weight)
+	}
+	for _, c := range candidates {
+		if c.Registered() {
+			add(c.Address, c.SelfStake)
+		}
+	}
+	accounts := make(map[string]*state.Account)
+	for _, addr := range addrs {
+		acct, err := readAccount(sr, addr)
+		if err != nil {
+			return nil, err
+		}
+		accounts[addr] = acct
+		if len(acct.Votee) > 0 {
+			add(acct.Votee, acct.Balance)
+		}
+		key, err := BucketsKey(addr)
+		if err != nil {
+			return nil, err
+		}
+		var buckets Buckets
+		if err := sr.State(key, &buckets); err != nil && errors.Cause(err) != state.ErrStateNotExist {
+			return nil, errors.Wrapf(err, "error when loading stake buckets of %s", addr)
+		}
+		for _, b := range buckets.List {
+			if !b.Unstaked() {
+				add(b.Candidate, b.Weight())
+			}
+		}
+	}
+
+	all := make([]string, 0, len(accounts))
+	for addr := range accounts {
+		all = append(all, addr)
+	}
+	for addr := range expected {
+		if _, ok := accounts[addr]; !ok {
+			all = append(all, addr)
+		}
+	}
+	sort.Strings(all)
+	var diffs []WeightDiff
+	for _, addr := range all {
+		acct, ok := accounts[addr]
+		if !ok {
+			var err error
+			if acct, err = readAccount(sr, addr); err != nil {
+				return nil, err
+			}
+		}
+		weight, ok := expected[addr]
+		if !ok {
+			weight = big.NewInt(0)
+		}
+		if acct.VotingWeight.Cmp(weight) != 0 {
+			diffs = append(diffs, WeightDiff{Address: addr, Stored: acct.VotingWeight, Expected: weight})
+		}
+	}
+	return diffs, nil
+}
+
+func readAccount(sr StateReader, addr string) (*state.Account, error) {
+	addrHash, err := iotxaddress.AddressToPKHash(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert address to public key hash")
+	}
+	var acct state.Account
+	if err := sr.State(addrHash, &acct); err != nil {
+		if errors.Cause(err) != state.ErrStateNotExist {
+			return nil, errors.Wrapf(err, "error when loading the account of %s", addr)
+		}
+		return &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(0)}, nil
+	}
+	return &acct, nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package vote
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestCheckWeights(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.Background()
+	sf, err := factory.NewFactory(config.Default, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+
+	alfa := testaddress.IotxAddrinfo["alfa"].RawAddress
+	bravo := testaddress.IotxAddrinfo["bravo"].RawAddress
+	charlie := testaddress.IotxAddrinfo["charlie"].RawAddress
+	delta := testaddress.IotxAddrinfo["delta"].RawAddress
+	echo := testaddress.IotxAddrinfo["echo"].RawAddress
+	putAccount := func(addr string, acct *state.Account) {
+		pkHash, err := iotxaddress.AddressToPKHash(addr)
+		require.NoError(err)
+		require.NoError(ws.PutState(pkHash, acct))
+	}
+	putAccount(alfa, &state.Account{Balance: big.NewInt(100), VotingWeight: big.NewInt(150), Votee: alfa})
+	putAccount(bravo, &state.Account{Balance: big.NewInt(50), VotingWeight: big.NewInt(0), Votee: alfa})
+	putAccount(charlie, &state.Account{Balance: big.NewInt(10), VotingWeight: big.NewInt(0), Votee: delta})
+	putAccount(echo, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(0)})
+	key, err := BucketsKey(echo)
+	require.NoError(err)
+	require.NoError(ws.PutState(key, Buckets{
+		NextIndex: 2,
+		List: []Bucket{
+			{Index: 0, Candidate: alfa, Amount: big.NewInt(100), Duration: 73},
+			{Index: 1, Candidate: alfa, Amount: big.NewInt(100), UnstakeTime: 1},
+		},
+	}))
+
+	candidates := state.CandidateList{
+		{Address: alfa, Votes: big.NewInt(150), SelfStake: big.NewInt(30)},
+		{Address: delta, Votes: big.NewInt(0)},
+	}
+
+	diffs, err := CheckWeights(ws, []string{alfa, bravo, charlie, echo}, candidates)
+	require.NoError(err)
+	require.Equal(2, len(diffs))
+	weights := make(map[string][2]string)
+	for _, d := range diffs {
+		weights[d.Address] = [2]string{d.Stored.String(), d.Expected.String()}
+	}
+	// The weight of alfa misses the stake bucket of echo and its self-stake, and delta misses the vote of charlie
+	require.Equal([2]string{"150", "300"}, weights[alfa])
+	require.Equal([2]string{"0", "10"}, weights[delta])
+}
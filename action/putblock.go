@@ -19,6 +19,24 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+func init() {
+	mustRegister("putBlock", &codecFuncs{
+		newPayload: func() Payload { return &PutBlock{} },
+		oneof:      &iproto.ActionPb_PutBlock{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_PutBlock{PutBlock: act.(*PutBlock).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &PutBlock{}
+			if err := act.LoadProto(pbAct.GetPutBlock()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetPutBlock().SubChainAddress, nil
+		},
+	})
+}
+
 // PutBlockIntrinsicGas is the instrinsic gas for put block action.
 const PutBlockIntrinsicGas = uint64(1000)
 
@@ -0,0 +1,185 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+// Codec converts a kind of action payload from and to the action proto
+type Codec interface {
+	// New returns an empty payload of the kind, which identifies the payload type of the kind
+	New() Payload
+	// Oneof returns an empty wrapper of the oneof action field of the action proto, such as
+	// &iproto.ActionPb_Transfer{}, which identifies the action protos carrying a payload of the kind
+	Oneof() interface{}
+	// Encode puts the payload into the action proto
+	Encode(Payload, *iproto.ActionPb) error
+	// Decode loads the payload from the action proto, and returns it together with its destination address
+	Decode(*iproto.ActionPb) (Payload, string, error)
+}
+
+// GenericPayload is the payload of an action kind defined outside the core, which is carried in GenericActionPb
+type GenericPayload interface {
+	Payload
+	DstAddr() string
+	Serialize() ([]byte, error)
+	Deserialize([]byte) error
+}
+
+type registry struct {
+	mutex    sync.RWMutex
+	codecs   map[string]Codec
+	byTypes  map[reflect.Type]string
+	byOneofs map[reflect.Type]string
+}
+
+var (
+	actionRegistry = registry{
+		codecs:   make(map[string]Codec),
+		byTypes:  make(map[reflect.Type]string),
+		byOneofs: make(map[reflect.Type]string),
+	}
+	// genericOneof is the oneof type shared by the generic kinds, which are told apart by the kind in the proto
+	genericOneof = reflect.TypeOf(&iproto.ActionPb_Generic{})
+)
+
+// Register registers the codec of a kind of action
+func Register(kind string, codec Codec) error {
+	if kind == "" || codec == nil {
+		return errors.Wrap(ErrAction, "empty action kind or codec to register")
+	}
+	payloadType := reflect.TypeOf(codec.New())
+	oneofType := reflect.TypeOf(codec.Oneof())
+	_, isGeneric := codec.(*genericCodec)
+	if oneofType == nil || (oneofType == genericOneof) != isGeneric {
+		return errors.Wrapf(ErrAction, "invalid oneof type %v of action kind %s", oneofType, kind)
+	}
+	actionRegistry.mutex.Lock()
+	defer actionRegistry.mutex.Unlock()
+	if _, ok := actionRegistry.codecs[kind]; ok {
+		return errors.Wrapf(ErrAction, "action kind %s is already registered", kind)
+	}
+	if registered, ok := actionRegistry.byTypes[payloadType]; ok {
+		return errors.Wrapf(ErrAction, "payload type %s is already registered as %s", payloadType, registered)
+	}
+	if registered, ok := actionRegistry.byOneofs[oneofType]; ok && !isGeneric {
+		return errors.Wrapf(ErrAction, "oneof type %s is already registered as %s", oneofType, registered)
+	}
+	actionRegistry.codecs[kind] = codec
+	actionRegistry.byTypes[payloadType] = kind
+	if !isGeneric {
+		actionRegistry.byOneofs[oneofType] = kind
+	}
+	return nil
+}
+
+// RegisterGeneric registers a kind of action whose payload is carried in GenericActionPb
+func RegisterGeneric(kind string, newPayload func() GenericPayload) error {
+	return Register(kind, &genericCodec{kind: kind, newPayload: newPayload})
+}
+
+// KindOf returns the registered kind of the action payload
+func KindOf(act Action) (string, bool) {
+	actionRegistry.mutex.RLock()
+	defer actionRegistry.mutex.RUnlock()
+	kind, ok := actionRegistry.byTypes[reflect.TypeOf(act)]
+	return kind, ok
+}
+
+func codecOf(act Action) (Codec, error) {
+	actionRegistry.mutex.RLock()
+	defer actionRegistry.mutex.RUnlock()
+	kind, ok := actionRegistry.byTypes[reflect.TypeOf(act)]
+	if !ok {
+		return nil, errors.Wrapf(ErrAction, "action type %T is not registered", act)
+	}
+	return actionRegistry.codecs[kind], nil
+}
+
+// matchCodec returns the codec of the action proto, which is dispatched on the type of the oneof action field, and
+// on the kind for a generic action
+func matchCodec(pbAct *iproto.ActionPb) Codec {
+	if pbAct.GetAction() == nil {
+		return nil
+	}
+	actionRegistry.mutex.RLock()
+	defer actionRegistry.mutex.RUnlock()
+	if generic := pbAct.GetGeneric(); generic != nil {
+		codec, ok := actionRegistry.codecs[generic.Kind].(*genericCodec)
+		if !ok {
+			return nil
+		}
+		return codec
+	}
+	kind, ok := actionRegistry.byOneofs[reflect.TypeOf(pbAct.GetAction())]
+	if !ok {
+		return nil
+	}
+	return actionRegistry.codecs[kind]
+}
+
+func mustRegister(kind string, codec Codec) {
+	if err := Register(kind, codec); err != nil {
+		panic(err)
+	}
+}
+
+// codecFuncs implements Codec of the core action kinds by functions
+type codecFuncs struct {
+	newPayload func() Payload
+	oneof      interface{}
+	encode     func(Payload, *iproto.ActionPb) error
+	decode     func(*iproto.ActionPb) (Payload, string, error)
+}
+
+func (c *codecFuncs) New() Payload { return c.newPayload() }
+
+func (c *codecFuncs) Oneof() interface{} { return c.oneof }
+
+func (c *codecFuncs) Encode(act Payload, pbAct *iproto.ActionPb) error { return c.encode(act, pbAct) }
+
+func (c *codecFuncs) Decode(pbAct *iproto.ActionPb) (Payload, string, error) { return c.decode(pbAct) }
+
+type genericCodec struct {
+	kind       string
+	newPayload func() GenericPayload
+}
+
+func (c *genericCodec) New() Payload { return c.newPayload() }
+
+func (c *genericCodec) Oneof() interface{} { return &iproto.ActionPb_Generic{} }
+
+func (c *genericCodec) Encode(act Payload, pbAct *iproto.ActionPb) error {
+	generic, ok := act.(GenericPayload)
+	if !ok {
+		return errors.Wrapf(ErrAction, "action type %T is not a generic payload", act)
+	}
+	payload, err := generic.Serialize()
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize %s action", c.kind)
+	}
+	pbAct.Action = &iproto.ActionPb_Generic{Generic: &iproto.GenericActionPb{
+		Kind:      c.kind,
+		Recipient: generic.DstAddr(),
+		Payload:   payload,
+	}}
+	return nil
+}
+
+func (c *genericCodec) Decode(pbAct *iproto.ActionPb) (Payload, string, error) {
+	act := c.newPayload()
+	if err := act.Deserialize(pbAct.GetGeneric().Payload); err != nil {
+		return nil, "", errors.Wrapf(err, "failed to deserialize %s action", c.kind)
+	}
+	return act, pbAct.GetGeneric().Recipient, nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+type memo struct {
+	AbstractAction
+
+	text []byte
+}
+
+func (m *memo) ByteStream() []byte { return m.text }
+
+func (m *memo) Cost() (*big.Int, error) { return big.NewInt(0), nil }
+
+func (m *memo) IntrinsicGas() (uint64, error) { return uint64(len(m.text)), nil }
+
+func (m *memo) Serialize() ([]byte, error) { return m.text, nil }
+
+func (m *memo) Deserialize(buf []byte) error {
+	m.text = buf
+	return nil
+}
+
+type note struct {
+	memo
+}
+
+func TestRegistry(t *testing.T) {
+	require := require.New(t)
+
+	kind, ok := KindOf(&Transfer{})
+	require.True(ok)
+	require.Equal("transfer", kind)
+	_, ok = KindOf(&memo{})
+	require.False(ok)
+
+	require.NoError(RegisterGeneric("memo", func() GenericPayload { return &memo{} }))
+	kind, ok = KindOf(&memo{})
+	require.True(ok)
+	require.Equal("memo", kind)
+	// Register the same kind or payload type again
+	err := RegisterGeneric("memo", func() GenericPayload { return &memo{} })
+	require.Equal(ErrAction, errors.Cause(err))
+	err = RegisterGeneric("memo2", func() GenericPayload { return &memo{} })
+	require.Equal(ErrAction, errors.Cause(err))
+
+	sender, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainid)
+	require.NoError(err)
+	recipient, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainid)
+	require.NoError(err)
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetDestinationAddress(recipient.RawAddress).
+		SetGasLimit(uint64(100000)).
+		SetAction(&memo{text: []byte("hello")}).Build()
+	selp, err := Sign(elp, sender.RawAddress, sender.PrivateKey)
+	require.NoError(err)
+	require.NoError(Verify(selp))
+
+	actPb, err := selp.Proto()
+	require.NoError(err)
+	require.Equal("memo", actPb.GetGeneric().Kind)
+	require.Equal(recipient.RawAddress, actPb.GetGeneric().Recipient)
+	nselp := &SealedEnvelope{}
+	require.NoError(nselp.LoadProto(actPb))
+	require.Equal(selp.Hash(), nselp.Hash())
+	require.Equal(recipient.RawAddress, nselp.DstAddr())
+	require.Equal([]byte("hello"), nselp.Action().(*memo).text)
+	require.NoError(Verify(*nselp))
+
+	// Unregistered generic kind
+	actPb.GetGeneric().Kind = "unknown"
+	require.Error(nselp.LoadProto(actPb))
+	require.Error(nselp.LoadProto(&iproto.ActionPb{SenderPubKey: sender.PublicKey[:]}))
+
+	// Unregistered payload type
+	elp = bd.SetDestinationAddress(recipient.RawAddress).
+		SetGasLimit(uint64(100000)).
+		SetAction(&note{memo{text: []byte("hello")}}).Build()
+	selp, err = Sign(elp, sender.RawAddress, sender.PrivateKey)
+	require.NoError(err)
+	_, err = selp.Proto()
+	require.Equal(ErrAction, errors.Cause(err))
+
+	// A codec cannot claim the oneof type of another kind, nor the generic one
+	err = Register("transfer2", &codecFuncs{
+		newPayload: func() Payload { return &note{} },
+		oneof:      &iproto.ActionPb_Transfer{},
+	})
+	require.Equal(ErrAction, errors.Cause(err))
+	err = Register("generic", &codecFuncs{
+		newPayload: func() Payload { return &note{} },
+		oneof:      &iproto.ActionPb_Generic{},
+	})
+	require.Equal(ErrAction, errors.Cause(err))
+	_, ok = KindOf(&note{})
+	require.False(ok)
+}
@@ -18,6 +18,24 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+func init() {
+	mustRegister("settleDeposit", &codecFuncs{
+		newPayload: func() Payload { return &SettleDeposit{} },
+		oneof:      &iproto.ActionPb_SettleDeposit{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_SettleDeposit{SettleDeposit: act.(*SettleDeposit).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &SettleDeposit{}
+			if err := act.LoadProto(pbAct.GetSettleDeposit()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetSettleDeposit().Recipient, nil
+		},
+	})
+}
+
 const (
 	// SettleDepositIntrinsicGas represents the intrinsic gas for the deposit action
 	SettleDepositIntrinsicGas = uint64(10000)
@@ -0,0 +1,294 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+func init() {
+	mustRegister("createStake", &codecFuncs{
+		newPayload: func() Payload { return &CreateStake{} },
+		oneof:      &iproto.ActionPb_CreateStake{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_CreateStake{CreateStake: act.(*CreateStake).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &CreateStake{}
+			if err := act.LoadProto(pbAct.GetCreateStake()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetCreateStake().Candidate, nil
+		},
+	})
+	mustRegister("unstake", &codecFuncs{
+		newPayload: func() Payload { return &Unstake{} },
+		oneof:      &iproto.ActionPb_Unstake{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_Unstake{Unstake: act.(*Unstake).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &Unstake{}
+			if err := act.LoadProto(pbAct.GetUnstake()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+	mustRegister("withdraw", &codecFuncs{
+		newPayload: func() Payload { return &Withdraw{} },
+		oneof:      &iproto.ActionPb_Withdraw{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_Withdraw{Withdraw: act.(*Withdraw).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &Withdraw{}
+			if err := act.LoadProto(pbAct.GetWithdraw()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+}
+
+const (
+	// CreateStakeIntrinsicGas represents the intrinsic gas for the stake creation action
+	CreateStakeIntrinsicGas = uint64(10000)
+	// UnstakeIntrinsicGas represents the intrinsic gas for the unstake action
+	UnstakeIntrinsicGas = uint64(10000)
+	// WithdrawIntrinsicGas represents the intrinsic gas for the stake withdrawal action
+	WithdrawIntrinsicGas = uint64(10000)
+)
+
+// CreateStake represents the action to stake the amount of the sender for the candidate in a new bucket, which is
+// locked for the duration in days
+type CreateStake struct {
+	AbstractAction
+
+	amount   *big.Int
+	duration uint32
+}
+
+// NewCreateStake instantiates a stake creation action struct
+func NewCreateStake(
+	nonce uint64,
+	staker string,
+	candidate string,
+	amount *big.Int,
+	duration uint32,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) *CreateStake {
+	return &CreateStake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  staker,
+			dstAddr:  candidate,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		amount:   amount,
+		duration: duration,
+	}
+}
+
+// Staker returns the staker address. It's the wrapper of Action.SrcAddr
+func (cs *CreateStake) Staker() string { return cs.SrcAddr() }
+
+// Candidate returns the address of the candidate staked for. It's the wrapper of Action.DstAddr
+func (cs *CreateStake) Candidate() string { return cs.DstAddr() }
+
+// Amount returns the amount to stake
+func (cs *CreateStake) Amount() *big.Int { return cs.amount }
+
+// Duration returns the lock duration in days
+func (cs *CreateStake) Duration() uint32 { return cs.duration }
+
+// ByteStream returns a raw byte stream of the stake creation action
+func (cs *CreateStake) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(cs.Proto()))
+}
+
+// Proto converts CreateStake to protobuf's ActionPb
+func (cs *CreateStake) Proto() *iproto.CreateStakePb {
+	pbCS := &iproto.CreateStakePb{
+		Candidate: cs.dstAddr,
+		Duration:  cs.duration,
+	}
+	if cs.amount != nil {
+		pbCS.Amount = cs.amount.Bytes()
+	}
+	return pbCS
+}
+
+// LoadProto converts a protobuf's ActionPb to CreateStake
+func (cs *CreateStake) LoadProto(pbCS *iproto.CreateStakePb) error {
+	if cs == nil {
+		return errors.New("nil action to load proto")
+	}
+	*cs = CreateStake{}
+
+	if pbCS == nil {
+		return errors.New("empty action proto to load")
+	}
+	cs.dstAddr = pbCS.Candidate
+	cs.amount = big.NewInt(0).SetBytes(pbCS.Amount)
+	cs.duration = pbCS.Duration
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a stake creation
+func (cs *CreateStake) IntrinsicGas() (uint64, error) { return CreateStakeIntrinsicGas, nil }
+
+// Cost returns the total cost of a stake creation
+func (cs *CreateStake) Cost() (*big.Int, error) {
+	intrinsicGas, err := cs.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the stake creation")
+	}
+	fee := big.NewInt(0).Mul(cs.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return fee.Add(fee, cs.Amount()), nil
+}
+
+// Unstake represents the action to start unstaking a bucket of the sender after its lock duration. The bucket stops
+// counting for the candidate, and could be withdrawn after the withdrawal waiting period.
+type Unstake struct {
+	AbstractAction
+
+	index uint64
+}
+
+// NewUnstake instantiates an unstake action struct
+func NewUnstake(nonce uint64, staker string, index uint64, gasLimit uint64, gasPrice *big.Int) *Unstake {
+	return &Unstake{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  staker,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		index: index,
+	}
+}
+
+// Staker returns the staker address. It's the wrapper of Action.SrcAddr
+func (us *Unstake) Staker() string { return us.SrcAddr() }
+
+// Index returns the index of the bucket to unstake
+func (us *Unstake) Index() uint64 { return us.index }
+
+// ByteStream returns a raw byte stream of the unstake action
+func (us *Unstake) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(us.Proto()))
+}
+
+// Proto converts Unstake to protobuf's ActionPb
+func (us *Unstake) Proto() *iproto.UnstakePb {
+	return &iproto.UnstakePb{Index: us.index}
+}
+
+// LoadProto converts a protobuf's ActionPb to Unstake
+func (us *Unstake) LoadProto(pbUS *iproto.UnstakePb) error {
+	if us == nil {
+		return errors.New("nil action to load proto")
+	}
+	*us = Unstake{}
+
+	if pbUS == nil {
+		return errors.New("empty action proto to load")
+	}
+	us.index = pbUS.Index
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of an unstake
+func (us *Unstake) IntrinsicGas() (uint64, error) { return UnstakeIntrinsicGas, nil }
+
+// Cost returns the total cost of an unstake
+func (us *Unstake) Cost() (*big.Int, error) {
+	intrinsicGas, err := us.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the unstake")
+	}
+	return big.NewInt(0).Mul(us.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
+
+// Withdraw represents the action to pay the amount of an unstaked bucket back to the sender after the withdrawal
+// waiting period, and remove the bucket
+type Withdraw struct {
+	AbstractAction
+
+	index uint64
+}
+
+// NewWithdraw instantiates a stake withdrawal action struct
+func NewWithdraw(nonce uint64, staker string, index uint64, gasLimit uint64, gasPrice *big.Int) *Withdraw {
+	return &Withdraw{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  staker,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		index: index,
+	}
+}
+
+// Staker returns the staker address. It's the wrapper of Action.SrcAddr
+func (w *Withdraw) Staker() string { return w.SrcAddr() }
+
+// Index returns the index of the bucket to withdraw
+func (w *Withdraw) Index() uint64 { return w.index }
+
+// ByteStream returns a raw byte stream of the stake withdrawal action
+func (w *Withdraw) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(w.Proto()))
+}
+
+// Proto converts Withdraw to protobuf's ActionPb
+func (w *Withdraw) Proto() *iproto.WithdrawPb {
+	return &iproto.WithdrawPb{Index: w.index}
+}
+
+// LoadProto converts a protobuf's ActionPb to Withdraw
+func (w *Withdraw) LoadProto(pbW *iproto.WithdrawPb) error {
+	if w == nil {
+		return errors.New("nil action to load proto")
+	}
+	*w = Withdraw{}
+
+	if pbW == nil {
+		return errors.New("empty action proto to load")
+	}
+	w.index = pbW.Index
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a stake withdrawal
+func (w *Withdraw) IntrinsicGas() (uint64, error) { return WithdrawIntrinsicGas, nil }
+
+// Cost returns the total cost of a stake withdrawal
+func (w *Withdraw) Cost() (*big.Int, error) {
+	intrinsicGas, err := w.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the stake withdrawal")
+	}
+	return big.NewInt(0).Mul(w.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas)), nil
+}
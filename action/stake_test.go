@@ -0,0 +1,69 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestStakeProto(t *testing.T) {
+	t.Parallel()
+
+	staker := testaddress.IotxAddrinfo["producer"].RawAddress
+	candidate := testaddress.IotxAddrinfo["alfa"].RawAddress
+
+	cs1 := NewCreateStake(1, staker, candidate, big.NewInt(1000), 30, 10, big.NewInt(100))
+	assert.Equal(t, staker, cs1.Staker())
+	cost, err := cs1.Cost()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000+int64(CreateStakeIntrinsicGas)*100), cost)
+
+	// Round trip through a sealed envelope
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetGasLimit(10).SetGasPrice(big.NewInt(100)).SetAction(cs1).Build()
+	selp, err := Sign(elp, staker, testaddress.IotxAddrinfo["producer"].PrivateKey)
+	require.NoError(t, err)
+	var selp2 SealedEnvelope
+	actPb, err := selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	cs2, ok := selp2.Action().(*CreateStake)
+	require.True(t, ok)
+	assert.Equal(t, candidate, cs2.Candidate())
+	assert.Equal(t, big.NewInt(1000), cs2.Amount())
+	assert.Equal(t, uint32(30), cs2.Duration())
+	kind, ok := KindOf(cs2)
+	require.True(t, ok)
+	assert.Equal(t, "createStake", kind)
+
+	elp = bd.SetNonce(2).SetAction(NewUnstake(2, staker, 3, 10, big.NewInt(100))).Build()
+	selp, err = Sign(elp, staker, testaddress.IotxAddrinfo["producer"].PrivateKey)
+	require.NoError(t, err)
+	actPb, err = selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	us, ok := selp2.Action().(*Unstake)
+	require.True(t, ok)
+	assert.Equal(t, uint64(3), us.Index())
+
+	elp = bd.SetNonce(3).SetAction(NewWithdraw(3, staker, 3, 10, big.NewInt(100))).Build()
+	selp, err = Sign(elp, staker, testaddress.IotxAddrinfo["producer"].PrivateKey)
+	require.NoError(t, err)
+	actPb, err = selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	w, ok := selp2.Action().(*Withdraw)
+	require.True(t, ok)
+	assert.Equal(t, uint64(3), w.Index())
+	assert.Equal(t, staker, w.Staker())
+}
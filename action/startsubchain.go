@@ -18,6 +18,24 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+func init() {
+	mustRegister("startSubChain", &codecFuncs{
+		newPayload: func() Payload { return &StartSubChain{} },
+		oneof:      &iproto.ActionPb_StartSubChain{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_StartSubChain{StartSubChain: act.(*StartSubChain).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &StartSubChain{}
+			if err := act.LoadProto(pbAct.GetStartSubChain()); err != nil {
+				return nil, "", err
+			}
+			return act, "", nil
+		},
+	})
+}
+
 const (
 	// StartSubChainIntrinsicGas is the instrinsic gas for start sub chain action
 	StartSubChainIntrinsicGas = uint64(1000)
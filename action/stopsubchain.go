@@ -17,6 +17,24 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+func init() {
+	mustRegister("stopSubChain", &codecFuncs{
+		newPayload: func() Payload { return &StopSubChain{} },
+		oneof:      &iproto.ActionPb_StopSubChain{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_StopSubChain{StopSubChain: act.(*StopSubChain).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &StopSubChain{}
+			if err := act.LoadProto(pbAct.GetStopSubChain()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetStopSubChain().SubChainAddress, nil
+		},
+	})
+}
+
 const (
 	// StopSubChainIntrinsicGas is the instrinsic gas for stop sub chain action
 	StopSubChainIntrinsicGas = uint64(1000)
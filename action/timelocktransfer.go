@@ -0,0 +1,141 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+func init() {
+	mustRegister("timelockTransfer", &codecFuncs{
+		newPayload: func() Payload { return &TimelockTransfer{} },
+		oneof:      &iproto.ActionPb_TimelockTransfer{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_TimelockTransfer{TimelockTransfer: act.(*TimelockTransfer).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &TimelockTransfer{}
+			if err := act.LoadProto(pbAct.GetTimelockTransfer()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetTimelockTransfer().Recipient, nil
+		},
+	})
+}
+
+const (
+	// TimelockTransferIntrinsicGas represents the intrinsic gas for the time-locked transfer action
+	TimelockTransferIntrinsicGas = uint64(20000)
+)
+
+// TimelockTransfer represents the action to transfer the token to the recipient, which is only released at or after the
+// given block height and/or timestamp. A zero release height or release time means no constraint on that dimension.
+type TimelockTransfer struct {
+	AbstractAction
+
+	amount        *big.Int
+	releaseHeight uint64
+	releaseTime   int64
+}
+
+// NewTimelockTransfer instantiates a time-locked transfer action struct
+func NewTimelockTransfer(
+	nonce uint64,
+	amount *big.Int,
+	sender string,
+	recipient string,
+	releaseHeight uint64,
+	releaseTime int64,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) *TimelockTransfer {
+	return &TimelockTransfer{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			srcAddr:  sender,
+			dstAddr:  recipient,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		amount:        amount,
+		releaseHeight: releaseHeight,
+		releaseTime:   releaseTime,
+	}
+}
+
+// Amount returns the amount
+func (tt *TimelockTransfer) Amount() *big.Int { return tt.amount }
+
+// Sender returns the sender address. It's the wrapper of Action.SrcAddr
+func (tt *TimelockTransfer) Sender() string { return tt.SrcAddr() }
+
+// Recipient returns the recipient address. It's the wrapper of Action.DstAddr
+func (tt *TimelockTransfer) Recipient() string { return tt.DstAddr() }
+
+// ReleaseHeight returns the block height at or after which the amount is released
+func (tt *TimelockTransfer) ReleaseHeight() uint64 { return tt.releaseHeight }
+
+// ReleaseTime returns the unix timestamp at or after which the amount is released
+func (tt *TimelockTransfer) ReleaseTime() int64 { return tt.releaseTime }
+
+// ByteStream returns a raw byte stream of the time-locked transfer action
+func (tt *TimelockTransfer) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(tt.Proto()))
+}
+
+// Proto converts TimelockTransfer to protobuf's ActionPb
+func (tt *TimelockTransfer) Proto() *iproto.TimelockTransferPb {
+	act := &iproto.TimelockTransferPb{
+		Recipient:     tt.dstAddr,
+		ReleaseHeight: tt.releaseHeight,
+		ReleaseTime:   tt.releaseTime,
+	}
+	if tt.amount != nil && len(tt.amount.Bytes()) > 0 {
+		act.Amount = tt.amount.Bytes()
+	}
+	return act
+}
+
+// LoadProto converts a protobuf's ActionPb to TimelockTransfer
+func (tt *TimelockTransfer) LoadProto(pbTT *iproto.TimelockTransferPb) error {
+	if tt == nil {
+		return errors.New("nil action to load proto")
+	}
+	*tt = TimelockTransfer{}
+
+	if pbTT == nil {
+		return errors.New("empty action proto to load")
+	}
+
+	tt.amount = big.NewInt(0)
+	tt.amount.SetBytes(pbTT.GetAmount())
+	tt.releaseHeight = pbTT.GetReleaseHeight()
+	tt.releaseTime = pbTT.GetReleaseTime()
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a time-locked transfer
+func (tt *TimelockTransfer) IntrinsicGas() (uint64, error) { return TimelockTransferIntrinsicGas, nil }
+
+// Cost returns the total cost of a time-locked transfer
+func (tt *TimelockTransfer) Cost() (*big.Int, error) {
+	intrinsicGas, err := tt.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the time-locked transfer")
+	}
+	fee := big.NewInt(0).Mul(tt.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return big.NewInt(0).Add(tt.Amount(), fee), nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestTimelockTransferProto(t *testing.T) {
+	t.Parallel()
+
+	sender := testaddress.IotxAddrinfo["producer"].RawAddress
+	recipient := testaddress.IotxAddrinfo["alfa"].RawAddress
+
+	assertTimelock := func(tt *TimelockTransfer) {
+		require.NotNil(t, tt)
+		assert.Equal(t, big.NewInt(1000), tt.Amount())
+		assert.Equal(t, uint64(100), tt.ReleaseHeight())
+		assert.Equal(t, int64(1546300800), tt.ReleaseTime())
+	}
+
+	tt1 := NewTimelockTransfer(1, big.NewInt(1000), sender, recipient, 100, 1546300800, 10, big.NewInt(100))
+	assertTimelock(tt1)
+	assert.Equal(t, sender, tt1.Sender())
+	assert.Equal(t, recipient, tt1.Recipient())
+	cost, err := tt1.Cost()
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(1000+int64(TimelockTransferIntrinsicGas)*100), cost)
+
+	var tt2 TimelockTransfer
+	require.NoError(t, tt2.LoadProto(tt1.Proto()))
+	assertTimelock(&tt2)
+
+	// Round trip through a sealed envelope
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetGasLimit(10).SetGasPrice(big.NewInt(100)).SetAction(tt1).Build()
+	selp, err := Sign(elp, sender, testaddress.IotxAddrinfo["producer"].PrivateKey)
+	require.NoError(t, err)
+	var selp2 SealedEnvelope
+	actPb, err := selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	tt3, ok := selp2.Action().(*TimelockTransfer)
+	require.True(t, ok)
+	assertTimelock(tt3)
+	assert.Equal(t, recipient, tt3.Recipient())
+	kind, ok := KindOf(tt3)
+	require.True(t, ok)
+	assert.Equal(t, "timelockTransfer", kind)
+
+	ct := NewClaimTimelock(2, recipient, 10, big.NewInt(100))
+	elp = bd.SetNonce(2).SetAction(ct).Build()
+	selp, err = Sign(elp, recipient, testaddress.IotxAddrinfo["alfa"].PrivateKey)
+	require.NoError(t, err)
+	actPb, err = selp.Proto()
+	require.NoError(t, err)
+	require.NoError(t, selp2.LoadProto(actPb))
+	ct2, ok := selp2.Action().(*ClaimTimelock)
+	require.True(t, ok)
+	assert.Equal(t, recipient, ct2.Claimer())
+}
@@ -19,9 +19,29 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+func init() {
+	mustRegister("transfer", &codecFuncs{
+		newPayload: func() Payload { return &Transfer{} },
+		oneof:      &iproto.ActionPb_Transfer{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_Transfer{Transfer: act.(*Transfer).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &Transfer{}
+			if err := act.LoadProto(pbAct.GetTransfer()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetTransfer().Recipient, nil
+		},
+	})
+}
+
 const (
 	// TransferPayloadGas represents the transfer payload gas per uint
 	TransferPayloadGas = uint64(100)
+	// TransferMemoGas represents the transfer memo gas per byte
+	TransferMemoGas = uint64(100)
 	// TransferBaseIntrinsicGas represents the base intrinsic gas for transfer
 	TransferBaseIntrinsicGas = uint64(10000)
 )
@@ -32,6 +52,7 @@ type Transfer struct {
 
 	amount  *big.Int
 	payload []byte
+	memo    *Memo
 	// Coinbase transfer is not expected to be received from the network but can only be generated by block producer
 	isCoinbase bool
 }
@@ -66,6 +87,25 @@ func NewTransfer(
 	}, nil
 }
 
+// NewTransferWithMemo returns a Transfer instance with a typed memo
+func NewTransferWithMemo(
+	nonce uint64,
+	amount *big.Int,
+	sender string,
+	recipient string,
+	payload []byte,
+	memo *Memo,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*Transfer, error) {
+	tsf, err := NewTransfer(nonce, amount, sender, recipient, payload, gasLimit, gasPrice)
+	if err != nil {
+		return nil, err
+	}
+	tsf.memo = memo
+	return tsf, nil
+}
+
 // NewCoinBaseTransfer returns a coinbase Transfer
 func NewCoinBaseTransfer(nonce uint64, amount *big.Int, recipient string) *Transfer {
 	return &Transfer{
@@ -90,6 +130,9 @@ func (tsf *Transfer) Amount() *big.Int { return tsf.amount }
 // Payload returns the payload bytes
 func (tsf *Transfer) Payload() []byte { return tsf.payload }
 
+// Memo returns the typed memo, which is nil if the transfer doesn't have one
+func (tsf *Transfer) Memo() *Memo { return tsf.memo }
+
 // IsCoinbase returns a boolean value to indicate if a transfer is a coinbase one
 func (tsf *Transfer) IsCoinbase() bool { return tsf.isCoinbase }
 
@@ -115,7 +158,7 @@ func (tsf *Transfer) TotalSize() uint32 {
 		size += uint32(len(tsf.amount.Bytes()))
 	}
 
-	return size + uint32(len(tsf.payload))
+	return size + uint32(len(tsf.payload)) + tsf.memo.Size()
 }
 
 // ByteStream returns a raw byte stream of this Transfer
@@ -130,6 +173,7 @@ func (tsf *Transfer) Proto() *iproto.TransferPb {
 		Recipient:  tsf.dstAddr,
 		Payload:    tsf.payload,
 		IsCoinbase: tsf.isCoinbase,
+		Memo:       tsf.memo.Proto(),
 	}
 
 	if tsf.amount != nil {
@@ -152,6 +196,7 @@ func (tsf *Transfer) LoadProto(pbAct *iproto.TransferPb) error {
 	tsf.amount.SetBytes(pbAct.GetAmount())
 	tsf.payload = pbAct.Payload
 	tsf.isCoinbase = pbAct.IsCoinbase
+	tsf.memo = memoFromProto(pbAct.GetMemo())
 	return nil
 }
 
@@ -161,8 +206,13 @@ func (tsf *Transfer) IntrinsicGas() (uint64, error) {
 	if (math.MaxUint64-TransferBaseIntrinsicGas)/TransferPayloadGas < payloadSize {
 		return 0, ErrOutOfGas
 	}
+	gas := payloadSize*TransferPayloadGas + TransferBaseIntrinsicGas
+	memoSize := uint64(tsf.memo.Size())
+	if (math.MaxUint64-gas)/TransferMemoGas < memoSize {
+		return 0, ErrOutOfGas
+	}
 
-	return payloadSize*TransferPayloadGas + TransferBaseIntrinsicGas, nil
+	return gas + memoSize*TransferMemoGas, nil
 }
 
 // Cost returns the total cost of a transfer
@@ -53,3 +53,35 @@ func TestCoinbaseTsf(t *testing.T) {
 	require.NotNil(t, coinbaseTsf)
 	require.True(coinbaseTsf.isCoinbase)
 }
+
+func TestTransferMemo(t *testing.T) {
+	require := require.New(t)
+	sender, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainid)
+	require.NoError(err)
+	recipient, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainid)
+	require.NoError(err)
+
+	memo := &Memo{Type: TagMemo, Data: []byte("10086")}
+	tsf, err := NewTransferWithMemo(0, big.NewInt(10), sender.RawAddress, recipient.RawAddress, []byte{1, 2},
+		memo, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	gas, err := tsf.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(TransferBaseIntrinsicGas+2*TransferPayloadGas+5*TransferMemoGas, gas)
+	tag, ok := tsf.Memo().Tag()
+	require.True(ok)
+	require.Equal("10086", tag)
+
+	var tsf2 Transfer
+	require.NoError(tsf2.LoadProto(tsf.Proto()))
+	require.Equal(memo, tsf2.Memo())
+
+	// Transfer without memo
+	tsf, err = NewTransfer(0, big.NewInt(10), sender.RawAddress, recipient.RawAddress, nil, uint64(100000),
+		big.NewInt(10))
+	require.NoError(err)
+	require.NoError(tsf2.LoadProto(tsf.Proto()))
+	require.Nil(tsf2.Memo())
+	_, ok = tsf2.Memo().Tag()
+	require.False(ok)
+}
@@ -18,6 +18,24 @@ import (
 	"github.com/iotexproject/iotex-core/proto"
 )
 
+func init() {
+	mustRegister("vote", &codecFuncs{
+		newPayload: func() Payload { return &Vote{} },
+		oneof:      &iproto.ActionPb_Vote{},
+		encode: func(act Payload, pbAct *iproto.ActionPb) error {
+			pbAct.Action = &iproto.ActionPb_Vote{Vote: act.(*Vote).Proto()}
+			return nil
+		},
+		decode: func(pbAct *iproto.ActionPb) (Payload, string, error) {
+			act := &Vote{}
+			if err := act.LoadProto(pbAct.GetVote()); err != nil {
+				return nil, "", err
+			}
+			return act, pbAct.GetVote().VoteeAddress, nil
+		},
+	})
+}
+
 const (
 	// VoteIntrinsicGas represents the intrinsic gas for vote
 	VoteIntrinsicGas = uint64(10000)
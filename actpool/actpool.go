@@ -7,25 +7,74 @@
 package actpool
 
 import (
+	"container/heap"
 	"context"
+	"math/big"
 	"sync"
 
+	"github.com/facebookgo/clock"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/blocklimit"
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/routine"
 )
 
+var evictionMtc = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "iotex_actpool_eviction",
+		Help: "Actpool eviction counter.",
+	},
+	[]string{"reason"},
+)
+
+func init() {
+	prometheus.MustRegister(evictionMtc)
+}
+
+// gasPriceQueue is a priority queue of the pending actions of accounts, ordered by the gas price of the head action
+type gasPriceQueue [][]action.SealedEnvelope
+
+func (h gasPriceQueue) Len() int { return len(h) }
+func (h gasPriceQueue) Less(i, j int) bool {
+	if cmp := h[i][0].GasPrice().Cmp(h[j][0].GasPrice()); cmp != 0 {
+		return cmp > 0
+	}
+	// Break the tie by the sender address to make the picking order deterministic
+	return h[i][0].SrcAddr() < h[j][0].SrcAddr()
+}
+func (h gasPriceQueue) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *gasPriceQueue) Push(x interface{}) {
+	in, ok := x.([]action.SealedEnvelope)
+	if !ok {
+		return
+	}
+	*h = append(*h, in)
+}
+
+func (h *gasPriceQueue) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[0 : n-1]
+	return x
+}
+
 // ActPool is the interface of actpool
 type ActPool interface {
+	lifecycle.StartStopper
 	// Reset resets actpool state
 	Reset()
-	// PickActs returns all currently accepted actions in actpool
+	// PickActs returns the currently accepted actions in actpool in the order of gas price, up to the block gas limit
 	PickActs() []action.SealedEnvelope
 	// Add adds an action into the pool after passing validation
 	Add(act action.SealedEnvelope) error
@@ -39,8 +88,14 @@ type ActPool interface {
 	GetSize() uint64
 	// GetCapacity returns the act pool capacity
 	GetCapacity() uint64
+	// GetReplacementGasPrice returns the minimum gas price for an action to replace the queued one of the same nonce
+	GetReplacementGasPrice(addr string, nonce uint64) (*big.Int, error)
 	// AddActionValidators add validators
 	AddActionValidators(...protocol.ActionValidator)
+	// AddSubscriber makes the subscriber notified of the actions entering or leaving the pool
+	AddSubscriber(Subscriber) error
+	// RemoveSubscriber stops notifying the subscriber
+	RemoveSubscriber(Subscriber) error
 
 	AddActionEnvelopeValidators(...protocol.ActionEnvelopeValidator)
 }
@@ -54,10 +109,26 @@ type actPool struct {
 	allActions               map[hash.Hash32B]action.SealedEnvelope
 	actionEnvelopeValidators []protocol.ActionEnvelopeValidator
 	validators               []protocol.ActionValidator
+	localAccounts            map[string]bool
+	clk                      clock.Clock
+	journal                  *journal
+	compactTask              *routine.RecurringTask
+	subscribers              []Subscriber
+}
+
+// Option sets actpool construction parameter
+type Option func(ap *actPool) error
+
+// ClockOption overrides the default clock
+func ClockOption(clk clock.Clock) Option {
+	return func(ap *actPool) error {
+		ap.clk = clk
+		return nil
+	}
 }
 
 // NewActPool constructs a new actpool
-func NewActPool(bc blockchain.Blockchain, cfg config.ActPool) (ActPool, error) {
+func NewActPool(bc blockchain.Blockchain, cfg config.ActPool, opts ...Option) (ActPool, error) {
 	if bc == nil {
 		return nil, errors.New("Try to attach a nil blockchain")
 	}
@@ -66,10 +137,61 @@ func NewActPool(bc blockchain.Blockchain, cfg config.ActPool) (ActPool, error) {
 		bc:          bc,
 		accountActs: make(map[string]ActQueue),
 		allActions:  make(map[hash.Hash32B]action.SealedEnvelope),
+		clk:         clock.New(),
+	}
+	ap.localAccounts = make(map[string]bool, len(cfg.LocalAccounts))
+	for _, addr := range cfg.LocalAccounts {
+		ap.localAccounts[addr] = true
+	}
+	for _, opt := range opts {
+		if err := opt(ap); err != nil {
+			return nil, err
+		}
+	}
+	if cfg.JournalPath != "" {
+		ap.journal = newJournal(cfg.JournalPath)
+		if cfg.JournalCompactInterval > 0 {
+			ap.compactTask = routine.NewRecurringTask(ap.compactJournal, cfg.JournalCompactInterval)
+		}
 	}
 	return ap, nil
 }
 
+// Start restores the actions in the journal into actpool, skipping the ones confirmed in the meantime
+func (ap *actPool) Start(ctx context.Context) error {
+	if ap.journal == nil {
+		return nil
+	}
+	if err := ap.journal.load(ap.Add); err != nil {
+		return errors.Wrap(err, "failed to load actpool journal")
+	}
+	if err := ap.rotateJournal(); err != nil {
+		return err
+	}
+	if ap.compactTask != nil {
+		return ap.compactTask.Start(ctx)
+	}
+	return nil
+}
+
+// Stop compacts and closes the journal
+func (ap *actPool) Stop(ctx context.Context) error {
+	if ap.journal == nil {
+		return nil
+	}
+	if ap.compactTask != nil {
+		if err := ap.compactTask.Stop(ctx); err != nil {
+			return err
+		}
+	}
+	if err := ap.rotateJournal(); err != nil {
+		return err
+	}
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+	return ap.journal.close()
+}
+
 // AddActionValidators add validators
 func (ap *actPool) AddActionValidators(validators ...protocol.ActionValidator) {
 	ap.validators = append(ap.validators, validators...)
@@ -79,8 +201,32 @@ func (ap *actPool) AddActionEnvelopeValidators(fs ...protocol.ActionEnvelopeVali
 	ap.actionEnvelopeValidators = append(ap.actionEnvelopeValidators, fs...)
 }
 
+// AddSubscriber makes the subscriber notified of the actions entering or leaving the pool
+func (ap *actPool) AddSubscriber(s Subscriber) error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+	if s == nil {
+		return errors.New("subscriber could not be nil")
+	}
+	ap.subscribers = append(ap.subscribers, s)
+	return nil
+}
+
+// RemoveSubscriber stops notifying the subscriber
+func (ap *actPool) RemoveSubscriber(s Subscriber) error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+	for i, sub := range ap.subscribers {
+		if sub == s {
+			ap.subscribers = append(ap.subscribers[:i], ap.subscribers[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("cannot find subscription")
+}
+
 // Reset resets actpool state
-// Step I: remove all the actions in actpool that have already been committed to block
+// Step I: remove all the actions in actpool that have already been committed to block or expired
 // Step II: update pending balance of each account if it still exists in pool
 // Step III: update queue's status in each account and remove invalid actions following queue's update
 // Specifically, first reset the pending nonce based on confirmed nonce in order to prevent omitting reevaluation of
@@ -91,8 +237,9 @@ func (ap *actPool) Reset() {
 	ap.mutex.Lock()
 	defer ap.mutex.Unlock()
 
-	// Remove confirmed actions in actpool
+	// Remove confirmed and expired actions in actpool
 	ap.removeConfirmedActs()
+	ap.removeExpiredActs()
 	for from, queue := range ap.accountActs {
 		// Reset pending balance for each account
 		balance, err := ap.bc.Balance(from)
@@ -115,23 +262,81 @@ func (ap *actPool) Reset() {
 	}
 }
 
-// PickActs returns all currently accepted transfers and votes for all accounts
+// PickActs returns the pending actions of all accounts. The actions of local accounts are picked before the others.
+// Among the accounts, the action with the highest gas price is picked first, while the actions of the same account
+// are picked in the order of nonce. Actions paying less than the base fee of the next block are left in the pool. The
+// picking stops once the block gas limit or size limit is used up or the max number of actions to pick is reached.
 func (ap *actPool) PickActs() []action.SealedEnvelope {
 	ap.mutex.RLock()
 	defer ap.mutex.RUnlock()
 
-	numActs := uint64(0)
+	localHeads := make(gasPriceQueue, 0, len(ap.localAccounts))
+	heads := make(gasPriceQueue, 0, len(ap.accountActs))
+	for sender, queue := range ap.accountActs {
+		acts := queue.PendingActs()
+		if len(acts) == 0 {
+			continue
+		}
+		if ap.isLocal(sender) {
+			localHeads = append(localHeads, acts)
+			continue
+		}
+		heads = append(heads, acts)
+	}
+
+	limits, err := ap.bc.BlockLimits()
+	if err != nil {
+		log.L().Error("Error when getting block limits, using the genesis ones.", zap.Error(err))
+		limits = blocklimit.DefaultLimits()
+	}
+	baseFee, err := ap.bc.NextBaseFee()
+	if err != nil {
+		log.L().Error("Error when getting base fee, using zero.", zap.Error(err))
+		baseFee = big.NewInt(0)
+	}
 	actions := make([]action.SealedEnvelope, 0)
-	for _, queue := range ap.accountActs {
-		for _, act := range queue.PendingActs() {
-			actions = append(actions, act)
-			numActs++
-			if ap.cfg.MaxNumActsToPick > 0 && numActs >= ap.cfg.MaxNumActsToPick {
-				log.L().Debug("Reach the max number of actions to pick.",
-					zap.Uint64("limit", ap.cfg.MaxNumActsToPick))
-				return actions
-			}
+	actions = ap.pickActs(localHeads, &limits, baseFee, actions)
+	return ap.pickActs(heads, &limits, baseFee, actions)
+}
+
+// pickActs picks the actions from the heads of accounts in the order of gas price and appends them to the picked ones.
+// The remaining limits of the block are reduced by the picked actions. Actions paying less than the base fee are not
+// picked.
+func (ap *actPool) pickActs(
+	heads gasPriceQueue,
+	limits *blocklimit.Limits,
+	baseFee *big.Int,
+	actions []action.SealedEnvelope,
+) []action.SealedEnvelope {
+	heap.Init(&heads)
+	for heads.Len() > 0 {
+		if ap.cfg.MaxNumActsToPick > 0 && uint64(len(actions)) >= ap.cfg.MaxNumActsToPick {
+			log.L().Debug("Reach the max number of actions to pick.",
+				zap.Uint64("limit", ap.cfg.MaxNumActsToPick))
+			break
 		}
+		acts := heads[0]
+		act := acts[0]
+		size, err := blocklimit.Size(act)
+		if err != nil {
+			log.L().Error("Error when getting action size.", zap.Error(err))
+			heap.Pop(&heads)
+			continue
+		}
+		if act.GasLimit() > limits.GasLimit || size > limits.SizeLimit || act.GasPrice().Cmp(baseFee) < 0 {
+			// The subsequent actions of the account cannot be picked either without this one
+			heap.Pop(&heads)
+			continue
+		}
+		limits.GasLimit -= act.GasLimit()
+		limits.SizeLimit -= size
+		actions = append(actions, act)
+		if len(acts) == 1 {
+			heap.Pop(&heads)
+			continue
+		}
+		heads[0] = acts[1:]
+		heap.Fix(&heads, 0)
 	}
 	return actions
 }
@@ -139,9 +344,21 @@ func (ap *actPool) PickActs() []action.SealedEnvelope {
 func (ap *actPool) Add(act action.SealedEnvelope) error {
 	ap.mutex.Lock()
 	defer ap.mutex.Unlock()
-	// Reject action if pool space is full
-	if uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool {
-		return errors.Wrap(action.ErrActPool, "insufficient space for action")
+	// Reject action if pool space is full, unless it replaces a queued action or a lower priced one could be evicted.
+	// Actions of local accounts are always accepted. The action to evict is decided before the action is enqueued, so
+	// that a rejected action changes neither the pool nor the journal.
+	var (
+		evictee string
+		evict   bool
+	)
+	if ap.isFull() && !ap.overlaps(act) {
+		ap.removeExpiredActs()
+		if ap.isFull() {
+			evictee, evict = ap.evictionCandidate(act)
+			if !evict && !ap.isLocal(act.SrcAddr()) {
+				return errors.Wrap(action.ErrActPool, "insufficient space for action")
+			}
+		}
 	}
 	hash := act.Hash()
 	// Reject action if it already exists in pool
@@ -157,11 +374,24 @@ func (ap *actPool) Add(act action.SealedEnvelope) error {
 	}
 	// Reject action if it's invalid
 	for _, validator := range ap.validators {
-		if err := validator.Validate(context.Background(), act.Action()); err != nil {
+		if err := protocol.ValidateAction(context.Background(), validator, act.Action()); err != nil {
 			return errors.Wrapf(err, "reject invalid action: %x", hash)
 		}
 	}
-	return ap.enqueueAction(act.SrcAddr(), act, hash, act.Nonce())
+	if err := ap.enqueueAction(act.SrcAddr(), act, hash, act.Nonce()); err != nil {
+		return err
+	}
+	if ap.journal != nil {
+		if err := ap.journal.insert(act); err != nil {
+			log.L().Error("Failed to write action to journal.", log.Hex("hash", hash[:]), zap.Error(err))
+		}
+	}
+	if evict {
+		if err := ap.evictAction(evictee); err != nil {
+			log.L().Error("Error when evicting action.", zap.String("sender", evictee), zap.Error(err))
+		}
+	}
+	return nil
 }
 
 // GetPendingNonce returns pending nonce in pool or confirmed nonce given an account address
@@ -216,13 +446,34 @@ func (ap *actPool) GetCapacity() uint64 {
 	return ap.cfg.MaxNumActsPerPool
 }
 
+// GetReplacementGasPrice returns the minimum gas price for an action to replace the queued one of the same nonce
+func (ap *actPool) GetReplacementGasPrice(addr string, nonce uint64) (*big.Int, error) {
+	ap.mutex.RLock()
+	defer ap.mutex.RUnlock()
+
+	queue, ok := ap.accountActs[addr]
+	if !ok {
+		return nil, errors.Wrapf(action.ErrNonce, "no queued action of %s", addr)
+	}
+	queued, ok := queue.Get(nonce)
+	if !ok {
+		return nil, errors.Wrapf(action.ErrNonce, "no queued action of %s with nonce %d", addr, nonce)
+	}
+	return ap.replacementGasPrice(queued.GasPrice()), nil
+}
+
 //======================================
 // private functions
 //======================================
 func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, hash hash.Hash32B, actNonce uint64) error {
 	queue := ap.accountActs[sender]
 	if queue == nil {
-		queue = NewActQueue()
+		ttl := ap.cfg.ActionExpiry
+		if ap.isLocal(sender) {
+			// Actions of local accounts never expire
+			ttl = 0
+		}
+		queue = NewActQueue(WithTimeToLive(ttl), WithClock(ap.clk))
 		ap.accountActs[sender] = queue
 		confirmedNonce, err := ap.bc.Nonce(sender)
 		if err != nil {
@@ -240,11 +491,11 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, hash
 		queue.SetPendingBalance(balance)
 	}
 	if queue.Overlaps(act) {
-		// Nonce already exists
-		return errors.Wrapf(action.ErrNonce, "duplicate nonce for action %x", hash)
+		// Nonce already exists, try to replace the queued action by fee
+		return ap.replaceAction(sender, queue, act, hash)
 	}
 
-	if actNonce-queue.StartNonce() >= ap.cfg.MaxNumActsPerAcct {
+	if actNonce-queue.StartNonce() >= ap.cfg.MaxNumActsPerAcct && !ap.isLocal(sender) {
 		// Nonce exceeds current range
 		log.L().Debug("Rejecting action because nonce is too large.",
 			log.Hex("hash", hash[:]),
@@ -266,6 +517,7 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, hash
 		return errors.Wrapf(err, "cannot put action %x into ActQueue", hash)
 	}
 	ap.allActions[hash] = act
+	ap.emit(ActionAdded, act)
 	// If the pending nonce equals this nonce, update queue
 	nonce := queue.PendingNonce()
 	if actNonce == nonce {
@@ -274,6 +526,195 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, hash
 	return nil
 }
 
+// replaceAction replaces the queued action of the same nonce if the new action bumps the gas price enough
+func (ap *actPool) replaceAction(sender string, queue ActQueue, act action.SealedEnvelope, hash hash.Hash32B) error {
+	queued, _ := queue.Get(act.Nonce())
+	minGasPrice := ap.replacementGasPrice(queued.GasPrice())
+	if act.GasPrice().Cmp(minGasPrice) < 0 {
+		return errors.Wrapf(
+			action.ErrNonce,
+			"duplicate nonce for action %x, gas price %d is lower than %d to replace the queued one",
+			hash,
+			act.GasPrice(),
+			minGasPrice,
+		)
+	}
+	cost, err := act.Cost()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get cost of action %x", hash)
+	}
+	// The cost of the queued action is refunded if it has been deducted from the pending balance
+	balance := new(big.Int).Set(queue.PendingBalance())
+	isPending := act.Nonce() < queue.PendingNonce()
+	if isPending {
+		queuedCost, err := queued.Cost()
+		if err != nil {
+			return errors.Wrapf(err, "failed to get cost of action %x", queued.Hash())
+		}
+		balance.Add(balance, queuedCost)
+	}
+	if balance.Cmp(cost) < 0 {
+		return errors.Wrapf(action.ErrBalance, "insufficient balance for action %x", hash)
+	}
+
+	if err := queue.Replace(act); err != nil {
+		return errors.Wrapf(err, "cannot replace action %x in ActQueue", hash)
+	}
+	queuedHash := queued.Hash()
+	delete(ap.allActions, queuedHash)
+	ap.allActions[hash] = act
+	ap.emit(ActionReplaced, queued)
+	ap.emit(ActionAdded, act)
+	log.L().Debug("Replaced queued action.",
+		log.Hex("hash", hash[:]),
+		log.Hex("replaced", queuedHash[:]),
+		zap.Uint64("nonce", act.Nonce()))
+	if !isPending {
+		return nil
+	}
+	// Re-evaluate the pending nonce and balance, as the cost of a pending action changes
+	return ap.reevaluateAccount(sender)
+}
+
+// reevaluateAccount recalculates the pending nonce and balance of the account from the confirmed state
+func (ap *actPool) reevaluateAccount(sender string) error {
+	queue := ap.accountActs[sender]
+	confirmedBalance, err := ap.bc.Balance(sender)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get balance of %s", sender)
+	}
+	queue.SetPendingBalance(confirmedBalance)
+	queue.SetPendingNonce(queue.StartNonce())
+	ap.updateAccount(sender)
+	return nil
+}
+
+// rotateJournal regenerates the journal with the actions currently in pool, dropping the confirmed, replaced, expired
+// and evicted ones
+func (ap *actPool) rotateJournal() error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	acts := make([]action.SealedEnvelope, 0, len(ap.allActions))
+	for _, queue := range ap.accountActs {
+		acts = append(acts, queue.AllActs()...)
+	}
+	if err := ap.journal.rotate(acts); err != nil {
+		return errors.Wrap(err, "failed to rotate actpool journal")
+	}
+	return nil
+}
+
+// compactJournal is the recurring task to compact the journal
+func (ap *actPool) compactJournal() {
+	if err := ap.rotateJournal(); err != nil {
+		log.L().Error("Error when compacting actpool journal.", zap.Error(err))
+	}
+}
+
+// isLocal returns whether the account is a local one, whose actions are prioritized
+func (ap *actPool) isLocal(addr string) bool {
+	return ap.localAccounts[addr]
+}
+
+// isFull returns whether the pool space is used up
+func (ap *actPool) isFull() bool {
+	return uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool
+}
+
+// removeExpiredActs removes the actions which have stayed in pool for longer than the expiry
+func (ap *actPool) removeExpiredActs() {
+	for sender, queue := range ap.accountActs {
+		pendingNonce := queue.PendingNonce()
+		expiredActs := queue.CleanTimeout()
+		if len(expiredActs) == 0 {
+			continue
+		}
+		isPending := false
+		for _, act := range expiredActs {
+			hash := act.Hash()
+			log.L().Debug("Removed expired action.", log.Hex("hash", hash[:]))
+			delete(ap.allActions, hash)
+			ap.emit(ActionEvicted, act)
+			evictionMtc.WithLabelValues("expired").Inc()
+			if act.Nonce() < pendingNonce {
+				isPending = true
+			}
+		}
+		if !isPending {
+			if queue.Empty() {
+				delete(ap.accountActs, sender)
+			}
+			continue
+		}
+		if err := ap.reevaluateAccount(sender); err != nil {
+			log.L().Error("Error when removing expired actions.", zap.Error(err))
+		}
+	}
+}
+
+// evictionCandidate returns the sender of the action to evict to make room for the given action, which is the lowest
+// priced action at the tail of a non-local account queue holding more than the guaranteed number of actions. Actions
+// of local accounts could evict regardless of the gas price.
+func (ap *actPool) evictionCandidate(act action.SealedEnvelope) (string, bool) {
+	var (
+		candidate string
+		gasPrice  *big.Int
+	)
+	isLocal := ap.isLocal(act.SrcAddr())
+	for sender, queue := range ap.accountActs {
+		// Never evict the actions of the same sender, which would leave a nonce gap in its queue
+		if sender == act.SrcAddr() || ap.isLocal(sender) || uint64(queue.Len()) <= ap.cfg.MinNumActsPerAcct {
+			continue
+		}
+		tail, ok := queue.Tail()
+		if !ok || (!isLocal && tail.GasPrice().Cmp(act.GasPrice()) >= 0) {
+			continue
+		}
+		if gasPrice == nil || tail.GasPrice().Cmp(gasPrice) < 0 {
+			candidate = sender
+			gasPrice = tail.GasPrice()
+		}
+	}
+	return candidate, gasPrice != nil
+}
+
+// evictAction evicts the tail action of the eviction candidate returned by evictionCandidate
+func (ap *actPool) evictAction(sender string) error {
+	queue := ap.accountActs[sender]
+	evicted, _ := queue.PopTail()
+	hash := evicted.Hash()
+	log.L().Debug("Evicted underpriced action.", log.Hex("hash", hash[:]))
+	delete(ap.allActions, hash)
+	ap.emit(ActionEvicted, evicted)
+	evictionMtc.WithLabelValues("underpriced").Inc()
+	if evicted.Nonce() < queue.PendingNonce() {
+		return ap.reevaluateAccount(sender)
+	}
+	if queue.Empty() {
+		delete(ap.accountActs, sender)
+	}
+	return nil
+}
+
+// replacementGasPrice returns the minimum gas price to replace an action of the given gas price
+func (ap *actPool) replacementGasPrice(gasPrice *big.Int) *big.Int {
+	minGasPrice := new(big.Int).Mul(gasPrice, big.NewInt(int64(100+ap.cfg.PriceBumpPercent)))
+	minGasPrice.Add(minGasPrice, big.NewInt(99))
+	minGasPrice.Div(minGasPrice, big.NewInt(100))
+	// The gas price has to be strictly higher anyway
+	if minGasPrice.Cmp(gasPrice) <= 0 {
+		minGasPrice.Add(gasPrice, big.NewInt(1))
+	}
+	return minGasPrice
+}
+
+// overlaps returns whether the action has the same nonce as a queued action of the same sender
+func (ap *actPool) overlaps(act action.SealedEnvelope) bool {
+	queue, ok := ap.accountActs[act.SrcAddr()]
+	return ok && queue.Overlaps(act)
+}
+
 // removeConfirmedActs removes processed (committed to block) actions from pool
 func (ap *actPool) removeConfirmedActs() {
 	for from, queue := range ap.accountActs {
@@ -284,8 +725,12 @@ func (ap *actPool) removeConfirmedActs() {
 		}
 		pendingNonce := confirmedNonce + 1
 		// Remove all actions that are committed to new block
-		acts := queue.FilterNonce(pendingNonce)
-		ap.removeInvalidActs(acts)
+		for _, act := range queue.FilterNonce(pendingNonce) {
+			hash := act.Hash()
+			log.L().Debug("Removed confirmed action.", log.Hex("hash", hash[:]))
+			delete(ap.allActions, hash)
+			ap.emit(ActionConfirmed, act)
+		}
 
 		// Delete the queue entry if it becomes empty
 		if queue.Empty() {
@@ -299,6 +744,7 @@ func (ap *actPool) removeInvalidActs(acts []action.SealedEnvelope) {
 		hash := act.Hash()
 		log.L().Debug("Removed invalidated action.", log.Hex("hash", hash[:]))
 		delete(ap.allActions, hash)
+		ap.emit(ActionEvicted, act)
 	}
 }
 
@@ -9,8 +9,12 @@ package actpool
 import (
 	"context"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/facebookgo/clock"
 	"github.com/golang/mock/gomock"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -58,10 +62,11 @@ var (
 func TestActPool_validateGenericAction(t *testing.T) {
 	require := require.New(t)
 
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	bc.GetFactory().AddActionHandlers(account.NewProtocol())
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	apConfig := getActPoolCfg()
 	Ap, err := NewActPool(bc, apConfig)
@@ -125,9 +130,10 @@ func TestActPool_AddActs(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(10))
 	require.NoError(err)
@@ -304,12 +310,80 @@ func TestActPool_AddActs(t *testing.T) {
 	require.Equal(action.ErrInsufficientBalanceForGas, errors.Cause(err))
 }
 
+func TestActPool_ReplaceActs(t *testing.T) {
+	require := require.New(t)
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
+	require.NoError(bc.Start(context.Background()))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(1000000))
+	require.NoError(err)
+	// Create actpool
+	apConfig := getActPoolCfg()
+	apConfig.PriceBumpPercent = 10
+	Ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
+	ap.AddActionValidators(account.NewProtocol())
+
+	tsf1, err := testutil.SignedTransfer(addr1, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, addr2, uint64(2), big.NewInt(20),
+		[]byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(tsf2))
+	pBalance, _ := ap.getPendingBalance(addr1.RawAddress)
+	require.Equal(uint64(799970), pBalance.Uint64())
+
+	gasPrice, err := ap.GetReplacementGasPrice(addr1.RawAddress, uint64(1))
+	require.NoError(err)
+	require.Equal(uint64(11), gasPrice.Uint64())
+	_, err = ap.GetReplacementGasPrice(addr1.RawAddress, uint64(3))
+	require.Equal(action.ErrNonce, errors.Cause(err))
+	_, err = ap.GetReplacementGasPrice(addr2.RawAddress, uint64(1))
+	require.Equal(action.ErrNonce, errors.Cause(err))
+
+	// Case I: Gas price is not bumped enough
+	lowTsf, err := testutil.SignedTransfer(addr1, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	err = ap.Add(lowTsf)
+	require.Equal(action.ErrNonce, errors.Cause(err))
+	// Case II: Insufficient balance for the replacement
+	overBalTsf, err := testutil.SignedTransfer(addr1, addr2, uint64(2), big.NewInt(900000),
+		[]byte{}, uint64(10000), big.NewInt(11))
+	require.NoError(err)
+	err = ap.Add(overBalTsf)
+	require.Equal(action.ErrBalance, errors.Cause(err))
+	// Case III: Replace the pending action
+	replaceTsf, err := testutil.SignedTransfer(addr1, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(11))
+	require.NoError(err)
+	require.NoError(ap.Add(replaceTsf))
+	require.Equal(uint64(2), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf1.Hash())
+	require.Error(err)
+	act, err := ap.GetActionByHash(replaceTsf.Hash())
+	require.NoError(err)
+	require.Equal(replaceTsf, act)
+	pBalance, _ = ap.getPendingBalance(addr1.RawAddress)
+	require.Equal(uint64(789970), pBalance.Uint64())
+	pNonce, _ := ap.getPendingNonce(addr1.RawAddress)
+	require.Equal(uint64(3), pNonce)
+	acts := ap.PickActs()
+	require.Equal([]action.SealedEnvelope{replaceTsf, tsf2}, acts)
+}
+
 func TestActPool_PickActs(t *testing.T) {
 	createActPool := func(cfg config.ActPool) (*actPool, []action.SealedEnvelope, []action.SealedEnvelope, []action.SealedEnvelope) {
 		require := require.New(t)
-		bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+		bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+		require.NoError(err)
 		require.NoError(bc.Start(context.Background()))
-		_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+		_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 		require.NoError(err)
 		_, err = bc.CreateState(addr2.RawAddress, big.NewInt(10))
 		require.NoError(err)
@@ -395,12 +469,444 @@ func TestActPool_PickActs(t *testing.T) {
 	})
 }
 
+func TestActPool_PickActsByGasPrice(t *testing.T) {
+	require := require.New(t)
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
+	require.NoError(bc.Start(context.Background()))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(1000000000))
+	require.NoError(err)
+	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(1000000000))
+	require.NoError(err)
+	// Create actpool
+	apConfig := getActPoolCfg()
+	Ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
+	ap.AddActionValidators(account.NewProtocol())
+
+	tsf1, err := testutil.SignedTransfer(addr1, addr1, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, addr1, uint64(2), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(3))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr2, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(2))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(addr2, addr2, uint64(2), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(tsf2))
+	require.NoError(ap.Add(tsf3))
+	require.NoError(ap.Add(tsf4))
+	// The head of addr2 pays more, while the actions of addr1 stay in the order of nonce
+	require.Equal([]action.SealedEnvelope{tsf3, tsf1, tsf2, tsf4}, ap.PickActs())
+
+	// Actions beyond the block gas limit are not picked
+	bigTsfs := make([]action.SealedEnvelope, 0)
+	for i := uint64(3); i < 13; i++ {
+		tsf, err := testutil.SignedTransfer(addr1, addr1, i, big.NewInt(10),
+			[]byte{}, genesis.ActionGasLimit, big.NewInt(1))
+		require.NoError(err)
+		require.NoError(ap.Add(tsf))
+		bigTsfs = append(bigTsfs, tsf)
+	}
+	pickedActs := ap.PickActs()
+	require.Equal(13, len(pickedActs))
+	require.Equal([]action.SealedEnvelope{tsf3, tsf1, tsf2}, pickedActs[:3])
+	require.Equal(bigTsfs[:9], pickedActs[3:12])
+	require.Equal(tsf4, pickedActs[12])
+}
+
+func TestActPool_ExpireActs(t *testing.T) {
+	require := require.New(t)
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
+	require.NoError(bc.Start(context.Background()))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	require.NoError(err)
+	// Create actpool
+	apConfig := getActPoolCfg()
+	apConfig.ActionExpiry = time.Minute
+	c := clock.NewMock()
+	Ap, err := NewActPool(bc, apConfig, ClockOption(c))
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
+	ap.AddActionValidators(account.NewProtocol())
+
+	tsf1, err := testutil.SignedTransfer(addr1, addr1, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, addr1, uint64(2), big.NewInt(20),
+		[]byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, addr1, uint64(3), big.NewInt(30),
+		[]byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	c.Add(30 * time.Second)
+	require.NoError(ap.Add(tsf2))
+	require.NoError(ap.Add(tsf3))
+	pBalance, _ := ap.getPendingBalance(addr1.RawAddress)
+	require.Equal(uint64(40), pBalance.Uint64())
+
+	// tsf1 expires, leaving tsf2 and tsf3 not pending any more
+	c.Add(30 * time.Second)
+	ap.Reset()
+	require.Equal(uint64(2), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf1.Hash())
+	require.Error(err)
+	pNonce, _ := ap.getPendingNonce(addr1.RawAddress)
+	require.Equal(uint64(1), pNonce)
+	pBalance, _ = ap.getPendingBalance(addr1.RawAddress)
+	require.Equal(uint64(100), pBalance.Uint64())
+	require.Empty(ap.PickActs())
+
+	// The rest expire as well
+	c.Add(30 * time.Second)
+	ap.Reset()
+	require.Zero(ap.GetSize())
+	require.Empty(ap.accountActs)
+}
+
+func TestActPool_EvictActs(t *testing.T) {
+	require := require.New(t)
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
+	require.NoError(bc.Start(context.Background()))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(10000000))
+	require.NoError(err)
+	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(10000000))
+	require.NoError(err)
+	// Create actpool
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 3
+	apConfig.MinNumActsPerAcct = 1
+	Ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
+	ap.AddActionValidators(account.NewProtocol())
+
+	tsf1, err := testutil.SignedTransfer(addr1, addr1, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, addr1, uint64(2), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(3))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, addr1, uint64(3), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(2))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(tsf2))
+	require.NoError(ap.Add(tsf3))
+
+	// Case I: The pool is full and the action doesn't pay more than the tail of other accounts
+	tsf4, err := testutil.SignedTransfer(addr2, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(2))
+	require.NoError(err)
+	err = ap.Add(tsf4)
+	require.Equal(action.ErrActPool, errors.Cause(err))
+	// Case II: The action of the same sender never evicts its own ones
+	tsf5, err := testutil.SignedTransfer(addr1, addr1, uint64(4), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	err = ap.Add(tsf5)
+	require.Equal(action.ErrActPool, errors.Cause(err))
+	// Case III: The action which fails to be enqueued evicts nothing
+	tsf6, err := testutil.SignedTransfer(addr2, addr2, uint64(1), big.NewInt(100000000),
+		[]byte{}, uint64(10000), big.NewInt(3))
+	require.NoError(err)
+	err = ap.Add(tsf6)
+	require.Equal(action.ErrBalance, errors.Cause(err))
+	require.Equal(uint64(3), ap.GetSize())
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2, tsf3}, ap.GetUnconfirmedActs(addr1.RawAddress))
+	// Case IV: The tail of the account is evicted by a higher priced action
+	tsf6, err = testutil.SignedTransfer(addr2, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(3))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf6))
+	require.Equal(uint64(3), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf3.Hash())
+	require.Error(err)
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2}, ap.GetUnconfirmedActs(addr1.RawAddress))
+	pNonce, _ := ap.getPendingNonce(addr1.RawAddress)
+	require.Equal(uint64(3), pNonce)
+	pBalance, _ := ap.getPendingBalance(addr1.RawAddress)
+	require.Equal(uint64(10000000-40020), pBalance.Uint64())
+	// Case V: The guaranteed number of actions of an account are not evicted
+	tsf7, err := testutil.SignedTransfer(addr2, addr2, uint64(2), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf7))
+	require.Equal([]action.SealedEnvelope{tsf1}, ap.GetUnconfirmedActs(addr1.RawAddress))
+	tsf8, err := testutil.SignedTransfer(addr2, addr2, uint64(3), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	err = ap.Add(tsf8)
+	require.Equal(action.ErrActPool, errors.Cause(err))
+}
+
+func TestActPool_Journal(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(os.TempDir(), "actpool.journal")
+	testutil.CleanupPath(t, path)
+	defer testutil.CleanupPath(t, path)
+
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
+	bc.GetFactory().AddActionHandlers(account.NewProtocol(), vote.NewProtocol(bc))
+	require.NoError(bc.Start(context.Background()))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	require.NoError(err)
+	apConfig := getActPoolCfg()
+	apConfig.JournalPath = path
+	newActPool := func() *actPool {
+		Ap, err := NewActPool(bc, apConfig)
+		require.NoError(err)
+		ap, ok := Ap.(*actPool)
+		require.True(ok)
+		ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
+		ap.AddActionValidators(account.NewProtocol(), vote.NewProtocol(bc))
+		return ap
+	}
+
+	tsf1, err := testutil.SignedTransfer(addr1, addr1, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, addr1, uint64(2), big.NewInt(20),
+		[]byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	vote3, err := testutil.SignedVote(addr1, addr1, uint64(3), uint64(100000), big.NewInt(0))
+	require.NoError(err)
+
+	ap := newActPool()
+	require.NoError(ap.Start(context.Background()))
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(tsf2))
+	require.NoError(ap.Add(vote3))
+	require.NoError(ap.Stop(context.Background()))
+
+	// Restore the actions on restart
+	ap = newActPool()
+	require.NoError(ap.Start(context.Background()))
+	require.Equal(uint64(3), ap.GetSize())
+	pickedActs := ap.PickActs()
+	require.Equal(3, len(pickedActs))
+	for i, act := range []action.SealedEnvelope{tsf1, tsf2, vote3} {
+		require.Equal(act.Hash(), pickedActs[i].Hash())
+	}
+	require.NoError(ap.Stop(context.Background()))
+
+	// Confirm tsf1 while the actpool is down
+	ws, err := bc.GetFactory().NewWorkingSet()
+	require.NoError(err)
+	gasLimit := testutil.TestGasLimit
+	ctx := protocol.WithRunActionsCtx(context.Background(),
+		protocol.RunActionsCtx{
+			ProducerAddr:    testaddress.IotxAddrinfo["producer"].RawAddress,
+			GasLimit:        &gasLimit,
+			EnableGasCharge: testutil.EnableGasCharge,
+		})
+	_, _, err = ws.RunActions(ctx, 0, []action.SealedEnvelope{tsf1})
+	require.NoError(err)
+	require.NoError(bc.GetFactory().Commit(ws))
+
+	ap = newActPool()
+	require.NoError(ap.Start(context.Background()))
+	require.Equal(uint64(2), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf1.Hash())
+	require.Error(err)
+	pickedActs = ap.PickActs()
+	require.Equal(2, len(pickedActs))
+	for i, act := range []action.SealedEnvelope{tsf2, vote3} {
+		require.Equal(act.Hash(), pickedActs[i].Hash())
+	}
+	require.NoError(ap.Stop(context.Background()))
+}
+
+func TestActPool_LocalAccounts(t *testing.T) {
+	require := require.New(t)
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
+	require.NoError(bc.Start(context.Background()))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(1000000))
+	require.NoError(err)
+	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(1000000))
+	require.NoError(err)
+	// Create actpool
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 3
+	apConfig.MaxNumActsPerAcct = 2
+	apConfig.ActionExpiry = time.Minute
+	apConfig.LocalAccounts = []string{addr1.RawAddress}
+	c := clock.NewMock()
+	Ap, err := NewActPool(bc, apConfig, ClockOption(c))
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
+	ap.AddActionValidators(account.NewProtocol())
+
+	tsf1, err := testutil.SignedTransfer(addr2, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, addr2, uint64(2), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(tsf2))
+
+	// Local actions bypass the limit of actions per account and evict the others regardless of gas price
+	localActs := make([]action.SealedEnvelope, 0)
+	for i := uint64(1); i <= 3; i++ {
+		tsf, err := testutil.SignedTransfer(addr1, addr1, i, big.NewInt(10),
+			[]byte{}, uint64(10000), big.NewInt(1))
+		require.NoError(err)
+		require.NoError(ap.Add(tsf))
+		localActs = append(localActs, tsf)
+	}
+	require.Equal(uint64(3), ap.GetSize())
+	require.Empty(ap.GetUnconfirmedActs(addr2.RawAddress))
+	// Local actions are never evicted, and are allowed to exceed the pool space
+	tsf3, err := testutil.SignedTransfer(addr2, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(10))
+	require.NoError(err)
+	err = ap.Add(tsf3)
+	require.Equal(action.ErrActPool, errors.Cause(err))
+	tsf4, err := testutil.SignedTransfer(addr1, addr1, uint64(4), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf4))
+	localActs = append(localActs, tsf4)
+	require.Equal(uint64(4), ap.GetSize())
+	// Local actions never expire
+	c.Add(time.Hour)
+	ap.Reset()
+	require.Equal(localActs, ap.GetUnconfirmedActs(addr1.RawAddress))
+	// Local actions still go through the validators
+	tsf5, err := testutil.SignedTransfer(addr1, addr1, uint64(5), big.NewInt(10),
+		[]byte{}, genesis.ActionGasLimit+1, big.NewInt(1))
+	require.NoError(err)
+	err = ap.Add(tsf5)
+	require.Equal(action.ErrGasHigherThanLimit, errors.Cause(err))
+
+	// Local actions are picked first
+	apConfig.MaxNumActsPerPool = maxNumActsPerPool
+	Ap, err = NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok = Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
+	ap.AddActionValidators(account.NewProtocol())
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(localActs[0]))
+	require.Equal([]action.SealedEnvelope{localActs[0], tsf1}, ap.PickActs())
+}
+
+type eventRecorder struct {
+	events []Event
+}
+
+func (r *eventRecorder) HandleActPoolEvent(evt Event) error {
+	r.events = append(r.events, evt)
+	return nil
+}
+
+func TestActPool_Subscriber(t *testing.T) {
+	require := require.New(t)
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
+	bc.GetFactory().AddActionHandlers(account.NewProtocol())
+	require.NoError(bc.Start(context.Background()))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(1000000))
+	require.NoError(err)
+	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(1000000))
+	require.NoError(err)
+	// Create actpool
+	apConfig := getActPoolCfg()
+	apConfig.PriceBumpPercent = 10
+	apConfig.MaxNumActsPerPool = 3
+	Ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc))
+	ap.AddActionValidators(account.NewProtocol())
+	recorder := &eventRecorder{}
+	require.Error(ap.AddSubscriber(nil))
+	require.NoError(ap.AddSubscriber(recorder))
+
+	tsf1, err := testutil.SignedTransfer(addr1, addr1, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, addr1, uint64(2), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	replaceTsf2, err := testutil.SignedTransfer(addr1, addr1, uint64(2), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(2))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, addr1, uint64(3), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(1))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(addr2, addr2, uint64(1), big.NewInt(10),
+		[]byte{}, uint64(10000), big.NewInt(5))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(tsf2))
+	require.NoError(ap.Add(replaceTsf2))
+	require.Equal([]Event{
+		{Type: ActionAdded, Action: tsf1},
+		{Type: ActionAdded, Action: tsf2},
+		{Type: ActionReplaced, Action: tsf2},
+		{Type: ActionAdded, Action: replaceTsf2},
+	}, recorder.events)
+
+	// tsf3 is evicted by the higher priced tsf4 when the pool is full
+	recorder.events = nil
+	require.NoError(ap.Add(tsf3))
+	require.NoError(ap.Add(tsf4))
+	require.Equal([]Event{
+		{Type: ActionAdded, Action: tsf3},
+		{Type: ActionAdded, Action: tsf4},
+		{Type: ActionEvicted, Action: tsf3},
+	}, recorder.events)
+
+	// tsf1 is confirmed
+	recorder.events = nil
+	ws, err := bc.GetFactory().NewWorkingSet()
+	require.NoError(err)
+	gasLimit := testutil.TestGasLimit
+	ctx := protocol.WithRunActionsCtx(context.Background(),
+		protocol.RunActionsCtx{
+			ProducerAddr:    testaddress.IotxAddrinfo["producer"].RawAddress,
+			GasLimit:        &gasLimit,
+			EnableGasCharge: testutil.EnableGasCharge,
+		})
+	_, _, err = ws.RunActions(ctx, 0, []action.SealedEnvelope{tsf1})
+	require.NoError(err)
+	require.NoError(bc.GetFactory().Commit(ws))
+	ap.Reset()
+	require.Equal([]Event{{Type: ActionConfirmed, Action: tsf1}}, recorder.events)
+
+	require.NoError(ap.RemoveSubscriber(recorder))
+	require.Error(ap.RemoveSubscriber(recorder))
+}
+
 func TestActPool_removeConfirmedActs(t *testing.T) {
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	bc.GetFactory().AddActionHandlers(account.NewProtocol(), vote.NewProtocol(bc))
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	// Create actpool
 	apConfig := getActPoolCfg()
@@ -456,10 +962,11 @@ func TestActPool_removeConfirmedActs(t *testing.T) {
 func TestActPool_Reset(t *testing.T) {
 	require := require.New(t)
 
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	bc.GetFactory().AddActionHandlers(account.NewProtocol(), vote.NewProtocol(bc))
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(200))
 	require.NoError(err)
@@ -863,9 +1370,10 @@ func TestActPool_Reset(t *testing.T) {
 
 func TestActPool_removeInvalidActs(t *testing.T) {
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	// Create actpool
 	apConfig := getActPoolCfg()
@@ -909,9 +1417,10 @@ func TestActPool_removeInvalidActs(t *testing.T) {
 
 func TestActPool_GetPendingNonce(t *testing.T) {
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(100))
 	require.NoError(err)
@@ -951,9 +1460,10 @@ func TestActPool_GetPendingNonce(t *testing.T) {
 
 func TestActPool_GetUnconfirmedActs(t *testing.T) {
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(100))
 	require.NoError(err)
@@ -991,9 +1501,10 @@ func TestActPool_GetUnconfirmedActs(t *testing.T) {
 
 func TestActPool_GetActionByHash(t *testing.T) {
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	_, err = bc.CreateState(addr2.RawAddress, big.NewInt(100))
 	require.NoError(err)
@@ -1028,7 +1539,8 @@ func TestActPool_GetActionByHash(t *testing.T) {
 
 func TestActPool_GetCapacity(t *testing.T) {
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	// Create actpool
 	apConfig := getActPoolCfg()
 	Ap, err := NewActPool(bc, apConfig)
@@ -1040,10 +1552,11 @@ func TestActPool_GetCapacity(t *testing.T) {
 
 func TestActPool_GetSize(t *testing.T) {
 	require := require.New(t)
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	bc.GetFactory().AddActionHandlers(account.NewProtocol(), vote.NewProtocol(bc))
 	require.NoError(bc.Start(context.Background()))
-	_, err := bc.CreateState(addr1.RawAddress, big.NewInt(100))
+	_, err = bc.CreateState(addr1.RawAddress, big.NewInt(100))
 	require.NoError(err)
 	// Create actpool
 	apConfig := getActPoolCfg()
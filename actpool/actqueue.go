@@ -10,7 +10,9 @@ import (
 	"container/heap"
 	"math/big"
 	"sort"
+	"time"
 
+	"github.com/facebookgo/clock"
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -42,6 +44,11 @@ func (h *noncePriorityQueue) Pop() interface{} {
 type ActQueue interface {
 	Overlaps(action.SealedEnvelope) bool
 	Put(action.SealedEnvelope) error
+	Get(uint64) (action.SealedEnvelope, bool)
+	Replace(action.SealedEnvelope) error
+	Tail() (action.SealedEnvelope, bool)
+	PopTail() (action.SealedEnvelope, bool)
+	CleanTimeout() []action.SealedEnvelope
 	FilterNonce(uint64) []action.SealedEnvelope
 	SetStartNonce(uint64)
 	StartNonce() uint64
@@ -68,17 +75,45 @@ type actQueue struct {
 	pendingNonce uint64
 	// Current pending balance for the account
 	pendingBalance *big.Int
+	// Map that stores the time when each action expires, associated with nonces
+	deadlines map[uint64]time.Time
+	// Time to live of an action in the queue. 0 means actions never expire
+	ttl time.Duration
+	clk clock.Clock
+}
+
+// ActQueueOption is the option to create an action queue
+type ActQueueOption func(*actQueue)
+
+// WithTimeToLive sets how long an action can stay in the queue
+func WithTimeToLive(ttl time.Duration) ActQueueOption {
+	return func(q *actQueue) {
+		q.ttl = ttl
+	}
+}
+
+// WithClock sets the clock to tell the expiry of actions
+func WithClock(clk clock.Clock) ActQueueOption {
+	return func(q *actQueue) {
+		q.clk = clk
+	}
 }
 
 // NewActQueue create a new action queue
-func NewActQueue() ActQueue {
-	return &actQueue{
+func NewActQueue(opts ...ActQueueOption) ActQueue {
+	q := &actQueue{
 		items:          make(map[uint64]action.SealedEnvelope),
 		index:          noncePriorityQueue{},
 		startNonce:     uint64(1), // Taking coinbase Action into account, startNonce should start with 1
 		pendingNonce:   uint64(1), // Taking coinbase Action into account, pendingNonce should start with 1
 		pendingBalance: big.NewInt(0),
+		deadlines:      make(map[uint64]time.Time),
+		clk:            clock.New(),
+	}
+	for _, opt := range opts {
+		opt(q)
 	}
+	return q
 }
 
 // Overlap returns whether the current queue contains the given nonce
@@ -95,9 +130,69 @@ func (q *actQueue) Put(act action.SealedEnvelope) error {
 	}
 	heap.Push(&q.index, nonce)
 	q.items[nonce] = act
+	q.setDeadline(nonce)
 	return nil
 }
 
+// Get returns the action of the given nonce in the queue
+func (q *actQueue) Get(nonce uint64) (action.SealedEnvelope, bool) {
+	act, exist := q.items[nonce]
+	return act, exist
+}
+
+// Replace replaces the action of the same nonce in the queue with the given one
+func (q *actQueue) Replace(act action.SealedEnvelope) error {
+	nonce := act.Nonce()
+	if _, exist := q.items[nonce]; !exist {
+		return errors.Wrapf(action.ErrNonce, "nonce %d to replace doesn't exist", nonce)
+	}
+	q.items[nonce] = act
+	q.setDeadline(nonce)
+	return nil
+}
+
+// Tail returns the action of the highest nonce in the queue
+func (q *actQueue) Tail() (action.SealedEnvelope, bool) {
+	if q.Len() == 0 {
+		return action.SealedEnvelope{}, false
+	}
+	sort.Sort(q.index)
+	return q.items[q.index[q.index.Len()-1]], true
+}
+
+// PopTail removes the action of the highest nonce from the queue and returns it
+func (q *actQueue) PopTail() (action.SealedEnvelope, bool) {
+	if q.Len() == 0 {
+		return action.SealedEnvelope{}, false
+	}
+	// A sorted slice is still a valid heap after removing its last element
+	sort.Sort(q.index)
+	removed := q.removeActs(q.index.Len() - 1)
+	return removed[0], true
+}
+
+// CleanTimeout removes all the expired actions from the queue and returns them
+func (q *actQueue) CleanTimeout() []action.SealedEnvelope {
+	if q.ttl == 0 {
+		return nil
+	}
+	var removed []action.SealedEnvelope
+	now := q.clk.Now()
+	index := make(noncePriorityQueue, 0, q.index.Len())
+	for _, nonce := range q.index {
+		if now.Before(q.deadlines[nonce]) {
+			index = append(index, nonce)
+			continue
+		}
+		removed = append(removed, q.items[nonce])
+		delete(q.items, nonce)
+		delete(q.deadlines, nonce)
+	}
+	q.index = index
+	heap.Init(&q.index)
+	return removed
+}
+
 // FilterNonce removes all actions from the map with a nonce lower than the given threshold
 func (q *actQueue) FilterNonce(threshold uint64) []action.SealedEnvelope {
 	var removed []action.SealedEnvelope
@@ -106,6 +201,7 @@ func (q *actQueue) FilterNonce(threshold uint64) []action.SealedEnvelope {
 		nonce := heap.Pop(&q.index).(uint64)
 		removed = append(removed, q.items[nonce])
 		delete(q.items, nonce)
+		delete(q.deadlines, nonce)
 	}
 	return removed
 }
@@ -228,12 +324,21 @@ func (q *actQueue) removeActs(idx int) []action.SealedEnvelope {
 	for i := idx; i < q.index.Len(); i++ {
 		removedFromQueue = append(removedFromQueue, q.items[q.index[i]])
 		delete(q.items, q.index[i])
+		delete(q.deadlines, q.index[i])
 	}
 	q.index = q.index[:idx]
 	heap.Init(&q.index)
 	return removedFromQueue
 }
 
+// setDeadline sets the time when the action of the given nonce expires
+func (q *actQueue) setDeadline(nonce uint64) {
+	if q.ttl == 0 {
+		return
+	}
+	q.deadlines[nonce] = q.clk.Now().Add(q.ttl)
+}
+
 // enoughBalance helps check whether queue's pending balance is sufficient for the given action
 func (q *actQueue) enoughBalance(act action.SealedEnvelope, updateBalance bool) bool {
 	cost, _ := act.Cost()
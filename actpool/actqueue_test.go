@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/facebookgo/clock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -75,6 +76,101 @@ func TestActQueue_Put(t *testing.T) {
 	require.NotNil(err)
 }
 
+func TestActQueue_Replace(t *testing.T) {
+	require := require.New(t)
+	a := testaddress.IotxAddrinfo["alfa"]
+	b := testaddress.IotxAddrinfo["bravo"]
+	q := NewActQueue().(*actQueue)
+	tsf1, err := testutil.SignedTransfer(a, b, 1, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(q.Put(tsf1))
+	act, ok := q.Get(uint64(1))
+	require.True(ok)
+	require.Equal(tsf1, act)
+	_, ok = q.Get(uint64(2))
+	require.False(ok)
+	// tsf2 is a replacement transfer
+	tsf2, err := testutil.SignedTransfer(a, b, 1, big.NewInt(1000), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(q.Replace(tsf2))
+	require.Equal(1, q.Len())
+	act, ok = q.Get(uint64(1))
+	require.True(ok)
+	require.Equal(tsf2, act)
+	tsf3, err := testutil.SignedTransfer(a, b, 3, big.NewInt(1000), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	require.Error(q.Replace(tsf3))
+}
+
+func TestActQueue_Tail(t *testing.T) {
+	require := require.New(t)
+	a := testaddress.IotxAddrinfo["alfa"]
+	b := testaddress.IotxAddrinfo["bravo"]
+	q := NewActQueue().(*actQueue)
+	_, ok := q.Tail()
+	require.False(ok)
+	_, ok = q.PopTail()
+	require.False(ok)
+	tsf1, err := testutil.SignedTransfer(a, b, 1, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(a, b, 2, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(a, b, 4, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(q.Put(tsf4))
+	require.NoError(q.Put(tsf1))
+	require.NoError(q.Put(tsf2))
+	act, ok := q.Tail()
+	require.True(ok)
+	require.Equal(tsf4, act)
+	act, ok = q.PopTail()
+	require.True(ok)
+	require.Equal(tsf4, act)
+	act, ok = q.PopTail()
+	require.True(ok)
+	require.Equal(tsf2, act)
+	require.Equal([]action.SealedEnvelope{tsf1}, q.AllActs())
+	require.NoError(q.Put(tsf2))
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2}, q.AllActs())
+}
+
+func TestActQueue_CleanTimeout(t *testing.T) {
+	require := require.New(t)
+	a := testaddress.IotxAddrinfo["alfa"]
+	b := testaddress.IotxAddrinfo["bravo"]
+	c := clock.NewMock()
+	q := NewActQueue(WithTimeToLive(time.Minute), WithClock(c)).(*actQueue)
+	tsf1, err := testutil.SignedTransfer(a, b, 1, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(a, b, 2, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(a, b, 3, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(q.Put(tsf1))
+	require.NoError(q.Put(tsf3))
+	c.Add(30 * time.Second)
+	require.NoError(q.Put(tsf2))
+	require.Empty(q.CleanTimeout())
+	c.Add(30 * time.Second)
+	require.ElementsMatch([]action.SealedEnvelope{tsf1, tsf3}, q.CleanTimeout())
+	require.Equal([]action.SealedEnvelope{tsf2}, q.AllActs())
+	// Replacing an action renews its deadline
+	c.Add(15 * time.Second)
+	tsf2, err = testutil.SignedTransfer(a, b, 2, big.NewInt(1), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	require.NoError(q.Replace(tsf2))
+	c.Add(30 * time.Second)
+	require.Empty(q.CleanTimeout())
+	c.Add(30 * time.Second)
+	require.Equal([]action.SealedEnvelope{tsf2}, q.CleanTimeout())
+	require.True(q.Empty())
+	// Actions never expire without time to live
+	q = NewActQueue(WithClock(c)).(*actQueue)
+	require.NoError(q.Put(tsf1))
+	c.Add(time.Hour)
+	require.Empty(q.CleanTimeout())
+}
+
 func TestActQueue_FilterNonce(t *testing.T) {
 	require := require.New(t)
 	a := testaddress.IotxAddrinfo["alfa"]
@@ -0,0 +1,164 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/proto"
+)
+
+// journal is an on-disk log of the actions accepted by the actpool, which is replayed to restore the pool on restart.
+// Each record is an action proto prefixed by its size in big endian.
+type journal struct {
+	path   string
+	writer *os.File
+}
+
+func newJournal(path string) *journal {
+	return &journal{path: path}
+}
+
+// load reads the actions in the journal and feeds them to the given function. Actions failed to add are skipped.
+func (j *journal) load(add func(action.SealedEnvelope) error) error {
+	file, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to open journal %s", j.path)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	total, dropped := 0, 0
+	for {
+		act, err := readAction(reader)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			// A partially written record is left at the end of the journal after an unclean shutdown
+			log.L().Warn("Failed to read action from journal.", zap.Error(err))
+			break
+		}
+		total++
+		if err := add(act); err != nil {
+			hash := act.Hash()
+			log.L().Debug("Dropped action from journal.", log.Hex("hash", hash[:]), zap.Error(err))
+			dropped++
+		}
+	}
+	log.L().Info("Loaded actpool journal.", zap.Int("actions", total), zap.Int("dropped", dropped))
+	return nil
+}
+
+// insert appends the action to the journal. It's a no-op before the journal is opened by rotate, which is the case
+// while the journal is being loaded.
+func (j *journal) insert(act action.SealedEnvelope) error {
+	if j.writer == nil {
+		return nil
+	}
+	return writeAction(j.writer, act)
+}
+
+// rotate regenerates the journal with the given actions and opens it for appending
+func (j *journal) rotate(acts []action.SealedEnvelope) error {
+	if err := j.close(); err != nil {
+		return err
+	}
+	newPath := j.path + ".new"
+	file, err := os.OpenFile(newPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create journal %s", newPath)
+	}
+	for _, act := range acts {
+		if err := writeAction(file, act); err != nil {
+			file.Close()
+			return err
+		}
+	}
+	if err := file.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close journal %s", newPath)
+	}
+	if err := os.Rename(newPath, j.path); err != nil {
+		return errors.Wrapf(err, "failed to replace journal %s", j.path)
+	}
+	j.writer, err = os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open journal %s", j.path)
+	}
+	return nil
+}
+
+// close closes the journal
+func (j *journal) close() error {
+	if j.writer == nil {
+		return nil
+	}
+	err := j.writer.Close()
+	j.writer = nil
+	if err != nil {
+		return errors.Wrapf(err, "failed to close journal %s", j.path)
+	}
+	return nil
+}
+
+func writeAction(w io.Writer, act action.SealedEnvelope) error {
+	actPb, err := act.Proto()
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(actPb)
+	if err != nil {
+		return errors.Wrap(err, "failed to serialize action")
+	}
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(data)))
+	if _, err := w.Write(append(size[:], data...)); err != nil {
+		return errors.Wrap(err, "failed to write action to journal")
+	}
+	return nil
+}
+
+func readAction(r io.Reader) (action.SealedEnvelope, error) {
+	var act action.SealedEnvelope
+	var size [4]byte
+	if _, err := io.ReadFull(r, size[:]); err != nil {
+		if err == io.EOF {
+			return act, err
+		}
+		return act, errors.Wrap(err, "failed to read action size")
+	}
+	// An action never exceeds the size of a block, so a larger size means a corrupted record
+	length := binary.BigEndian.Uint32(size[:])
+	if uint64(length) > genesis.BlockSizeLimit {
+		return act, errors.Errorf("action size %d exceeds the limit %d", length, genesis.BlockSizeLimit)
+	}
+	data := make([]byte, length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return act, errors.Wrap(err, "failed to read action")
+	}
+	pbAct := &iproto.ActionPb{}
+	if err := proto.Unmarshal(data, pbAct); err != nil {
+		return act, errors.Wrap(err, "failed to deserialize action")
+	}
+	if err := act.LoadProto(pbAct); err != nil {
+		return act, err
+	}
+	return act, nil
+}
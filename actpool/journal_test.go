@@ -0,0 +1,88 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"bytes"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestJournal(t *testing.T) {
+	require := require.New(t)
+	path := filepath.Join(os.TempDir(), "actpool.journal")
+	testutil.CleanupPath(t, path)
+	defer testutil.CleanupPath(t, path)
+
+	a := testaddress.IotxAddrinfo["alfa"]
+	b := testaddress.IotxAddrinfo["bravo"]
+	tsf1, err := testutil.SignedTransfer(a, b, 1, big.NewInt(1), nil, uint64(10000), big.NewInt(0))
+	require.NoError(err)
+	vote2, err := testutil.SignedVote(a, b, 2, uint64(10000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(a, b, 3, big.NewInt(1000), nil, uint64(10000), big.NewInt(0))
+	require.NoError(err)
+
+	var loaded []action.SealedEnvelope
+	add := func(act action.SealedEnvelope) error {
+		loaded = append(loaded, act)
+		return nil
+	}
+	j := newJournal(path)
+	// Loading a missing journal is a no-op
+	require.NoError(j.load(add))
+	require.Empty(loaded)
+	// Inserting before the journal is opened is a no-op
+	require.NoError(j.insert(tsf1))
+
+	require.NoError(j.rotate([]action.SealedEnvelope{tsf1}))
+	require.NoError(j.insert(vote2))
+	require.NoError(j.insert(tsf3))
+	require.NoError(j.close())
+	require.NoError(j.load(add))
+	require.Equal(3, len(loaded))
+	require.Equal(tsf1.Hash(), loaded[0].Hash())
+	require.Equal(vote2.Hash(), loaded[1].Hash())
+	require.Equal(tsf3.Hash(), loaded[2].Hash())
+
+	// Compact the journal
+	loaded = nil
+	require.NoError(j.rotate([]action.SealedEnvelope{tsf3}))
+	require.NoError(j.close())
+	require.NoError(j.load(add))
+	require.Equal(1, len(loaded))
+	require.Equal(tsf3.Hash(), loaded[0].Hash())
+
+	// A partially written record at the end is skipped
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	require.NoError(err)
+	_, err = file.Write([]byte{100, 0, 0, 0, 1, 2})
+	require.NoError(err)
+	require.NoError(file.Close())
+	loaded = nil
+	require.NoError(j.load(add))
+	require.Equal(1, len(loaded))
+	require.Equal(tsf3.Hash(), loaded[0].Hash())
+
+	// A record larger than the max action size is rejected without being read
+	_, err = readAction(bytes.NewReader([]byte{0xff, 0xff, 0xff, 0xff, 1, 2}))
+	require.Error(err)
+	var buf bytes.Buffer
+	require.NoError(writeAction(&buf, tsf3))
+	require.Zero(buf.Bytes()[0])
+	act, err := readAction(&buf)
+	require.NoError(err)
+	require.Equal(tsf3.Hash(), act.Hash())
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// EventType is the type of an actpool event
+type EventType int
+
+const (
+	// ActionAdded is emitted when an action is accepted into the pool
+	ActionAdded EventType = iota
+	// ActionReplaced is emitted when a queued action is replaced by a higher priced one of the same nonce
+	ActionReplaced
+	// ActionEvicted is emitted when an action is dropped from the pool without being confirmed, e.g., because it
+	// expires, is underpriced or becomes unpayable
+	ActionEvicted
+	// ActionConfirmed is emitted when an action is removed from the pool after being committed to a block
+	ActionConfirmed
+)
+
+// String returns the name of the event type
+func (t EventType) String() string {
+	switch t {
+	case ActionAdded:
+		return "added"
+	case ActionReplaced:
+		return "replaced"
+	case ActionEvicted:
+		return "evicted"
+	case ActionConfirmed:
+		return "confirmed"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is an event of an action entering or leaving the pool
+type Event struct {
+	Type   EventType
+	Action action.SealedEnvelope
+}
+
+// Subscriber is an interface which will get notified of actpool events. Subscribers are notified synchronously in the
+// order of events while the actpool is locked, so they should return promptly and must not call back into the actpool.
+type Subscriber interface {
+	HandleActPoolEvent(Event) error
+}
+
+// emit notifies the subscribers of the event
+func (ap *actPool) emit(eventType EventType, act action.SealedEnvelope) {
+	evt := Event{Type: eventType, Action: act}
+	for _, s := range ap.subscribers {
+		if err := s.HandleActPoolEvent(evt); err != nil {
+			log.L().Error("Failed to handle actpool event.", zap.Stringer("type", eventType), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+)
+
+// BaseFeeParams are the parameters the base fee is calculated with
+type BaseFeeParams struct {
+	// ElasticityMultiplier is the ratio of the block gas limit to the gas target
+	ElasticityMultiplier uint64
+	// ChangeDenominator bounds the change of the base fee from the parent block to 1/ChangeDenominator
+	ChangeDenominator uint64
+}
+
+// LoadBaseFeeParams returns the base fee parameters at the height, which are governed by the governance protocol, and
+// default to the ones defined in genesis
+func LoadBaseFeeParams(sr governance.StateReader, height uint64) (BaseFeeParams, error) {
+	multiplier, err := governance.Parameter(
+		sr,
+		governance.ElasticityMultiplier,
+		height,
+		genesis.ElasticityMultiplier,
+	)
+	if err != nil {
+		return BaseFeeParams{}, errors.Wrap(err, "error when loading elasticity multiplier")
+	}
+	denominator, err := governance.Parameter(
+		sr,
+		governance.BaseFeeChangeDenominator,
+		height,
+		genesis.BaseFeeChangeDenominator,
+	)
+	if err != nil {
+		return BaseFeeParams{}, errors.Wrap(err, "error when loading base fee change denominator")
+	}
+	return BaseFeeParams{ElasticityMultiplier: multiplier, ChangeDenominator: denominator}, nil
+}
+
+// CalcBaseFee calculates the base fee per gas of the block following the parent one, given the base fee of the parent
+// block and the gas it used. The base fee goes up if the parent block uses more gas than the target, which is the block
+// gas limit divided by the elasticity multiplier, and goes down otherwise. The change is bounded by
+// 1/ChangeDenominator of the parent base fee.
+func CalcBaseFee(parentBaseFee *big.Int, gasUsed uint64, gasLimit uint64, params BaseFeeParams) *big.Int {
+	parentBaseFee = new(big.Int).Set(parentBaseFee)
+	gasTarget := gasLimit / params.ElasticityMultiplier
+	if gasTarget == 0 || gasUsed == gasTarget {
+		return parentBaseFee
+	}
+	denominator := new(big.Int).SetUint64(gasTarget * params.ChangeDenominator)
+	if gasUsed > gasTarget {
+		delta := new(big.Int).SetUint64(gasUsed - gasTarget)
+		delta.Mul(delta, parentBaseFee).Div(delta, denominator)
+		// The base fee always goes up by at least 1, so that it could move away from zero
+		if delta.Sign() == 0 {
+			delta.SetUint64(1)
+		}
+		return parentBaseFee.Add(parentBaseFee, delta)
+	}
+	delta := new(big.Int).SetUint64(gasTarget - gasUsed)
+	delta.Mul(delta, parentBaseFee).Div(delta, denominator)
+	return parentBaseFee.Sub(parentBaseFee, delta)
+}
@@ -0,0 +1,80 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	ta "github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestCalcBaseFee(t *testing.T) {
+	require := require.New(t)
+
+	params := BaseFeeParams{
+		ElasticityMultiplier: genesis.ElasticityMultiplier,
+		ChangeDenominator:    genesis.BaseFeeChangeDenominator,
+	}
+	// Gas used hits the target
+	require.Equal(big.NewInt(800), CalcBaseFee(big.NewInt(800), 500000, 1000000, params))
+	// Full block raises the base fee by 1/8
+	require.Equal(big.NewInt(900), CalcBaseFee(big.NewInt(800), 1000000, 1000000, params))
+	// Empty block lowers the base fee by 1/8
+	require.Equal(big.NewInt(700), CalcBaseFee(big.NewInt(800), 0, 1000000, params))
+	// Partially filled block
+	require.Equal(big.NewInt(740), CalcBaseFee(big.NewInt(800), 200000, 1000000, params))
+	// Zero base fee goes up by at least 1
+	require.Equal(big.NewInt(1), CalcBaseFee(big.NewInt(0), 600000, 1000000, params))
+	require.Equal(big.NewInt(0), CalcBaseFee(big.NewInt(0), 0, 1000000, params))
+	// The parent base fee is not modified
+	parentBaseFee := big.NewInt(800)
+	CalcBaseFee(parentBaseFee, 1000000, 1000000, params)
+	require.Equal(big.NewInt(800), parentBaseFee)
+	// The governed parameters change the target and the bound
+	params = BaseFeeParams{ElasticityMultiplier: 4, ChangeDenominator: 4}
+	require.Equal(big.NewInt(1400), CalcBaseFee(big.NewInt(800), 1000000, 1000000, params))
+}
+
+func TestGasUsed(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default
+	bc, err := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption())
+	require.NoError(err)
+	require.NoError(bc.Start(context.Background()))
+	defer func() {
+		require.NoError(bc.Stop(context.Background()))
+	}()
+
+	tsf, err := testutil.SignedTransfer(ta.IotxAddrinfo["alfa"], ta.IotxAddrinfo["bravo"], 1,
+		big.NewInt(1), nil, 600000, big.NewInt(10))
+	require.NoError(err)
+	ex, err := testutil.SignedExecution(ta.IotxAddrinfo["alfa"], action.EmptyAddress, 2,
+		big.NewInt(0), 600000, big.NewInt(10), nil)
+	require.NoError(err)
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		AddActions(tsf, ex).
+		SignAndBuild(ta.IotxAddrinfo["producer"])
+	require.NoError(err)
+	blk.Receipts = map[hash.Hash32B]*action.Receipt{ex.Hash(): {Hash: ex.Hash(), GasConsumed: 53000}}
+
+	// The transfer without receipt uses its intrinsic gas, and the execution uses the gas consumed in its receipt
+	gasUsed, err := bc.(*blockchain).gasUsed(&blk)
+	require.NoError(err)
+	require.Equal(action.TransferBaseIntrinsicGas+53000, gasUsed)
+}
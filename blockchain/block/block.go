@@ -9,9 +9,11 @@ package block
 import (
 	"bytes"
 	"errors"
+	"math/big"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"go.uber.org/zap"
 	"golang.org/x/crypto/blake2b"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -20,6 +22,7 @@ import (
 	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/keypair"
+	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/proto"
 	"github.com/iotexproject/iotex-core/state/factory"
 )
@@ -31,6 +34,24 @@ type Footer struct {
 	commitTimestamp uint64
 }
 
+// NewFooter creates a block footer with the commit endorsements and the commit timestamp
+func NewFooter(endorsements *endorsement.Set, commitTimestamp uint64) *Footer {
+	return &Footer{
+		endorsements:    endorsements,
+		commitTimestamp: commitTimestamp,
+	}
+}
+
+// Endorsements returns the commit endorsements of the block
+func (f *Footer) Endorsements() *endorsement.Set {
+	return f.endorsements
+}
+
+// CommitTimestamp returns the timestamp when the block is committed
+func (f *Footer) CommitTimestamp() uint64 {
+	return f.commitTimestamp
+}
+
 // Block defines the struct of block
 type Block struct {
 	Header
@@ -79,21 +100,43 @@ func (b *Block) ConvertToBlockHeaderPb() *iproto.BlockHeaderPb {
 	pbHeader.DkgID = b.Header.dkgID[:]
 	pbHeader.DkgPubkey = b.Header.dkgPubkey[:]
 	pbHeader.DkgSignature = b.Header.dkgBlockSig[:]
+	if b.Header.baseFee != nil {
+		pbHeader.BaseFee = b.Header.baseFee.Bytes()
+	}
+	if b.Header.logsBloom != (Bloom{}) {
+		pbHeader.LogsBloom = b.Header.logsBloom[:]
+	}
 	return &pbHeader
 }
 
-// ConvertToBlockPb converts Block to BlockPb
+// ConvertToBlockPb converts Block to BlockPb, which returns nil if any action of the block cannot be converted
 func (b *Block) ConvertToBlockPb() *iproto.BlockPb {
 	actions := []*iproto.ActionPb{}
 	for _, act := range b.Actions {
-		actions = append(actions, act.Proto())
+		actPb, err := act.Proto()
+		if err != nil {
+			log.L().Error("Error when converting action into proto.", zap.Error(err))
+			return nil
+		}
+		actions = append(actions, actPb)
+	}
+	pbBlock := &iproto.BlockPb{Header: b.ConvertToBlockHeaderPb(), Actions: actions}
+	if b.Footer != nil {
+		pbBlock.Footer = &iproto.BlockFooterPb{CommitTimestamp: b.Footer.commitTimestamp}
+		if b.Footer.endorsements != nil {
+			pbBlock.Footer.Endorsements = b.Footer.endorsements.ToProto()
+		}
 	}
-	return &iproto.BlockPb{Header: b.ConvertToBlockHeaderPb(), Actions: actions}
+	return pbBlock
 }
 
 // Serialize returns the serialized byte stream of the block
 func (b *Block) Serialize() ([]byte, error) {
-	return proto.Marshal(b.ConvertToBlockPb())
+	blkPb := b.ConvertToBlockPb()
+	if blkPb == nil {
+		return nil, errors.New("failed to convert block into proto")
+	}
+	return proto.Marshal(blkPb)
 }
 
 // ConvertFromBlockHeaderPb converts BlockHeaderPb to BlockHeader
@@ -113,6 +156,10 @@ func (b *Block) ConvertFromBlockHeaderPb(pbBlock *iproto.BlockPb) {
 	b.Header.dkgID = pbBlock.GetHeader().GetDkgID()
 	b.Header.dkgPubkey = pbBlock.GetHeader().GetDkgPubkey()
 	b.Header.dkgBlockSig = pbBlock.GetHeader().GetDkgSignature()
+	if baseFee := pbBlock.GetHeader().GetBaseFee(); len(baseFee) > 0 {
+		b.Header.baseFee = new(big.Int).SetBytes(baseFee)
+	}
+	copy(b.Header.logsBloom[:], pbBlock.GetHeader().GetLogsBloom())
 }
 
 // ConvertFromBlockPb converts BlockPb to Block
@@ -129,6 +176,17 @@ func (b *Block) ConvertFromBlockPb(pbBlock *iproto.BlockPb) error {
 		b.Actions = append(b.Actions, act)
 		// TODO handle SecretProposal and SecretWitness
 	}
+
+	b.Footer = nil
+	if pbFooter := pbBlock.GetFooter(); pbFooter != nil {
+		b.Footer = &Footer{commitTimestamp: pbFooter.CommitTimestamp}
+		if pbFooter.GetEndorsements() != nil {
+			b.Footer.endorsements = &endorsement.Set{}
+			if err := b.Footer.endorsements.FromProto(pbFooter.GetEndorsements()); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -174,7 +232,7 @@ func (b *Block) VerifyStateRoot(root hash.Hash32B) error {
 func (b *Block) VerifySignature() bool {
 	blkHash := b.HashBlock()
 
-	return crypto.EC283.Verify(b.Header.pubkey, blkHash[:], b.Header.blockSig)
+	return crypto.Verify(b.Header.pubkey, blkHash[:], b.Header.blockSig)
 }
 
 // ProducerAddress returns the address of producer
@@ -194,6 +252,7 @@ func (b *Block) RunnableActions() RunnableActions {
 		blockTimeStamp:      b.Header.timestamp,
 		blockProducerPubKey: b.Header.pubkey,
 		blockProducerAddr:   addr.IotxAddress(),
+		baseFee:             b.Header.BaseFee(),
 		actions:             b.Actions,
 	}
 }
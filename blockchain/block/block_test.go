@@ -16,6 +16,7 @@ import (
 	"golang.org/x/crypto/blake2b"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/endorsement"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/version"
 	"github.com/iotexproject/iotex-core/proto"
@@ -239,3 +240,67 @@ func TestConvertFromBlockPb(t *testing.T) {
 	require.Equal(t, blk.Header.txRoot, blk.TxRoot())
 	require.Equal(t, blk.Header.stateRoot, blk.StateRoot())
 }
+
+func TestBaseFeeSerialization(t *testing.T) {
+	require := require.New(t)
+
+	blk, err := NewTestingBuilder().
+		SetChainID(1).
+		SetHeight(123).
+		SignAndBuild(ta.IotxAddrinfo["producer"])
+	require.NoError(err)
+	require.Equal(big.NewInt(0), blk.BaseFee())
+	hashWithoutBaseFee := blk.HashBlock()
+	streamLength := len(blk.Header.ByteStream())
+
+	// A zero base fee doesn't change the hash of the block
+	blk.Header.baseFee = big.NewInt(0)
+	require.Equal(hashWithoutBaseFee, blk.HashBlock())
+
+	blk.Header.baseFee = big.NewInt(1000)
+	require.NotEqual(hashWithoutBaseFee, blk.HashBlock())
+	// The base fee is encoded in fixed length ahead of the logs bloom
+	require.Equal(streamLength+baseFeeLength, len(blk.Header.ByteStream()))
+	raw, err := blk.Serialize()
+	require.NoError(err)
+	var newblk Block
+	require.NoError(newblk.Deserialize(raw))
+	require.Equal(big.NewInt(1000), newblk.BaseFee())
+	require.Equal(blk.HashBlock(), newblk.HashBlock())
+}
+
+func TestFooterSerialization(t *testing.T) {
+	require := require.New(t)
+	blk := Block{}
+	require.NoError(blk.ConvertFromBlockPb(&iproto.BlockPb{
+		Header: &iproto.BlockHeaderPb{
+			Version: version.ProtocolVersion,
+			Height:  123,
+		},
+	}))
+	require.Nil(blk.Footer)
+	blk.Header.txRoot = blk.CalculateTxRoot()
+
+	blkHash := blk.HashBlock()
+	set := endorsement.NewSet(blkHash[:])
+	en := endorsement.NewEndorsement(
+		endorsement.NewConsensusVote(blkHash[:], 123, 0, endorsement.COMMIT),
+		ta.IotxAddrinfo["producer"],
+	)
+	require.NoError(set.AddEndorsement(en))
+	blk.Footer = NewFooter(set, 1541000000)
+
+	raw, err := blk.Serialize()
+	require.NoError(err)
+	var newblk Block
+	require.NoError(newblk.Deserialize(raw))
+	require.NotNil(newblk.Footer)
+	require.Equal(uint64(1541000000), newblk.Footer.CommitTimestamp())
+	require.Equal(blkHash[:], newblk.Footer.Endorsements().BlockHash())
+	require.Equal(1, newblk.Footer.Endorsements().NumOfValidEndorsements(
+		map[endorsement.ConsensusVoteTopic]bool{endorsement.COMMIT: true},
+		[]string{ta.IotxAddrinfo["producer"].RawAddress},
+	))
+	// footer is not part of the block hash
+	require.Equal(blkHash, newblk.HashBlock())
+}
@@ -0,0 +1,65 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package block
+
+import (
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+)
+
+const (
+	// BloomByteLength is the length in bytes of the bloom filter of the logs in a block
+	BloomByteLength = 256
+	// bloomHashes is the number of bits set in the bloom filter for each item
+	bloomHashes = 3
+)
+
+// Bloom is the bloom filter of the addresses and the topics of the logs in a block, which tells that a block doesn't
+// contain the logs of an address or a topic without reading its receipts
+type Bloom [BloomByteLength]byte
+
+// Add adds the data into the bloom filter
+func (b *Bloom) Add(data []byte) {
+	for _, i := range bloomBits(data) {
+		b[BloomByteLength-1-i/8] |= byte(1) << (i % 8)
+	}
+}
+
+// Test returns false if the data is definitely not in the bloom filter
+func (b Bloom) Test(data []byte) bool {
+	for _, i := range bloomBits(data) {
+		if b[BloomByteLength-1-i/8]&(byte(1)<<(i%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateLogsBloom creates the bloom filter of the addresses and the topics of the logs in the receipts
+func CreateLogsBloom(receipts map[hash.Hash32B]*action.Receipt) Bloom {
+	var b Bloom
+	for _, r := range receipts {
+		for _, l := range r.Logs {
+			b.Add([]byte(l.Address))
+			for _, topic := range l.Topics {
+				b.Add(topic[:])
+			}
+		}
+	}
+	return b
+}
+
+// bloomBits returns the positions of the bits set for the data, each of which is taken from a pair of bytes of the
+// hash of the data
+func bloomBits(data []byte) []uint {
+	h := hash.Hash256b(data)
+	bits := make([]uint, bloomHashes)
+	for i := range bits {
+		bits[i] = (uint(h[2*i])<<8 | uint(h[2*i+1])) % (BloomByteLength * 8)
+	}
+	return bits
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	ta "github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestLogsBloom(t *testing.T) {
+	require := require.New(t)
+
+	topic := hash.Hash256b([]byte("Transfer(address,address,uint256)"))
+	bloom := CreateLogsBloom(map[hash.Hash32B]*action.Receipt{
+		hash.ZeroHash32B: {
+			Logs: []*action.Log{{Address: "io1contract", Topics: []hash.Hash32B{topic}}},
+		},
+	})
+	require.True(bloom.Test([]byte("io1contract")))
+	require.True(bloom.Test(topic[:]))
+	require.False(bloom.Test([]byte("io1other")))
+	require.Equal(Bloom{}, CreateLogsBloom(nil))
+
+	blk, err := NewTestingBuilder().
+		SetChainID(1).
+		SetHeight(123).
+		SignAndBuild(ta.IotxAddrinfo["producer"])
+	require.NoError(err)
+	hashWithoutBloom := blk.HashBlock()
+
+	blk.Header.logsBloom = bloom
+	require.NotEqual(hashWithoutBloom, blk.HashBlock())
+	raw, err := blk.Serialize()
+	require.NoError(err)
+	var newblk Block
+	require.NoError(newblk.Deserialize(raw))
+	require.Equal(bloom, newblk.LogsBloom())
+	require.Equal(blk.HashBlock(), newblk.HashBlock())
+}
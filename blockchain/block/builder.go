@@ -28,6 +28,7 @@ func NewBuilder(ra RunnableActions) *Builder {
 				height:    ra.blockHeight,
 				timestamp: ra.blockTimeStamp,
 				txRoot:    ra.txHash,
+				baseFee:   ra.baseFee,
 			},
 			Actions: ra.actions,
 		},
@@ -67,6 +68,12 @@ func (b *Builder) SetReceipts(rm map[hash.Hash32B]*action.Receipt) *Builder {
 	return b
 }
 
+// SetLogsBloom sets the bloom filter of the logs emitted by the actions included in this building block.
+func (b *Builder) SetLogsBloom(bloom Bloom) *Builder {
+	b.blk.Header.logsBloom = bloom
+	return b
+}
+
 // SetSecretProposals sets the secret proposals for block which is building.
 func (b *Builder) SetSecretProposals(sp []*action.SecretProposal) *Builder {
 	b.blk.SecretProposals = sp
@@ -94,7 +101,7 @@ func (b *Builder) SetDKG(id, pk, sig []byte) *Builder {
 func (b *Builder) SignAndBuild(signer *iotxaddress.Address) (Block, error) {
 	b.blk.Header.pubkey = signer.PublicKey
 	blkHash := b.blk.HashBlock()
-	sig := crypto.EC283.Sign(signer.PrivateKey, blkHash[:])
+	sig := crypto.Sign(signer.PrivateKey, blkHash[:])
 	if len(sig) == 0 {
 		return Block{}, errors.New("Failed to sign block")
 	}
@@ -7,6 +7,8 @@
 package block
 
 import (
+	"math/big"
+
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/pkg/enc"
@@ -15,6 +17,9 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
+// baseFeeLength is the length in bytes of the base fee in the byte stream of the header
+const baseFeeLength = 32
+
 // Header defines the struct of block header
 // make sure the variable type and order of this struct is same as "BlockHeaderPb" in blockchain.pb.go
 type Header struct {
@@ -31,6 +36,8 @@ type Header struct {
 	dkgID         []byte            // dkg ID of producer
 	dkgPubkey     []byte            // dkg public key of producer
 	dkgBlockSig   []byte            // dkg signature of producer
+	baseFee       *big.Int          // base fee per gas burned by the actions
+	logsBloom     Bloom             // bloom filter of the addresses and topics of the logs
 }
 
 // Version returns the version of this header.
@@ -54,6 +61,17 @@ func (h Header) TxRoot() hash.Hash32B { return h.txRoot }
 // StateRoot returns the state root after apply this header.
 func (h Header) StateRoot() hash.Hash32B { return h.stateRoot }
 
+// BaseFee returns the base fee per gas burned by the actions in this block.
+func (h Header) BaseFee() *big.Int {
+	if h.baseFee == nil {
+		return big.NewInt(0)
+	}
+	return new(big.Int).Set(h.baseFee)
+}
+
+// LogsBloom returns the bloom filter of the logs emitted by the actions in this block.
+func (h Header) LogsBloom() Bloom { return h.logsBloom }
+
 // PublicKey returns the public key of this header.
 func (h Header) PublicKey() keypair.PublicKey { return h.pubkey }
 
@@ -94,6 +112,19 @@ func (h Header) ByteStream() []byte {
 	stream = append(stream, h.txRoot[:]...)
 	stream = append(stream, h.stateRoot[:]...)
 	stream = append(stream, h.receiptRoot[:]...)
+	// A zero base fee adds nothing to the stream, so that the hashes of the blocks without base fee are kept. Otherwise
+	// it's left padded to a fixed length, so that it cannot be confused with the logs bloom following it.
+	if h.baseFee != nil && h.baseFee.Sign() > 0 {
+		baseFee := h.baseFee.Bytes()
+		if len(baseFee) < baseFeeLength {
+			baseFee = append(make([]byte, baseFeeLength-len(baseFee)), baseFee...)
+		}
+		stream = append(stream, baseFee...)
+	}
+	// So does an empty logs bloom, so that the hashes of the blocks before the logs bloom is active are kept
+	if h.logsBloom != (Bloom{}) {
+		stream = append(stream, h.logsBloom[:]...)
+	}
 	return stream
 }
 
@@ -105,5 +136,6 @@ func (h Header) HeaderLogger(l *zap.Logger) *zap.Logger {
 		zap.Int64("timeStamp", h.timestamp),
 		log.Hex("prevBlockHash", h.prevBlockHash[:]),
 		log.Hex("txRoot", h.txRoot[:]),
-		log.Hex("stateRoot", h.stateRoot[:]))
+		log.Hex("stateRoot", h.stateRoot[:]),
+		zap.String("baseFee", h.BaseFee().String()))
 }
@@ -7,6 +7,8 @@
 package block
 
 import (
+	"math/big"
+
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/iotxaddress"
 	"github.com/iotexproject/iotex-core/pkg/hash"
@@ -20,6 +22,7 @@ type RunnableActions struct {
 	blockProducerPubKey keypair.PublicKey
 	blockProducerAddr   string
 	txHash              hash.Hash32B
+	baseFee             *big.Int
 	actions             []action.SealedEnvelope
 }
 
@@ -46,6 +49,14 @@ func (ra RunnableActions) BlockProducerAddr() string {
 // TxHash returns TxHash.
 func (ra RunnableActions) TxHash() hash.Hash32B { return ra.txHash }
 
+// BaseFee returns the base fee per gas burned by the actions.
+func (ra RunnableActions) BaseFee() *big.Int {
+	if ra.baseFee == nil {
+		return big.NewInt(0)
+	}
+	return ra.baseFee
+}
+
 // Actions returns Actions.
 func (ra RunnableActions) Actions() []action.SealedEnvelope {
 	return ra.actions
@@ -69,6 +80,12 @@ func (b *RunnableActionsBuilder) SetTimeStamp(ts int64) *RunnableActionsBuilder
 	return b
 }
 
+// SetBaseFee sets the base fee per gas for block which is building.
+func (b *RunnableActionsBuilder) SetBaseFee(baseFee *big.Int) *RunnableActionsBuilder {
+	b.ra.baseFee = baseFee
+	return b
+}
+
 // AddActions adds actions for block which is building.
 func (b *RunnableActionsBuilder) AddActions(acts ...action.SealedEnvelope) *RunnableActionsBuilder {
 	if b.ra.actions == nil {
@@ -7,6 +7,8 @@
 package block
 
 import (
+	"math/big"
+
 	"github.com/pkg/errors"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -61,6 +63,18 @@ func (b *TestingBuilder) SetPrevBlockHash(h hash.Hash32B) *TestingBuilder {
 	return b
 }
 
+// SetBaseFee sets the base fee per gas for block which is building.
+func (b *TestingBuilder) SetBaseFee(baseFee *big.Int) *TestingBuilder {
+	b.blk.Header.baseFee = baseFee
+	return b
+}
+
+// SetLogsBloom sets the bloom filter of the logs for block which is building.
+func (b *TestingBuilder) SetLogsBloom(bloom Bloom) *TestingBuilder {
+	b.blk.Header.logsBloom = bloom
+	return b
+}
+
 // AddActions adds actions for block which is building.
 func (b *TestingBuilder) AddActions(acts ...action.SealedEnvelope) *TestingBuilder {
 	if b.blk.Actions == nil {
@@ -113,7 +127,7 @@ func (b *TestingBuilder) SignAndBuild(signer *iotxaddress.Address) (Block, error
 	b.blk.Header.txRoot = b.blk.CalculateTxRoot()
 	b.blk.Header.pubkey = signer.PublicKey
 	blkHash := b.blk.HashBlock()
-	sig := crypto.EC283.Sign(signer.PrivateKey, blkHash[:])
+	sig := crypto.Sign(signer.PrivateKey, blkHash[:])
 	if len(sig) == 0 {
 		return Block{}, errors.New("Failed to sign block")
 	}
@@ -22,7 +22,9 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/blocklimit"
 	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
@@ -72,6 +74,8 @@ type Blockchain interface {
 	GetTransfersFromAddress(address string) ([]hash.Hash32B, error)
 	// GetTransfersToAddress returns transaction to address
 	GetTransfersToAddress(address string) ([]hash.Hash32B, error)
+	// GetTransfersByMemoTag returns transfers with the destination tag memo
+	GetTransfersByMemoTag(tag string) ([]hash.Hash32B, error)
 	// GetTransfersByTransferHash returns transfer by transfer hash
 	GetTransferByTransferHash(h hash.Hash32B) (*action.Transfer, error)
 	// GetBlockHashByTransferHash returns Block hash by transfer hash
@@ -96,6 +100,10 @@ type Blockchain interface {
 	GetReceiptByExecutionHash(h hash.Hash32B) (*action.Receipt, error)
 	// GetReceiptByActionHash returns the receipt by action hash
 	GetReceiptByActionHash(h hash.Hash32B) (*action.Receipt, error)
+	// GetLogs returns the logs matching the filter
+	GetLogs(filter *LogFilter) ([]*action.Log, error)
+	// TraceExecution returns the receipt and the trace of re-executing the execution
+	TraceExecution(h hash.Hash32B) (*action.Receipt, *evm.Trace, error)
 	// GetActionsFromAddress returns actions from address
 	GetActionsFromAddress(address string) ([]hash.Hash32B, error)
 	// GetActionsToAddress returns actions to address
@@ -106,6 +114,14 @@ type Blockchain interface {
 	GetBlockHashByActionHash(h hash.Hash32B) (hash.Hash32B, error)
 	// GetFactory returns the state factory
 	GetFactory() factory.Factory
+	// BlockLimits returns the gas limit and the size limit of the next block
+	BlockLimits() (blocklimit.Limits, error)
+	// NextBaseFee returns the base fee per gas of the next block
+	NextBaseFee() (*big.Int, error)
+	// Productivity returns the number of blocks each producer has produced in the range of heights
+	Productivity(startHeight uint64, endHeight uint64) (map[string]uint64, error)
+	// ForkSchedule returns the fork schedule of the genesis spec
+	ForkSchedule() genesis.ForkSchedule
 	// GetChainID returns the chain ID
 	ChainID() uint32
 	// ChainAddress returns chain address on parent chain, the root chain return empty.
@@ -166,6 +182,8 @@ type blockchain struct {
 	clk           clock.Clock
 	blocklistener []BlockCreationSubscriber
 	timerFactory  *prometheustimer.TimerFactory
+	// forkSchedule is the fork schedule of the genesis spec
+	forkSchedule genesis.ForkSchedule
 
 	// used by account-based model
 	sf factory.Factory
@@ -252,7 +270,7 @@ func ClockOption(clk clock.Clock) Option {
 }
 
 // NewBlockchain creates a new blockchain and DB instance
-func NewBlockchain(cfg config.Config, opts ...Option) Blockchain {
+func NewBlockchain(cfg config.Config, opts ...Option) (Blockchain, error) {
 	// create the Blockchain
 	chain := &blockchain{
 		config:  cfg,
@@ -261,8 +279,7 @@ func NewBlockchain(cfg config.Config, opts ...Option) Blockchain {
 	}
 	for _, opt := range opts {
 		if err := opt(chain, cfg); err != nil {
-			log.S().Errorf("Failed to execute blockchain creation option %p: %v", opt, err)
-			return nil
+			return nil, errors.Wrapf(err, "failed to execute blockchain creation option %p", opt)
 		}
 	}
 	timerFactory, err := prometheustimer.New(
@@ -275,19 +292,24 @@ func NewBlockchain(cfg config.Config, opts ...Option) Blockchain {
 		log.L().Error("Failed to generate prometheus timer factory.", zap.Error(err))
 	}
 	chain.timerFactory = timerFactory
+	// The fork schedule is only taken from the genesis spec, so that it's identical on every node of the chain
+	spec, err := GenesisSpec(cfg.Chain)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load genesis spec")
+	}
+	chain.forkSchedule = spec.ForkSchedule
 	// Set block validator
 	pubKey, _, err := cfg.KeyPair()
 	if err != nil {
-		log.L().Error("Failed to get key pair of producer.", zap.Error(err))
-		return nil
+		return nil, errors.Wrap(err, "failed to get key pair of producer")
 	}
 	pkHash := keypair.HashPubKey(pubKey)
 	address := address.New(cfg.Chain.ID, pkHash[:])
-	if err != nil {
-		log.L().Error("Failed to get producer's address by public key.", zap.Error(err))
-		return nil
+	chain.validator = &validator{
+		sf:            chain.sf,
+		validatorAddr: address.IotxAddress(),
+		forkSchedule:  chain.forkSchedule,
 	}
-	chain.validator = &validator{sf: chain.sf, validatorAddr: address.IotxAddress()}
 
 	if chain.dao != nil {
 		chain.lifecycle.Add(chain.dao)
@@ -295,7 +317,7 @@ func NewBlockchain(cfg config.Config, opts ...Option) Blockchain {
 	if chain.sf != nil {
 		chain.lifecycle.Add(chain.sf)
 	}
-	return chain
+	return chain, nil
 }
 
 func (bc *blockchain) ChainID() uint32 {
@@ -436,6 +458,14 @@ func (bc *blockchain) GetTransfersToAddress(address string) ([]hash.Hash32B, err
 	return bc.dao.getTransfersByRecipientAddress(address)
 }
 
+// GetTransfersByMemoTag returns transfers with the destination tag memo
+func (bc *blockchain) GetTransfersByMemoTag(tag string) ([]hash.Hash32B, error) {
+	if !bc.config.Explorer.Enabled {
+		return nil, errors.New("explorer not enabled")
+	}
+	return bc.dao.getTransfersByMemoTag(tag)
+}
+
 // TODO: To be deprecated
 // GetTransferByTransferHash returns transfer by transfer hash
 func (bc *blockchain) GetTransferByTransferHash(h hash.Hash32B) (*action.Transfer, error) {
@@ -655,6 +685,37 @@ func (bc *blockchain) GetFactory() factory.Factory {
 	return bc.sf
 }
 
+// BlockLimits returns the gas limit and the size limit of the next block
+func (bc *blockchain) BlockLimits() (blocklimit.Limits, error) {
+	if bc.sf == nil {
+		return blocklimit.Limits{}, errors.New("empty state factory")
+	}
+	return blocklimit.Load(bc.sf, bc.TipHeight()+1)
+}
+
+// NextBaseFee returns the base fee per gas of the next block
+func (bc *blockchain) NextBaseFee() (*big.Int, error) {
+	bc.mu.RLock()
+	defer bc.mu.RUnlock()
+	return bc.nextBaseFee()
+}
+
+// ForkSchedule returns the fork schedule of the genesis spec
+func (bc *blockchain) ForkSchedule() genesis.ForkSchedule { return bc.forkSchedule }
+
+// Productivity returns the number of blocks each producer has produced in the range of heights
+func (bc *blockchain) Productivity(startHeight uint64, endHeight uint64) (map[string]uint64, error) {
+	productivity := make(map[string]uint64)
+	for height := startHeight; height <= endHeight; height++ {
+		blk, err := bc.getBlockByHeight(height)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get block %d", height)
+		}
+		productivity[blk.ProducerAddress()]++
+	}
+	return productivity, nil
+}
+
 // TipHash returns tip block's hash
 func (bc *blockchain) TipHash() hash.Hash32B {
 	bc.mu.RLock()
@@ -712,9 +773,14 @@ func (bc *blockchain) MintNewBlock(
 		return nil, err
 	}
 
+	baseFee, err := bc.nextBaseFee()
+	if err != nil {
+		return nil, err
+	}
 	ra := block.NewRunnableActionsBuilder().
 		SetHeight(bc.tipHeight + 1).
 		SetTimeStamp(bc.now()).
+		SetBaseFee(baseFee).
 		AddActions(actions...).
 		Build(producer)
 
@@ -740,6 +806,10 @@ func (bc *blockchain) MintNewBlock(
 		blkbd.SetDKG(dkgAddress.ID, dkgAddress.PublicKey, sig)
 	}
 
+	if bc.forkSchedule.IsActive(genesis.LogsBloom, bc.tipHeight+1) {
+		blkbd.SetLogsBloom(block.CreateLogsBloom(rc))
+	}
+
 	blk, err := blkbd.SetStateRoot(root).
 		SetReceipts(rc).
 		SignAndBuild(producer)
@@ -760,9 +830,14 @@ func (bc *blockchain) MintNewSecretBlock(
 	bc.mu.RLock()
 	defer bc.mu.RUnlock()
 
+	baseFee, err := bc.nextBaseFee()
+	if err != nil {
+		return nil, err
+	}
 	ra := block.NewRunnableActionsBuilder().
 		SetHeight(bc.tipHeight + 1).
 		SetTimeStamp(bc.now()).
+		SetBaseFee(baseFee).
 		Build(producer)
 
 	// run execution and update state trie root hash
@@ -870,7 +945,15 @@ func (bc *blockchain) ExecuteContractRead(ex *action.Execution) (*action.Receipt
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain working set from state factory")
 	}
-	gasLimit := genesis.BlockGasLimit
+	enableGasCharge, err := bc.enableGasCharge(bc.sf, h)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := blocklimit.Load(bc.sf, h)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := limits.GasLimit
 	return evm.ExecuteContract(
 		blk.Height(),
 		blk.HashBlock(),
@@ -880,7 +963,74 @@ func (bc *blockchain) ExecuteContractRead(ex *action.Execution) (*action.Receipt
 		ex,
 		bc,
 		&gasLimit,
-		bc.config.Chain.EnableGasCharge,
+		enableGasCharge,
+		nil,
+		bc.forkSchedule,
+	)
+}
+
+// TraceExecution re-executes the execution in a block on top of the states of its parent block and the actions before
+// it in the block, and returns the receipt and the trace of it
+func (bc *blockchain) TraceExecution(h hash.Hash32B) (*action.Receipt, *evm.Trace, error) {
+	if !bc.config.Explorer.Enabled {
+		return nil, nil, errors.New("explorer not enabled")
+	}
+	if bc.sf == nil {
+		return nil, nil, errors.New("statefactory cannot be nil")
+	}
+	blkHash, err := bc.dao.getBlockHashByActionHash(h)
+	if err != nil {
+		return nil, nil, err
+	}
+	blk, err := bc.dao.getBlock(blkHash)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		exec  *action.Execution
+		index int
+	)
+	for i, selp := range blk.Actions {
+		if selp.Hash() == h {
+			var ok bool
+			if exec, ok = selp.Action().(*action.Execution); !ok {
+				return nil, nil, errors.Errorf("action %x is not an execution", h)
+			}
+			index = i
+			break
+		}
+	}
+	if exec == nil {
+		return nil, nil, errors.Errorf("block %x does not have execution %x", blkHash, h)
+	}
+	if blk.Height() == 0 {
+		return nil, nil, errors.Errorf("execution %x is in the genesis block, which has no parent states", h)
+	}
+	ws, err := bc.sf.NewWorkingSetAtHeight(blk.Height() - 1)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to obtain the states of block %d", blk.Height()-1)
+	}
+	// The limits of the block are read from the states the block was run on, rather than from the tip
+	raCtx, err := bc.runActionsCtx(ws, blk.RunnableActions())
+	if err != nil {
+		return nil, nil, err
+	}
+	ctx := protocol.WithRunActionsCtx(context.Background(), raCtx)
+	if _, _, err := ws.RunActions(ctx, blk.Height(), blk.Actions[:index]); err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to run the actions before execution %x", h)
+	}
+	return evm.TraceContract(
+		raCtx.BlockHeight,
+		raCtx.BlockHash,
+		raCtx.ProducerPubKey,
+		raCtx.BlockTimeStamp,
+		ws,
+		exec,
+		bc,
+		raCtx.GasLimit,
+		raCtx.EnableGasCharge,
+		raCtx.BaseFee,
+		raCtx.ForkSchedule,
 	)
 }
 
@@ -901,12 +1051,17 @@ func (bc *blockchain) CreateState(addr string, init *big.Int) (*state.Account, e
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get genesis block")
 	}
-	gasLimit := genesis.BlockGasLimit
+	limits, err := blocklimit.Load(ws, 0)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := limits.GasLimit
 	ctx := protocol.WithRunActionsCtx(context.Background(),
 		protocol.RunActionsCtx{
 			ProducerAddr:    genesisBlk.ProducerAddress(),
 			GasLimit:        &gasLimit,
 			EnableGasCharge: bc.config.Chain.EnableGasCharge,
+			ForkSchedule:    bc.forkSchedule,
 		})
 	if _, _, err = ws.RunActions(ctx, 0, nil); err != nil {
 		return nil, errors.Wrap(err, "failed to run the account creation")
@@ -947,11 +1102,22 @@ func (bc *blockchain) startEmptyBlockchain() error {
 	if ws, err = bc.sf.NewWorkingSet(); err != nil {
 		return errors.Wrap(err, "failed to obtain working set from state factory")
 	}
-	if bc.config.Chain.GenesisActionsPath != "" || !bc.config.Chain.EmptyGenesis {
-		acts := NewGenesisActions(bc.config.Chain, ws)
+	if bc.config.Chain.GenesisPath != "" || bc.config.Chain.GenesisActionsPath != "" || !bc.config.Chain.EmptyGenesis {
+		spec, err := GenesisSpec(bc.config.Chain)
+		if err != nil {
+			return errors.Wrap(err, "failed to load genesis spec")
+		}
+		parentHash, err := GenesisParentHash(bc.config.Chain, spec)
+		if err != nil {
+			return err
+		}
+		acts, err := newGenesisActions(spec, bc.config.Chain, ws)
+		if err != nil {
+			return errors.Wrap(err, "failed to create genesis actions")
+		}
 		racts := block.NewRunnableActionsBuilder().
 			SetHeight(0).
-			SetTimeStamp(Gen.Timestamp).
+			SetTimeStamp(spec.Timestamp).
 			AddActions(acts...).
 			Build(iaddr)
 		// run execution and update state trie root hash
@@ -962,7 +1128,7 @@ func (bc *blockchain) startEmptyBlockchain() error {
 
 		genesis, err = block.NewBuilder(racts).
 			SetChainID(bc.ChainID()).
-			SetPrevBlockHash(Gen.ParentHash).
+			SetPrevBlockHash(parentHash).
 			SetReceipts(receipts).
 			SetStateRoot(root).
 			SignAndBuild(iaddr)
@@ -990,6 +1156,31 @@ func (bc *blockchain) startEmptyBlockchain() error {
 	return nil
 }
 
+// verifyGenesis checks if the genesis block in the db is created from the genesis file
+func (bc *blockchain) verifyGenesis() error {
+	spec, err := GenesisSpec(bc.config.Chain)
+	if err != nil {
+		return errors.Wrap(err, "failed to load genesis spec")
+	}
+	parentHash, err := GenesisParentHash(bc.config.Chain, spec)
+	if err != nil {
+		return err
+	}
+	genesisBlk, err := bc.getBlockByHeight(0)
+	if err != nil {
+		return errors.Wrap(err, "failed to get genesis block")
+	}
+	if genesisBlk.PrevHash() != parentHash {
+		return errors.Wrapf(
+			genesis.ErrInvalidSpec,
+			"genesis block with previous hash %x isn't created from the genesis file with hash %x",
+			genesisBlk.PrevHash(),
+			parentHash,
+		)
+	}
+	return nil
+}
+
 func (bc *blockchain) startExistingBlockchain(recoveryHeight uint64) error {
 	if bc.sf == nil {
 		return errors.New("statefactory cannot be nil")
@@ -1005,18 +1196,30 @@ func (bc *blockchain) startExistingBlockchain(recoveryHeight uint64) error {
 	if err != nil {
 		return errors.Wrap(err, "failed to obtain working set from state factory")
 	}
+	if bc.config.Chain.GenesisPath != "" {
+		if err := bc.verifyGenesis(); err != nil {
+			return err
+		}
+	}
 	// If restarting factory from fresh db, first update state changes in Genesis block
 	if startHeight == 0 {
+		spec, err := GenesisSpec(bc.config.Chain)
+		if err != nil {
+			return errors.Wrap(err, "failed to load genesis spec")
+		}
 		addr := address.New(bc.ChainID(), keypair.ZeroPublicKey[:])
 		iaddr := &iotxaddress.Address{
 			PrivateKey: keypair.ZeroPrivateKey,
 			PublicKey:  keypair.ZeroPublicKey,
 			RawAddress: addr.IotxAddress(),
 		}
-		acts := NewGenesisActions(bc.config.Chain, ws)
+		acts, err := newGenesisActions(spec, bc.config.Chain, ws)
+		if err != nil {
+			return errors.Wrap(err, "failed to create genesis actions")
+		}
 		racts := block.NewRunnableActionsBuilder().
 			SetHeight(0).
-			SetTimeStamp(Gen.Timestamp).
+			SetTimeStamp(spec.Timestamp).
 			AddActions(acts...).
 			Build(iaddr)
 		// run execution and update state trie root hash
@@ -1069,13 +1272,16 @@ func (bc *blockchain) validateBlock(blk *block.Block, containCoinbase bool) erro
 	if err != nil {
 		return errors.Wrapf(err, "error when validating block %d", blk.Height())
 	}
+	if err := bc.verifyBaseFee(blk); err != nil {
+		return errors.Wrapf(err, "error when validating the base fee of block %d", blk.Height())
+	}
 	// run actions and update state factory
 	ws, err := bc.sf.NewWorkingSet()
 	if err != nil {
 		return errors.Wrap(err, "Failed to obtain working set from state factory")
 	}
 	runTimer := bc.timerFactory.NewTimer("runActions")
-	root, _, err := bc.runActions(blk.RunnableActions(), ws, true)
+	root, receipts, err := bc.runActions(blk.RunnableActions(), ws, true)
 	runTimer.End()
 	if err != nil {
 		log.L().Panic("Failed to update state.", zap.Uint64("tipHeight", bc.tipHeight), zap.Error(err))
@@ -1084,6 +1290,12 @@ func (bc *blockchain) validateBlock(blk *block.Block, containCoinbase bool) erro
 	if err = blk.VerifyStateRoot(root); err != nil {
 		return errors.Wrap(err, "Failed to verify state root")
 	}
+	if err = bc.verifyLogsBloom(blk, receipts); err != nil {
+		return errors.Wrapf(err, "error when validating the logs bloom of block %d", blk.Height())
+	}
+
+	// attach receipts to be stored along with the block
+	blk.Receipts = receipts
 
 	// attach working set to be committed to state factory
 	blk.WorkingSet = ws
@@ -1142,22 +1354,167 @@ func (bc *blockchain) runActions(acts block.RunnableActions, ws factory.WorkingS
 	if bc.sf == nil {
 		return hash.ZeroHash32B, nil, errors.New("statefactory cannot be nil")
 	}
-	gasLimit := genesis.BlockGasLimit
+	raCtx, err := bc.runActionsCtx(bc.sf, acts)
+	if err != nil {
+		return hash.ZeroHash32B, nil, err
+	}
 	// update state factory
-	ctx := protocol.WithRunActionsCtx(context.Background(),
-		protocol.RunActionsCtx{
-			BlockHeight:     acts.BlockHeight(),
-			BlockHash:       acts.TxHash(),
-			ProducerPubKey:  acts.BlockProducerPubKey(),
-			BlockTimeStamp:  int64(acts.BlockTimeStamp()),
-			ProducerAddr:    acts.BlockProducerAddr(),
-			GasLimit:        &gasLimit,
-			EnableGasCharge: bc.config.Chain.EnableGasCharge,
-		})
+	ctx := protocol.WithRunActionsCtx(context.Background(), raCtx)
 
 	return ws.RunActions(ctx, acts.BlockHeight(), acts.Actions())
 }
 
+// runActionsCtx returns the context of running the actions, with the governed parameters read from the state reader
+func (bc *blockchain) runActionsCtx(sr governance.StateReader, acts block.RunnableActions) (protocol.RunActionsCtx, error) {
+	limits, err := blocklimit.Load(sr, acts.BlockHeight())
+	if err != nil {
+		return protocol.RunActionsCtx{}, err
+	}
+	enableGasCharge, err := bc.enableGasCharge(sr, acts.BlockHeight())
+	if err != nil {
+		return protocol.RunActionsCtx{}, err
+	}
+	gasLimit := limits.GasLimit
+	return protocol.RunActionsCtx{
+		BlockHeight:     acts.BlockHeight(),
+		BlockHash:       acts.TxHash(),
+		ProducerPubKey:  acts.BlockProducerPubKey(),
+		BlockTimeStamp:  int64(acts.BlockTimeStamp()),
+		ProducerAddr:    acts.BlockProducerAddr(),
+		GasLimit:        &gasLimit,
+		EnableGasCharge: enableGasCharge,
+		BaseFee:         acts.BaseFee(),
+		ForkSchedule:    bc.forkSchedule,
+	}, nil
+}
+
+// nextBaseFee returns the base fee per gas of the block following the tip one, which is zero until the base fee is
+// active
+func (bc *blockchain) nextBaseFee() (*big.Int, error) {
+	if !bc.forkSchedule.IsActive(genesis.BaseFee, bc.tipHeight+1) {
+		return big.NewInt(0), nil
+	}
+	parent, err := bc.getBlockByHeight(bc.tipHeight)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get block %d", bc.tipHeight)
+	}
+	gasUsed, err := bc.gasUsed(parent)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := bc.BlockLimits()
+	if err != nil {
+		return nil, err
+	}
+	params, err := LoadBaseFeeParams(bc.sf, bc.tipHeight+1)
+	if err != nil {
+		return nil, err
+	}
+	return CalcBaseFee(parent.BaseFee(), gasUsed, limits.GasLimit, params), nil
+}
+
+// gasUsed returns the gas consumed by the actions in the block, which is the gas consumed in their receipts, or the
+// intrinsic gas for the actions not producing receipts. Coinbase transfers are not counted.
+func (bc *blockchain) gasUsed(blk *block.Block) (uint64, error) {
+	var gas uint64
+	for _, selp := range blk.Actions {
+		if tsf, ok := selp.Action().(*action.Transfer); ok && tsf.IsCoinbase() {
+			continue
+		}
+		receipt, ok := blk.Receipts[selp.Hash()]
+		if !ok {
+			r, err := bc.dao.getReceiptByActionHash(selp.Hash())
+			switch errors.Cause(err) {
+			case nil:
+				receipt, ok = r, true
+			case db.ErrNotExist, bolt.ErrBucketNotFound:
+			default:
+				return 0, err
+			}
+		}
+		if ok {
+			gas += receipt.GasConsumed
+			continue
+		}
+		intrinsicGas, err := selp.IntrinsicGas()
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to get intrinsic gas for action %x", selp.Hash())
+		}
+		gas += intrinsicGas
+	}
+	return gas, nil
+}
+
+// enableGasCharge returns whether the gas is charged at the height, which may be changed by governance since the
+// config
+func (bc *blockchain) enableGasCharge(sr governance.StateReader, height uint64) (bool, error) {
+	var defaultValue uint64
+	if bc.config.Chain.EnableGasCharge {
+		defaultValue = 1
+	}
+	if sr == nil {
+		return defaultValue == 1, nil
+	}
+	value, err := governance.Parameter(sr, governance.EnableGasCharge, height, defaultValue)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to get whether the gas is charged")
+	}
+	return value == 1, nil
+}
+
+// verifyLogsBloom checks the logs bloom of the block against the receipts of running its actions
+func (bc *blockchain) verifyLogsBloom(blk *block.Block, receipts map[hash.Hash32B]*action.Receipt) error {
+	if !bc.forkSchedule.IsActive(genesis.LogsBloom, blk.Height()) {
+		if blk.LogsBloom() != (block.Bloom{}) {
+			return errors.New("logs bloom is not active yet")
+		}
+		return nil
+	}
+	if blk.LogsBloom() != block.CreateLogsBloom(receipts) {
+		return errors.New("wrong logs bloom")
+	}
+	return nil
+}
+
+// verifyBaseFee checks the base fee of the block, and whether the gas prices of the actions cover it
+func (bc *blockchain) verifyBaseFee(blk *block.Block) error {
+	if !bc.forkSchedule.IsActive(genesis.BaseFee, blk.Height()) {
+		if blk.BaseFee().Sign() != 0 {
+			return errors.New("base fee is not active yet")
+		}
+		return nil
+	}
+	baseFee, err := bc.nextBaseFee()
+	if err != nil {
+		return err
+	}
+	if blk.BaseFee().Cmp(baseFee) != 0 {
+		return errors.Errorf("wrong base fee %s, expecting %s", blk.BaseFee(), baseFee)
+	}
+	enableGasCharge, err := bc.enableGasCharge(bc.sf, blk.Height())
+	if err != nil {
+		return err
+	}
+	if !enableGasCharge {
+		return nil
+	}
+	for _, selp := range blk.Actions {
+		if tsf, ok := selp.Action().(*action.Transfer); ok && tsf.IsCoinbase() {
+			continue
+		}
+		if selp.GasPrice().Cmp(baseFee) < 0 {
+			return errors.Wrapf(
+				action.ErrGasPriceLowerThanBaseFee,
+				"gas price %s of action %x is lower than base fee %s",
+				selp.GasPrice(),
+				selp.Hash(),
+				baseFee,
+			)
+		}
+	}
+	return nil
+}
+
 func (bc *blockchain) emitToSubscribers(blk *block.Block) {
 	if bc.blocklistener == nil {
 		return
@@ -8,6 +8,7 @@ package blockchain
 
 import (
 	"context"
+	"sort"
 
 	"github.com/pkg/errors"
 	bolt "go.etcd.io/bbolt"
@@ -38,6 +39,10 @@ const (
 	blockAddressExecutionCountMappingNS = "address<->executioncount"
 	blockAddressActionMappingNS         = "address<->action"
 	blockAddressActionCountMappingNS    = "address<->actioncount"
+	blockAddressLogMappingNS            = "address<->log"
+	blockAddressLogCountMappingNS       = "address<->logcount"
+	blockTopicLogMappingNS              = "topic<->log"
+	blockTopicLogCountMappingNS         = "topic<->logcount"
 )
 
 var (
@@ -56,12 +61,15 @@ var (
 	totalActionsKey     = []byte("total-actions")
 	transferFromPrefix  = []byte("transfer-from.")
 	transferToPrefix    = []byte("transfer-to.")
+	transferTagPrefix   = []byte("transfer-tag.")
 	voteFromPrefix      = []byte("vote-from.")
 	voteToPrefix        = []byte("vote-to.")
 	executionFromPrefix = []byte("execution-from")
 	executionToPrefix   = []byte("execution-to")
 	actionFromPrefix    = []byte("action-from")
 	actionToPrefix      = []byte("action-to")
+	logAddressPrefix    = []byte("log-address.")
+	logTopicPrefix      = []byte("log-topic.")
 )
 
 var _ lifecycle.StartStopper = (*blockDAO)(nil)
@@ -328,6 +336,28 @@ func (dao *blockDAO) getTransferCountByRecipientAddress(address string) (uint64,
 	return enc.MachineEndian.Uint64(value), nil
 }
 
+// getTransfersByMemoTag returns transfers with the given destination tag memo
+func (dao *blockDAO) getTransfersByMemoTag(tag string) ([]hash.Hash32B, error) {
+	tagTransferCount, err := dao.getTransferCountByMemoTag(tag)
+	if err != nil {
+		return nil, errors.Wrapf(err, "for memo tag %s", tag)
+	}
+	return dao.getTransfersByAddress(tag, tagTransferCount, transferTagPrefix)
+}
+
+// getTransferCountByMemoTag returns transfer count by destination tag memo
+func (dao *blockDAO) getTransferCountByMemoTag(tag string) (uint64, error) {
+	tagTransferCountKey := append(transferTagPrefix, tag...)
+	value, err := dao.kvstore.Get(blockAddressTransferCountMappingNS, tagTransferCountKey)
+	if err != nil {
+		return 0, nil
+	}
+	if len(value) == 0 {
+		return 0, errors.New("count of transfers with memo tag is broken")
+	}
+	return enc.MachineEndian.Uint64(value), nil
+}
+
 // TODO: To be deprecated
 // getVotesBySenderAddress returns votes for sender
 func (dao *blockDAO) getVotesBySenderAddress(address string) ([]hash.Hash32B, error) {
@@ -578,6 +608,82 @@ func (dao *blockDAO) getActionCountByRecipientAddress(address string) (uint64, e
 	return enc.MachineEndian.Uint64(value), nil
 }
 
+// getLogHeightsByAddress returns the heights of the blocks within [from, to] having logs emitted by the address
+func (dao *blockDAO) getLogHeightsByAddress(address string, from uint64, to uint64) ([]uint64, error) {
+	key := append(logAddressPrefix, address...)
+	return dao.getLogHeights(blockAddressLogMappingNS, blockAddressLogCountMappingNS, key, from, to)
+}
+
+// getLogHeightsByTopic returns the heights of the blocks within [from, to] having logs with the topic
+func (dao *blockDAO) getLogHeightsByTopic(topic hash.Hash32B, from uint64, to uint64) ([]uint64, error) {
+	key := append(logTopicPrefix, topic[:]...)
+	return dao.getLogHeights(blockTopicLogMappingNS, blockTopicLogCountMappingNS, key, from, to)
+}
+
+// getLogHeights returns the heights within [from, to] indexed under the key
+func (dao *blockDAO) getLogHeights(ns string, countNS string, key []byte, from uint64, to uint64) ([]uint64, error) {
+	count, err := dao.getLogCount(countNS, key)
+	if err != nil {
+		return nil, err
+	}
+	// The heights are indexed in ascending order, so that the first one within the range is binary searched
+	var searchErr error
+	start := sort.Search(int(count), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		height, err := dao.getLogHeight(ns, key, uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return height >= from
+	})
+	if searchErr != nil {
+		return nil, searchErr
+	}
+
+	var res []uint64
+	for i := uint64(start); i < count; i++ {
+		height, err := dao.getLogHeight(ns, key, i)
+		if err != nil {
+			return nil, err
+		}
+		if height > to {
+			break
+		}
+		res = append(res, height)
+	}
+	return res, nil
+}
+
+// getLogHeight returns the height indexed under the key at the index
+func (dao *blockDAO) getLogHeight(ns string, key []byte, index uint64) (uint64, error) {
+	value, err := dao.kvstore.Get(ns, logIndexKey(key, index))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get log height for index %d", index)
+	}
+	if len(value) == 0 {
+		return 0, errors.Wrapf(db.ErrNotExist, "log height for index %d missing", index)
+	}
+	return enc.MachineEndian.Uint64(value), nil
+}
+
+// getLogCount returns the number of the heights indexed under the key
+func (dao *blockDAO) getLogCount(countNS string, key []byte) (uint64, error) {
+	value, err := dao.kvstore.Get(countNS, key)
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist || errors.Cause(err) == bolt.ErrBucketNotFound {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, "failed to get count of logs")
+	}
+	if len(value) == 0 {
+		return 0, errors.New("count of logs is broken")
+	}
+	return enc.MachineEndian.Uint64(value), nil
+}
+
 // getBlockchainHeight returns the blockchain height
 func (dao *blockDAO) getBlockchainHeight() (uint64, error) {
 	value, err := dao.kvstore.Get(blockNS, topHeightKey)
@@ -792,6 +898,7 @@ func (dao *blockDAO) putBlock(blk *block.Block) error {
 func putTransfers(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) error {
 	senderDelta := map[string]uint64{}
 	recipientDelta := map[string]uint64{}
+	tagDelta := map[string]uint64{}
 
 	transfers, _, _ := action.ClassifyActions(blk.Actions)
 	for _, transfer := range transfers {
@@ -845,6 +952,30 @@ func putTransfers(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) error
 		batch.Put(blockAddressTransferCountMappingNS, recipientTransferCountKey,
 			byteutil.Uint64ToBytes(recipientTransferCount+1), "failed to bump transfer count %x for recipient %x",
 			transfer.Hash(), transfer.Recipient())
+
+		tag, ok := transfer.Memo().Tag()
+		if !ok {
+			continue
+		}
+		// get transfers count for memo tag
+		tagTransferCount, err := dao.getTransferCountByMemoTag(tag)
+		if err != nil {
+			return errors.Wrapf(err, "for memo tag %s", tag)
+		}
+		tagTransferCount += tagDelta[tag]
+		tagDelta[tag]++
+
+		// put new transfer to memo tag
+		tagKey := append(transferTagPrefix, tag...)
+		tagKey = append(tagKey, byteutil.Uint64ToBytes(tagTransferCount)...)
+		batch.Put(blockAddressTransferMappingNS, tagKey, transferHash[:],
+			"failed to put transfer hash %x for memo tag %s", transfer.Hash(), tag)
+
+		// update memo tag transfers count
+		tagTransferCountKey := append(transferTagPrefix, tag...)
+		batch.Put(blockAddressTransferCountMappingNS, tagTransferCountKey,
+			byteutil.Uint64ToBytes(tagTransferCount+1), "failed to bump transfer count %x for memo tag %s",
+			transfer.Hash(), tag)
 	}
 
 	return nil
@@ -1051,9 +1182,41 @@ func (dao *blockDAO) putReceipts(blk *block.Block) error {
 		batch.Put(blockExecutionReceiptMappingNS, r.Hash[:], v, "failed to put receipt for execution %x", r.Hash[:])
 		batch.Put(blockActionReceiptMappingNS, r.Hash[:], v, "failed to put receipt for action %x", r.Hash[:])
 	}
+	if dao.writeIndex {
+		if err := putLogs(dao, blk, batch); err != nil {
+			return err
+		}
+	}
 	return dao.kvstore.Commit(batch)
 }
 
+// putLogs indexes the height of the block by the addresses and the topics of the logs in its receipts
+func putLogs(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) error {
+	height := byteutil.Uint64ToBytes(blk.Height())
+	addressKeys, topicKeys := logKeys(blk.Receipts)
+	for key := range addressKeys {
+		count, err := dao.getLogCount(blockAddressLogCountMappingNS, []byte(key))
+		if err != nil {
+			return errors.Wrapf(err, "for log address key %x", key)
+		}
+		batch.Put(blockAddressLogMappingNS, logIndexKey([]byte(key), count), height,
+			"failed to put log height for address key %x", key)
+		batch.Put(blockAddressLogCountMappingNS, []byte(key), byteutil.Uint64ToBytes(count+1),
+			"failed to bump log count for address key %x", key)
+	}
+	for key := range topicKeys {
+		count, err := dao.getLogCount(blockTopicLogCountMappingNS, []byte(key))
+		if err != nil {
+			return errors.Wrapf(err, "for log topic key %x", key)
+		}
+		batch.Put(blockTopicLogMappingNS, logIndexKey([]byte(key), count), height,
+			"failed to put log height for topic key %x", key)
+		batch.Put(blockTopicLogCountMappingNS, []byte(key), byteutil.Uint64ToBytes(count+1),
+			"failed to bump log count for topic key %x", key)
+	}
+	return nil
+}
+
 // deleteBlock deletes the tip block
 func (dao *blockDAO) deleteTipBlock() error {
 	batch := db.NewBatch()
@@ -1189,6 +1352,24 @@ func (dao *blockDAO) deleteTipBlock() error {
 		return err
 	}
 
+	// The receipts are not serialized with the block, so that they are loaded to roll back their index
+	blk.Receipts = make(map[hash.Hash32B]*action.Receipt)
+	for _, selp := range blk.Actions {
+		actHash := selp.Hash()
+		receipt, err := dao.getReceiptByActionHash(actHash)
+		if err != nil {
+			if errors.Cause(err) == db.ErrNotExist || errors.Cause(err) == bolt.ErrBucketNotFound {
+				continue
+			}
+			return errors.Wrapf(err, "failed to get receipt of action %x", actHash)
+		}
+		blk.Receipts[actHash] = receipt
+	}
+
+	if err = deleteLogs(dao, blk, batch); err != nil {
+		return err
+	}
+
 	if err = deleteReceipts(blk, batch); err != nil {
 		return err
 	}
@@ -1203,9 +1384,13 @@ func deleteTransfers(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) err
 	// First get the total count of transfers by sender and recipient respectively in the block
 	senderCount := make(map[string]uint64)
 	recipientCount := make(map[string]uint64)
+	tagCount := make(map[string]uint64)
 	for _, transfer := range transfers {
 		senderCount[transfer.Sender()]++
 		recipientCount[transfer.Recipient()]++
+		if tag, ok := transfer.Memo().Tag(); ok {
+			tagCount[tag]++
+		}
 	}
 	// Roll back the status of address -> transferCount mapping to the previous block
 	for sender, count := range senderCount {
@@ -1229,9 +1414,20 @@ func deleteTransfers(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) err
 			byteutil.Uint64ToBytes(recipientCount[recipient]),
 			"failed to update transfer count for recipient %x", recipient)
 	}
+	for tag, count := range tagCount {
+		tagTransferCount, err := dao.getTransferCountByMemoTag(tag)
+		if err != nil {
+			return errors.Wrapf(err, "for memo tag %s", tag)
+		}
+		tagTransferCountKey := append(transferTagPrefix, tag...)
+		tagCount[tag] = tagTransferCount - count
+		batch.Put(blockAddressTransferCountMappingNS, tagTransferCountKey,
+			byteutil.Uint64ToBytes(tagCount[tag]), "failed to update transfer count for memo tag %s", tag)
+	}
 
 	senderDelta := map[string]uint64{}
 	recipientDelta := map[string]uint64{}
+	tagDelta := map[string]uint64{}
 
 	for _, transfer := range transfers {
 		transferHash := transfer.Hash()
@@ -1261,6 +1457,17 @@ func deleteTransfers(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) err
 		recipientKey = append(recipientKey, byteutil.Uint64ToBytes(recipientCount[transfer.Recipient()])...)
 		batch.Delete(blockAddressTransferMappingNS, recipientKey, "failed to delete transfer hash %x for recipient %x",
 			transferHash, transfer.Recipient())
+
+		tag, ok := transfer.Memo().Tag()
+		if !ok {
+			continue
+		}
+		// Delete new transfer from memo tag
+		tagKey := append(transferTagPrefix, tag...)
+		tagKey = append(tagKey, byteutil.Uint64ToBytes(tagCount[tag]+tagDelta[tag])...)
+		tagDelta[tag]++
+		batch.Delete(blockAddressTransferMappingNS, tagKey, "failed to delete transfer hash %x for memo tag %s",
+			transferHash, tag)
 	}
 
 	return nil
@@ -1417,6 +1624,60 @@ func deleteReceipts(blk *block.Block, batch db.KVStoreBatch) error {
 	return nil
 }
 
+// deleteLogs deletes the height of the tip block from the index of the logs in its receipts
+func deleteLogs(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) error {
+	addressKeys, topicKeys := logKeys(blk.Receipts)
+	for key := range addressKeys {
+		count, err := dao.getLogCount(blockAddressLogCountMappingNS, []byte(key))
+		if err != nil {
+			return errors.Wrapf(err, "for log address key %x", key)
+		}
+		if count == 0 {
+			continue
+		}
+		batch.Delete(blockAddressLogMappingNS, logIndexKey([]byte(key), count-1),
+			"failed to delete log height for address key %x", key)
+		batch.Put(blockAddressLogCountMappingNS, []byte(key), byteutil.Uint64ToBytes(count-1),
+			"failed to update log count for address key %x", key)
+	}
+	for key := range topicKeys {
+		count, err := dao.getLogCount(blockTopicLogCountMappingNS, []byte(key))
+		if err != nil {
+			return errors.Wrapf(err, "for log topic key %x", key)
+		}
+		if count == 0 {
+			continue
+		}
+		batch.Delete(blockTopicLogMappingNS, logIndexKey([]byte(key), count-1),
+			"failed to delete log height for topic key %x", key)
+		batch.Put(blockTopicLogCountMappingNS, []byte(key), byteutil.Uint64ToBytes(count-1),
+			"failed to update log count for topic key %x", key)
+	}
+	return nil
+}
+
+// logKeys returns the index keys of the distinct addresses and topics of the logs in the receipts
+func logKeys(receipts map[hash.Hash32B]*action.Receipt) (map[string]bool, map[string]bool) {
+	addressKeys := make(map[string]bool)
+	topicKeys := make(map[string]bool)
+	for _, r := range receipts {
+		for _, l := range r.Logs {
+			addressKeys[string(append(logAddressPrefix, l.Address...))] = true
+			for _, topic := range l.Topics {
+				topicKeys[string(append(logTopicPrefix, topic[:]...))] = true
+			}
+		}
+	}
+	return addressKeys, topicKeys
+}
+
+// logIndexKey returns the key of the height at the index under the key of an address or a topic
+func logIndexKey(key []byte, index uint64) []byte {
+	k := make([]byte, 0, len(key)+8)
+	k = append(k, key...)
+	return append(k, byteutil.Uint64ToBytes(index)...)
+}
+
 // deleteActions deletes action information from db
 func deleteActions(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) error {
 	// Firt get the total count of actions by sender and recipient respectively in the block
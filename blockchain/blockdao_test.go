@@ -702,3 +702,154 @@ func TestBlockDAO(t *testing.T) {
 		testDeleteDao(db.NewOnDiskDB(cfg), t)
 	})
 }
+
+func TestBlockDAO_MemoTag(t *testing.T) {
+	require := require.New(t)
+
+	newTaggedTransfer := func(nonce uint64, tag string) action.SealedEnvelope {
+		tsf, err := action.NewTransferWithMemo(
+			nonce,
+			big.NewInt(1),
+			testaddress.IotxAddrinfo["alfa"].RawAddress,
+			testaddress.IotxAddrinfo["bravo"].RawAddress,
+			nil,
+			&action.Memo{Type: action.TagMemo, Data: []byte(tag)},
+			testutil.TestGasLimit,
+			big.NewInt(0),
+		)
+		require.NoError(err)
+		bd := &action.EnvelopeBuilder{}
+		elp := bd.SetNonce(nonce).
+			SetDestinationAddress(testaddress.IotxAddrinfo["bravo"].RawAddress).
+			SetGasLimit(testutil.TestGasLimit).
+			SetAction(tsf).Build()
+		selp, err := action.Sign(elp, testaddress.IotxAddrinfo["alfa"].RawAddress, testaddress.IotxAddrinfo["alfa"].PrivateKey)
+		require.NoError(err)
+		return selp
+	}
+	tsf1 := newTaggedTransfer(1, "10086")
+	tsf2 := newTaggedTransfer(2, "10087")
+	tsf3 := newTaggedTransfer(3, "10086")
+	tsf4 := newTaggedTransfer(4, "10086")
+
+	blk1, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetTimeStamp(testutil.TimestampNow()).
+		AddActions(tsf1, tsf2).
+		SignAndBuild(testaddress.IotxAddrinfo["producer"])
+	require.NoError(err)
+	blk2, err := block.NewTestingBuilder().
+		SetHeight(2).
+		SetPrevBlockHash(blk1.HashBlock()).
+		SetTimeStamp(testutil.TimestampNow()).
+		AddActions(tsf3, tsf4).
+		SignAndBuild(testaddress.IotxAddrinfo["producer"])
+	require.NoError(err)
+
+	ctx := context.Background()
+	dao := newBlockDAO(db.NewMemKVStore(), true)
+	require.NoError(dao.Start(ctx))
+	defer func() {
+		require.NoError(dao.Stop(ctx))
+	}()
+	require.NoError(dao.putBlock(&blk1))
+	require.NoError(dao.putBlock(&blk2))
+
+	transfers, err := dao.getTransfersByMemoTag("10086")
+	require.NoError(err)
+	require.Equal([]hash.Hash32B{tsf1.Hash(), tsf3.Hash(), tsf4.Hash()}, transfers)
+	transfers, err = dao.getTransfersByMemoTag("10087")
+	require.NoError(err)
+	require.Equal([]hash.Hash32B{tsf2.Hash()}, transfers)
+	transfers, err = dao.getTransfersByMemoTag("10088")
+	require.NoError(err)
+	require.Equal(0, len(transfers))
+
+	require.NoError(dao.deleteTipBlock())
+	transfers, err = dao.getTransfersByMemoTag("10086")
+	require.NoError(err)
+	require.Equal([]hash.Hash32B{tsf1.Hash()}, transfers)
+}
+
+func TestBlockDAO_Logs(t *testing.T) {
+	require := require.New(t)
+
+	topic1 := hash.Hash256b([]byte("topic1"))
+	topic2 := hash.Hash256b([]byte("topic2"))
+	newBlockWithLogs := func(height uint64, logs ...*action.Log) *block.Block {
+		tsf, err := action.NewTransfer(
+			height,
+			big.NewInt(1),
+			testaddress.IotxAddrinfo["alfa"].RawAddress,
+			testaddress.IotxAddrinfo["bravo"].RawAddress,
+			nil,
+			testutil.TestGasLimit,
+			big.NewInt(0),
+		)
+		require.NoError(err)
+		bd := &action.EnvelopeBuilder{}
+		elp := bd.SetNonce(height).
+			SetDestinationAddress(testaddress.IotxAddrinfo["bravo"].RawAddress).
+			SetGasLimit(testutil.TestGasLimit).
+			SetAction(tsf).Build()
+		selp, err := action.Sign(elp, testaddress.IotxAddrinfo["alfa"].RawAddress, testaddress.IotxAddrinfo["alfa"].PrivateKey)
+		require.NoError(err)
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetTimeStamp(testutil.TimestampNow()).
+			AddActions(selp).
+			SignAndBuild(testaddress.IotxAddrinfo["producer"])
+		require.NoError(err)
+		blk.Receipts = map[hash.Hash32B]*action.Receipt{
+			selp.Hash(): {Hash: selp.Hash(), Logs: logs},
+		}
+		return &blk
+	}
+
+	ctx := context.Background()
+	dao := newBlockDAO(db.NewMemKVStore(), true)
+	require.NoError(dao.Start(ctx))
+	defer func() {
+		require.NoError(dao.Stop(ctx))
+	}()
+	for _, blk := range []*block.Block{
+		newBlockWithLogs(1, &action.Log{Address: "io1a", Topics: []hash.Hash32B{topic1}}),
+		newBlockWithLogs(
+			2,
+			&action.Log{Address: "io1a", Topics: []hash.Hash32B{topic1}},
+			&action.Log{Address: "io1a", Topics: []hash.Hash32B{topic1}},
+			&action.Log{Address: "io1b", Topics: []hash.Hash32B{topic2}},
+		),
+		newBlockWithLogs(3, &action.Log{Address: "io1a", Topics: []hash.Hash32B{topic2}}),
+	} {
+		require.NoError(dao.putBlock(blk))
+		require.NoError(dao.putReceipts(blk))
+	}
+
+	heights, err := dao.getLogHeightsByAddress("io1a", 1, 3)
+	require.NoError(err)
+	require.Equal([]uint64{1, 2, 3}, heights)
+	heights, err = dao.getLogHeightsByAddress("io1a", 2, 10)
+	require.NoError(err)
+	require.Equal([]uint64{2, 3}, heights)
+	heights, err = dao.getLogHeightsByAddress("io1a", 0, 1)
+	require.NoError(err)
+	require.Equal([]uint64{1}, heights)
+	heights, err = dao.getLogHeightsByAddress("io1b", 1, 3)
+	require.NoError(err)
+	require.Equal([]uint64{2}, heights)
+	heights, err = dao.getLogHeightsByAddress("io1c", 1, 3)
+	require.NoError(err)
+	require.Empty(heights)
+	heights, err = dao.getLogHeightsByTopic(topic2, 1, 3)
+	require.NoError(err)
+	require.Equal([]uint64{2, 3}, heights)
+
+	require.NoError(dao.deleteTipBlock())
+	heights, err = dao.getLogHeightsByAddress("io1a", 1, 3)
+	require.NoError(err)
+	require.Equal([]uint64{1, 2}, heights)
+	heights, err = dao.getLogHeightsByTopic(topic2, 1, 3)
+	require.NoError(err)
+	require.Equal([]uint64{2}, heights)
+}
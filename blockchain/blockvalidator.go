@@ -17,8 +17,10 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/blocklimit"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/iotxaddress"
 	"github.com/iotexproject/iotex-core/pkg/hash"
@@ -44,13 +46,23 @@ type Validator interface {
 	// AddActionValidators add validators
 	AddActionValidators(...protocol.ActionValidator)
 	AddActionEnvelopeValidators(...protocol.ActionEnvelopeValidator)
+	// SetCommitVerifier sets the verifier of the aggregated commit endorsements in block footers
+	SetCommitVerifier(CommitVerifier)
+}
+
+// CommitVerifier verifies the aggregated commit endorsements carried by the footer of a block, which depends on the
+// delegates and their DKG public keys known by the consensus
+type CommitVerifier interface {
+	VerifyAggregatedCommits(blk *block.Block) error
 }
 
 type validator struct {
 	sf                       factory.Factory
 	validatorAddr            string
+	forkSchedule             genesis.ForkSchedule
 	actionEnvelopeValidators []protocol.ActionEnvelopeValidator
 	actionValidators         []protocol.ActionValidator
+	commitVerifier           CommitVerifier
 }
 
 var (
@@ -68,6 +80,8 @@ var (
 	ErrBalance = errors.New("invalid balance")
 	// ErrDKGSecretProposal indicates the error of DKG secret proposal
 	ErrDKGSecretProposal = errors.New("invalid DKG secret proposal")
+	// ErrInvalidFooter indicates the error of block footer
+	ErrInvalidFooter = errors.New("invalid block footer")
 )
 
 // Validate validates the given block's content
@@ -78,6 +92,9 @@ func (v *validator) Validate(blk *block.Block, tipHeight uint64, tipHash hash.Ha
 	if err := verifySigAndRoot(blk); err != nil {
 		return errors.Wrap(err, "failed to verify block's signature and merkle root")
 	}
+	if err := v.verifyFooter(blk); err != nil {
+		return errors.Wrap(err, "failed to verify block's footer")
+	}
 
 	if v.sf != nil {
 		return v.ValidateActionsOnly(
@@ -94,6 +111,11 @@ func (v *validator) Validate(blk *block.Block, tipHeight uint64, tipHash hash.Ha
 	return nil
 }
 
+// SetCommitVerifier sets the verifier of the aggregated commit endorsements in block footers
+func (v *validator) SetCommitVerifier(verifier CommitVerifier) {
+	v.commitVerifier = verifier
+}
+
 // AddActionValidators add validators
 func (v *validator) AddActionValidators(validators ...protocol.ActionValidator) {
 	v.actionValidators = append(v.actionValidators, validators...)
@@ -113,6 +135,15 @@ func (v *validator) ValidateActionsOnly(
 	chainID uint32,
 	height uint64,
 ) error {
+	if v.sf != nil {
+		limits, err := blocklimit.Load(v.sf, height)
+		if err != nil {
+			return err
+		}
+		if err := limits.Verify(actions); err != nil {
+			return errors.Wrap(err, "failed to verify block limits")
+		}
+	}
 	// Verify transfers, votes, executions, witness, and secrets
 	confirmedNonceMap := make(map[string]uint64)
 	accountNonceMap := &sync.Map{}
@@ -146,6 +177,7 @@ func (v *validator) ValidateActionsOnly(
 				NonceTracker: accountNonceMap,
 				BlockHeight:  height,
 				ProducerAddr: producerAddr.IotxAddress(),
+				ForkSchedule: v.forkSchedule,
 			})
 
 		for _, validator := range v.actionEnvelopeValidators {
@@ -166,7 +198,7 @@ func (v *validator) ValidateActionsOnly(
 			expectedVerifications++
 			go func(validator protocol.ActionValidator, act action.Action, counter *uint64) {
 				defer wg.Done()
-				if err := validator.Validate(ctx, act); err != nil {
+				if err := protocol.ValidateAction(ctx, validator, act); err != nil {
 					errChan <- err
 					return
 				}
@@ -322,3 +354,19 @@ func verifySigAndRoot(blk *block.Block) error {
 	}
 	return nil
 }
+
+// verifyFooter verifies the aggregated commit endorsements of the block if the footer carries them. A footer which
+// cannot be verified without a commit verifier is rejected.
+func (v *validator) verifyFooter(blk *block.Block) error {
+	if blk.Footer == nil || blk.Footer.Endorsements() == nil || !blk.Footer.Endorsements().IsAggregated() {
+		return nil
+	}
+	blkHash := blk.HashBlock()
+	if !bytes.Equal(blk.Footer.Endorsements().BlockHash(), blkHash[:]) {
+		return errors.Wrap(ErrInvalidFooter, "the endorsements are not of the block")
+	}
+	if v.commitVerifier == nil {
+		return errors.Wrap(ErrInvalidFooter, "no verifier of the aggregated commit endorsements")
+	}
+	return v.commitVerifier.VerifyAggregatedCommits(blk)
+}
@@ -18,7 +18,9 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/blocklimit"
 	"github.com/iotexproject/iotex-core/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
 	"github.com/iotexproject/iotex-core/action/protocol/vote"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/blockchain/block"
@@ -95,7 +97,8 @@ func TestWrongNonce(t *testing.T) {
 	require.NoError(addCreatorToFactory(sf))
 
 	// Create a blockchain from scratch
-	bc := NewBlockchain(cfg, PrecreatedStateFactoryOption(sf), BoltDBDaoOption())
+	bc, err := NewBlockchain(cfg, PrecreatedStateFactoryOption(sf), BoltDBDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(context.Background()))
 
 	val := &validator{sf: sf, validatorAddr: ""}
@@ -258,7 +261,8 @@ func TestWrongCoinbaseTsf(t *testing.T) {
 	require.NoError(addCreatorToFactory(sf))
 
 	// Create a blockchain from scratch
-	bc := NewBlockchain(cfg, PrecreatedStateFactoryOption(sf), BoltDBDaoOption())
+	bc, err := NewBlockchain(cfg, PrecreatedStateFactoryOption(sf), BoltDBDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(context.Background()))
 
 	val := &validator{sf: sf, validatorAddr: ""}
@@ -327,7 +331,8 @@ func TestWrongCoinbaseTsf(t *testing.T) {
 func TestWrongAddress(t *testing.T) {
 	ctx := context.Background()
 	cfg := config.Default
-	bc := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption())
+	bc, err := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption())
+	require.NoError(t, err)
 	bc.GetFactory().AddActionHandlers(account.NewProtocol(), vote.NewProtocol(bc))
 	require.NoError(t, bc.Start(ctx))
 	require.NotNil(t, bc)
@@ -434,7 +439,8 @@ func TestCoinbaseTransferValidation(t *testing.T) {
 	ctx := context.Background()
 	cfg := config.Default
 	cfg.Chain.ID = 1
-	chain := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption())
+	chain, err := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption())
+	require.NoError(t, err)
 	require.NotNil(t, chain)
 	require.NoError(t, chain.Start(ctx))
 	defer require.NoError(t, chain.Stop(ctx))
@@ -535,3 +541,86 @@ func TestValidateSecretBlock(t *testing.T) {
 	require.Error(err)
 	require.Equal(ErrDKGSecretProposal, errors.Cause(err))
 }
+
+func TestBlockLimits(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	bc, err := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption())
+	require.NoError(err)
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	sf := bc.GetFactory()
+
+	limits, err := bc.BlockLimits()
+	require.NoError(err)
+	require.Equal(blocklimit.DefaultLimits(), limits)
+
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	require.NoError(ws.PutState(governance.GenesisParametersKey, governance.Parameters{
+		governance.BlockGasLimit:  150000,
+		governance.BlockSizeLimit: 1024,
+	}))
+	require.NoError(sf.Commit(ws))
+	limits, err = bc.BlockLimits()
+	require.NoError(err)
+	require.Equal(blocklimit.Limits{GasLimit: 150000, SizeLimit: 1024}, limits)
+
+	val := &validator{sf: sf, validatorAddr: ""}
+	tsf1, err := testutil.SignedTransfer(ta.IotxAddrinfo["producer"], ta.IotxAddrinfo["alfa"], 1, big.NewInt(20), []byte{}, 100000, big.NewInt(10))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(ta.IotxAddrinfo["producer"], ta.IotxAddrinfo["alfa"], 2, big.NewInt(20), []byte{}, 100000, big.NewInt(10))
+	require.NoError(err)
+	blkhash := tsf1.Hash()
+	blk, err := block.NewTestingBuilder().
+		SetChainID(1).
+		SetHeight(3).
+		SetPrevBlockHash(blkhash).
+		SetTimeStamp(testutil.TimestampNow()).
+		AddActions(tsf1, tsf2).
+		SignAndBuild(ta.IotxAddrinfo["producer"])
+	require.NoError(err)
+	err = val.Validate(&blk, 2, blkhash, false)
+	require.Error(err)
+	require.Equal(blocklimit.ErrBlockLimits, errors.Cause(err))
+}
+
+type forkGatedValidator struct{}
+
+var errForkActive = errors.New("feature is active")
+
+func (forkGatedValidator) Validate(ctx context.Context, _ action.Action) error {
+	vaCtx, ok := protocol.GetValidateActionsCtx(ctx)
+	if !ok {
+		return errors.New("failed to get validate actions context")
+	}
+	if vaCtx.IsActive("feature") {
+		return errForkActive
+	}
+	return nil
+}
+
+func TestForkSchedule(t *testing.T) {
+	require := require.New(t)
+
+	val := &validator{forkSchedule: genesis.ForkSchedule{"feature": 3}}
+	val.AddActionValidators(forkGatedValidator{})
+
+	coinbaseTsf := action.NewCoinBaseTransfer(1, Gen.BlockReward, ta.IotxAddrinfo["producer"].RawAddress)
+	bd := action.EnvelopeBuilder{}
+	elp := bd.SetNonce(1).
+		SetDestinationAddress(ta.IotxAddrinfo["producer"].RawAddress).
+		SetGasLimit(genesis.ActionGasLimit).
+		SetAction(coinbaseTsf).Build()
+	cb, err := action.Sign(elp, ta.IotxAddrinfo["producer"].RawAddress, ta.IotxAddrinfo["producer"].PrivateKey)
+	require.NoError(err)
+
+	pk := ta.IotxAddrinfo["producer"].PublicKey
+	require.NoError(val.ValidateActionsOnly([]action.SealedEnvelope{cb}, true, nil, nil, pk, 1, 2))
+	err = val.ValidateActionsOnly([]action.SealedEnvelope{cb}, true, nil, nil, pk, 1, 3)
+	require.Error(err)
+	require.Equal(errForkActive, errors.Cause(err))
+}
@@ -7,16 +7,24 @@
 package blockchain
 
 import (
+	"context"
+	"encoding/hex"
 	"io/ioutil"
 	"math/big"
 
+	"github.com/pkg/errors"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v2"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/action/protocol/vote"
 	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/iotxaddress"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/keypair"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -93,35 +101,112 @@ func (g *Genesis) CreatorPKHash() hash.PKHash {
 	return keypair.HashPubKey(pk)
 }
 
+// SystemContractAddr returns the predetermined address of the system contract of the name on a particular chain
+func SystemContractAddr(chainID uint32, name string) string {
+	return address.New(chainID, hash.Hash160b([]byte("system.contract."+name))).IotxAddress()
+}
+
+// GenesisSpec returns the spec of the genesis block, which is loaded from the genesis file if it's configured, or
+// converted from the genesis actions otherwise
+func GenesisSpec(chainCfg config.Chain) (*genesis.Spec, error) {
+	if chainCfg.GenesisPath == "" {
+		return legacyGenesisSpec(chainCfg), nil
+	}
+	spec, err := genesis.LoadSpec(chainCfg.GenesisPath)
+	if err != nil {
+		return nil, err
+	}
+	if spec.ChainID != chainCfg.ID {
+		return nil, errors.Wrapf(
+			genesis.ErrInvalidSpec,
+			"chain ID %d of genesis file doesn't match %d in config",
+			spec.ChainID,
+			chainCfg.ID,
+		)
+	}
+	return spec, nil
+}
+
+// GenesisParentHash returns the previous block hash of the genesis block. The hash of the spec loaded from the genesis
+// file is committed there, so that the nodes set up from different genesis files don't accept the blocks of each
+// other.
+func GenesisParentHash(chainCfg config.Chain, spec *genesis.Spec) (hash.Hash32B, error) {
+	if chainCfg.GenesisPath == "" {
+		return Gen.ParentHash, nil
+	}
+	return spec.Hash()
+}
+
 // NewGenesisActions creates a new genesis block
 func NewGenesisActions(chainCfg config.Chain, ws factory.WorkingSet) []action.SealedEnvelope {
-	actions := loadGenesisData(chainCfg)
-	// add initial allocation
-	alloc := big.NewInt(0)
-	for _, transfer := range actions.Transfers {
-		rpk, _ := decodeKey(transfer.RecipientPK, "")
-		recipientAddr := generateAddr(chainCfg.ID, rpk)
-		amount := ConvertIotxToRau(transfer.Amount)
-		_, err := account.LoadOrCreateAccount(ws, recipientAddr, amount)
+	spec, err := GenesisSpec(chainCfg)
+	if err != nil {
+		log.L().Panic("Failed to load genesis spec.", zap.Error(err))
+	}
+	acts, err := newGenesisActions(spec, chainCfg, ws)
+	if err != nil {
+		log.L().Panic("Failed to create genesis actions.", zap.Error(err))
+	}
+	return acts
+}
+
+// newGenesisActions puts the balances, the contracts and the parameters of the spec into the working set, and creates
+// the actions nominating the delegates, registering the candidates and starting the sub-chains
+func newGenesisActions(spec *genesis.Spec, chainCfg config.Chain, ws factory.WorkingSet) ([]action.SealedEnvelope, error) {
+	Gen.CreatorPubKey = spec.CreatorPubKey
+	creatorAddr := Gen.CreatorAddr(chainCfg.ID)
+	creatorPK, _ := decodeKey(spec.CreatorPubKey, "")
+
+	// add initial balances
+	funded := make(map[string]bool)
+	for _, b := range spec.Balances {
+		if funded[b.Address] {
+			return nil, errors.Wrapf(genesis.ErrInvalidSpec, "duplicate balance of %s", b.Address)
+		}
+		funded[b.Address] = true
+		amount, err := genesis.ParseAmount(b.Amount)
 		if err != nil {
-			log.L().Panic("Failed to add initial allocation.", zap.Error(err))
+			return nil, err
+		}
+		if _, err := account.LoadOrCreateAccount(ws, b.Address, amount); err != nil {
+			return nil, errors.Wrapf(err, "failed to add initial balance of %s", b.Address)
 		}
-		alloc.Add(alloc, amount)
 	}
-	// add creator
-	Gen.CreatorPubKey = actions.Creation.PubKey
-	creatorAddr := Gen.CreatorAddr(chainCfg.ID)
-	_, err := account.LoadOrCreateAccount(ws, creatorAddr, alloc.Sub(Gen.TotalSupply, alloc))
-	if err != nil {
-		log.L().Panic("Failed to add creator.", zap.Error(err))
+	// deploy contracts
+	for _, c := range spec.Contracts {
+		contractAddr := c.Address
+		if contractAddr == "" {
+			contractAddr = SystemContractAddr(chainCfg.ID, c.Name)
+		}
+		addr, err := iotxaddress.AddressToPKHash(contractAddr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid address of contract %s", contractAddr)
+		}
+		code, err := hex.DecodeString(c.Code)
+		if err != nil {
+			return nil, errors.Wrapf(genesis.ErrInvalidSpec, "invalid code of contract %s", contractAddr)
+		}
+		storage, err := c.DecodeStorage()
+		if err != nil {
+			return nil, err
+		}
+		if err := evm.DeployContract(ws, addr, code, storage); err != nil {
+			return nil, errors.Wrapf(err, "failed to deploy contract %s", contractAddr)
+		}
+	}
+	// set governable parameters
+	if len(spec.Parameters) > 0 {
+		if err := governance.SetGenesisParameters(ws, spec.Parameters); err != nil {
+			return nil, err
+		}
 	}
 
 	// TODO: convert vote to state operation as well
 	acts := make([]action.SealedEnvelope, 0)
-	for _, nominator := range actions.SelfNominators {
-		pk, _ := decodeKey(nominator.PubKey, "")
+	for _, delegate := range spec.Delegates {
+		pk, _ := decodeKey(delegate, "")
 		address := generateAddr(chainCfg.ID, pk)
-		vote, err := action.NewVote(
+		nomination, err := action.NewVote(
 			0,
 			address,
 			address,
@@ -129,24 +214,70 @@ func NewGenesisActions(chainCfg config.Chain, ws factory.WorkingSet) []action.Se
 			big.NewInt(0),
 		)
 		if err != nil {
-			log.L().Panic("Fail to create the new vote action.", zap.Error(err))
+			return nil, errors.Wrap(err, "failed to create the new vote action")
 		}
 		bd := action.EnvelopeBuilder{}
 		elp := bd.SetDestinationAddress(address).
-			SetAction(vote).Build()
+			SetAction(nomination).Build()
+		selp := action.FakeSeal(elp, address, pk)
+		acts = append(acts, selp)
+	}
+
+	// The self-stakes are locked from the initial balances by the vote protocol
+	minSelfStake, err := vote.MinSelfStake(ws, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range spec.Candidates {
+		pk, _ := decodeKey(c.PubKey, "")
+		address := generateAddr(chainCfg.ID, pk)
+		selfStake, err := genesis.ParseAmount(c.SelfStake)
+		if err != nil {
+			return nil, err
+		}
+		if selfStake.Cmp(minSelfStake) < 0 {
+			return nil, errors.Wrapf(
+				genesis.ErrInvalidSpec,
+				"self-stake of genesis candidate %s is less than %s",
+				c.Name,
+				minSelfStake,
+			)
+		}
+		rc := action.NewRegisterCandidate(
+			0,
+			address,
+			action.NewCandidateMetadata(c.Name, c.OperatorAddress, c.RewardAddress, pk),
+			selfStake,
+			action.RegisterCandidateIntrinsicGas,
+			big.NewInt(0),
+		)
+		if err := vote.NewProtocol(nil).Validate(context.Background(), rc); err != nil {
+			return nil, errors.Wrapf(err, "invalid genesis candidate %s", c.Name)
+		}
+		bd := action.EnvelopeBuilder{}
+		elp := bd.SetGasLimit(action.RegisterCandidateIntrinsicGas).
+			SetAction(rc).Build()
 		selp := action.FakeSeal(elp, address, pk)
 		acts = append(acts, selp)
 	}
 
 	// TODO: decouple start sub-chain from genesis block
 	if chainCfg.EnableSubChainStartInGenesis {
-		for _, sc := range actions.SubChains {
+		for _, sc := range spec.SubChains {
+			securityDeposit, err := genesis.ParseAmount(sc.SecurityDeposit)
+			if err != nil {
+				return nil, err
+			}
+			operationDeposit, err := genesis.ParseAmount(sc.OperationDeposit)
+			if err != nil {
+				return nil, err
+			}
 			start := action.NewStartSubChain(
 				0,
 				sc.ChainID,
 				creatorAddr,
-				ConvertIotxToRau(sc.SecurityDeposit),
-				ConvertIotxToRau(sc.OperationDeposit),
+				securityDeposit,
+				operationDeposit,
 				sc.StartHeight,
 				sc.ParentHeightOffset,
 				0,
@@ -154,13 +285,59 @@ func NewGenesisActions(chainCfg config.Chain, ws factory.WorkingSet) []action.Se
 			)
 			bd := action.EnvelopeBuilder{}
 			elp := bd.SetAction(start).Build()
-			pk, _ := decodeKey(Gen.CreatorPubKey, "")
-			selp := action.FakeSeal(elp, creatorAddr, pk)
+			selp := action.FakeSeal(elp, creatorAddr, creatorPK)
 			acts = append(acts, selp)
 		}
 	}
 
-	return acts
+	return acts, nil
+}
+
+// legacyGenesisSpec converts the genesis actions to the genesis spec, where the creator is allocated the rest of the
+// total supply. An address funded more than once, including the creator, only keeps its first balance.
+func legacyGenesisSpec(chainCfg config.Chain) *genesis.Spec {
+	actions := loadGenesisData(chainCfg)
+	spec := &genesis.Spec{
+		ChainID:       chainCfg.ID,
+		Timestamp:     Gen.Timestamp,
+		CreatorPubKey: actions.Creation.PubKey,
+	}
+	funded := make(map[string]bool)
+	alloc := big.NewInt(0)
+	for _, transfer := range actions.Transfers {
+		rpk, _ := decodeKey(transfer.RecipientPK, "")
+		addr := generateAddr(chainCfg.ID, rpk)
+		if funded[addr] {
+			continue
+		}
+		funded[addr] = true
+		amount := ConvertIotxToRau(transfer.Amount)
+		spec.Balances = append(spec.Balances, genesis.Balance{
+			Address: addr,
+			Amount:  amount.String(),
+		})
+		alloc.Add(alloc, amount)
+	}
+	cpk, _ := decodeKey(actions.Creation.PubKey, "")
+	if creatorAddr := generateAddr(chainCfg.ID, cpk); !funded[creatorAddr] {
+		spec.Balances = append(spec.Balances, genesis.Balance{
+			Address: creatorAddr,
+			Amount:  alloc.Sub(Gen.TotalSupply, alloc).String(),
+		})
+	}
+	for _, nominator := range actions.SelfNominators {
+		spec.Delegates = append(spec.Delegates, nominator.PubKey)
+	}
+	for _, sc := range actions.SubChains {
+		spec.SubChains = append(spec.SubChains, genesis.SubChain{
+			ChainID:            sc.ChainID,
+			SecurityDeposit:    ConvertIotxToRau(sc.SecurityDeposit).String(),
+			OperationDeposit:   ConvertIotxToRau(sc.OperationDeposit).String(),
+			StartHeight:        sc.StartHeight,
+			ParentHeightOffset: sc.ParentHeightOffset,
+		})
+	}
+	return spec
 }
 
 // decodeKey decodes the string keypair
@@ -0,0 +1,47 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package genesis
+
+const (
+	// LogsBloom is the feature of the bloom filter of the logs in the block header
+	LogsBloom = "logsBloom"
+	// BaseFee is the feature of the base fee per gas burned by the actions in a block
+	BaseFee = "baseFee"
+	// VoteeWeightSync is the feature of keeping the voting weights of the votees in sync with all the balance changes
+	// of their voters, including the gas, the contract calls and the cross-chain deposits
+	VoteeWeightSync = "voteeWeightSync"
+	// Batch is the feature of the batch action executing several payloads atomically
+	Batch = "batch"
+	// TimeLock is the feature of the time-locked transfers and their claims
+	TimeLock = "timeLock"
+	// TransferMemo is the feature of the typed memos attached to the transfers
+	TransferMemo = "transferMemo"
+	// Reward is the feature of the reward claims
+	Reward = "reward"
+	// Staking is the feature of the stake bucket actions
+	Staking = "staking"
+	// CandidateRegistration is the feature of the candidate registration, update and unregistration actions
+	CandidateRegistration = "candidateRegistration"
+	// Governance is the feature of the proposal submissions and votes
+	Governance = "governance"
+)
+
+// ForkSchedule maps the features changing the protocol behavior to the heights since which they are active. A feature
+// which is not scheduled is never active, so that a node could ship the new behavior before the height is agreed on.
+type ForkSchedule map[string]uint64
+
+// IsActive returns true if the feature is active at the height
+func (fs ForkSchedule) IsActive(feature string, height uint64) bool {
+	activationHeight, ok := fs[feature]
+	return ok && height >= activationHeight
+}
+
+// ActivationHeight returns the height since which the feature is active, and false if it's not scheduled
+func (fs ForkSchedule) ActivationHeight(feature string) (uint64, bool) {
+	activationHeight, ok := fs[feature]
+	return activationHeight, ok
+}
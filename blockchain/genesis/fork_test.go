@@ -0,0 +1,32 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package genesis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestForkSchedule(t *testing.T) {
+	require := require.New(t)
+
+	fs := ForkSchedule{"feature": 10, "genesisFeature": 0}
+	require.False(fs.IsActive("feature", 9))
+	require.True(fs.IsActive("feature", 10))
+	require.True(fs.IsActive("feature", 11))
+	require.True(fs.IsActive("genesisFeature", 0))
+	require.False(fs.IsActive("unscheduled", 100))
+	height, ok := fs.ActivationHeight("feature")
+	require.True(ok)
+	require.Equal(uint64(10), height)
+	_, ok = fs.ActivationHeight("unscheduled")
+	require.False(ok)
+
+	var empty ForkSchedule
+	require.False(empty.IsActive("feature", 100))
+}
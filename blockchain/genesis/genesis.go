@@ -11,4 +11,24 @@ const (
 	BlockGasLimit = uint64(200000000)
 	// ActionGasLimit is the per action gas limit cap
 	ActionGasLimit = BlockGasLimit / 10
+	// BlockSizeLimit is the total size in bytes of the actions could be included in a block
+	BlockSizeLimit = uint64(8 * 1024 * 1024)
+	// ElasticityMultiplier is the ratio of the block gas limit to the gas target, at which the base fee is unchanged
+	ElasticityMultiplier = uint64(2)
+	// BaseFeeChangeDenominator bounds the change of the base fee from the parent block to 1/8
+	BaseFeeChangeDenominator = uint64(8)
+	// MinSelfStake is the minimum self-stake in IOTX a candidate has to lock on registration
+	MinSelfStake = uint64(1000000)
+	// VotingPeriod is the number of blocks a proposal could be voted on after its submission
+	VotingPeriod = uint64(8640)
+	// ActivationDelay is the number of blocks an accepted proposal takes effect after the voting period
+	ActivationDelay = uint64(8640)
+	// DefaultEpochLength is the number of blocks in an epoch if it's not set in the genesis spec
+	DefaultEpochLength = uint64(21)
+	// BlockReward is the reward in IOTX granted to the producer of each block out of the reward pool
+	BlockReward = uint64(16)
+	// EpochReward is the reward in IOTX split among the producers of an epoch by the blocks they produced
+	EpochReward = uint64(300)
+	// VoterSharePercent is the percentage of a delegate's epoch reward shared with its voters by voting weight
+	VoterSharePercent = uint64(0)
 )
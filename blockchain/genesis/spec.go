@@ -0,0 +1,186 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package genesis
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// ErrInvalidSpec indicates error for an invalid genesis spec
+var ErrInvalidSpec = errors.New("invalid genesis spec")
+
+type (
+	// Spec is the declarative specification of the genesis block of a chain, from which all the states and actions of
+	// the genesis block are derived
+	Spec struct {
+		ChainID   uint32 `json:"chainID" yaml:"chainID"`
+		Timestamp int64  `json:"timestamp" yaml:"timestamp"`
+		// CreatorPubKey is the public key of the creator of the genesis block, who governs the block limits
+		CreatorPubKey string `json:"creatorPubKey" yaml:"creatorPubKey"`
+		// Balances is the initial balances of the accounts
+		Balances []Balance `json:"balances" yaml:"balances"`
+		// Delegates is the public keys of the delegates self-nominated in the genesis block
+		Delegates []string `json:"delegates" yaml:"delegates"`
+		// Candidates is the candidates registered in the genesis block, whose self-stakes are locked from the initial
+		// balances
+		Candidates []Candidate `json:"candidates" yaml:"candidates"`
+		// Contracts is the contracts deployed in the genesis block
+		Contracts []Contract `json:"contracts" yaml:"contracts"`
+		// SubChains is the sub-chains started in the genesis block if config.Chain.EnableSubChainStartInGenesis is set
+		SubChains []SubChain `json:"subChains" yaml:"subChains"`
+		// Parameters is the initial values of the governable parameters
+		Parameters map[string]uint64 `json:"parameters" yaml:"parameters"`
+		// ForkSchedule is the heights since which the features changing the protocol behavior are active
+		ForkSchedule ForkSchedule `json:"forkSchedule" yaml:"forkSchedule"`
+		// EpochLength is the number of blocks in an epoch, over which the epoch rewards are granted. It's
+		// DefaultEpochLength if not set.
+		EpochLength uint64 `json:"epochLength,omitempty" yaml:"epochLength"`
+	}
+
+	// Balance is the initial balance in rau of an account
+	Balance struct {
+		Address string `json:"address" yaml:"address"`
+		Amount  string `json:"amount" yaml:"amount"`
+	}
+
+	// Candidate is a candidate registered in the genesis block with its metadata and self-stake in rau
+	Candidate struct {
+		PubKey          string `json:"pubKey" yaml:"pubKey"`
+		Name            string `json:"name" yaml:"name"`
+		OperatorAddress string `json:"operatorAddress" yaml:"operatorAddress"`
+		RewardAddress   string `json:"rewardAddress" yaml:"rewardAddress"`
+		SelfStake       string `json:"selfStake" yaml:"selfStake"`
+	}
+
+	// Contract is a contract deployed in the genesis block with its runtime code and storage in hex, whose constructor
+	// isn't executed. A system contract is deployed at the address predetermined by its name if the address isn't set.
+	Contract struct {
+		Name    string            `json:"name" yaml:"name"`
+		Address string            `json:"address" yaml:"address"`
+		Code    string            `json:"code" yaml:"code"`
+		Storage map[string]string `json:"storage" yaml:"storage"`
+	}
+
+	// SubChain is a sub-chain started in the genesis block with its deposits in rau
+	SubChain struct {
+		ChainID            uint32 `json:"chainID" yaml:"chainID"`
+		SecurityDeposit    string `json:"securityDeposit" yaml:"securityDeposit"`
+		OperationDeposit   string `json:"operationDeposit" yaml:"operationDeposit"`
+		StartHeight        uint64 `json:"startHeight" yaml:"startHeight"`
+		ParentHeightOffset uint64 `json:"parentHeightOffset" yaml:"parentHeightOffset"`
+	}
+)
+
+// LoadSpec loads the genesis spec from the JSON file if it has the .json extension, or the YAML file otherwise
+func LoadSpec(path string) (*Spec, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read genesis file %s", path)
+	}
+	spec := &Spec{}
+	if filepath.Ext(path) == ".json" {
+		err = json.Unmarshal(data, spec)
+	} else {
+		err = yaml.Unmarshal(data, spec)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal genesis file %s", path)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Validate checks the format of the amounts, the contracts and the storage in the spec
+func (s *Spec) Validate() error {
+	if s.CreatorPubKey == "" {
+		return errors.Wrap(ErrInvalidSpec, "empty creator public key")
+	}
+	for _, b := range s.Balances {
+		if _, err := ParseAmount(b.Amount); err != nil {
+			return errors.Wrapf(err, "invalid balance of %s", b.Address)
+		}
+	}
+	for _, c := range s.Candidates {
+		if _, err := ParseAmount(c.SelfStake); err != nil {
+			return errors.Wrapf(err, "invalid self-stake of candidate %s", c.Name)
+		}
+	}
+	names := make(map[string]bool)
+	for _, c := range s.Contracts {
+		if c.Address == "" && c.Name == "" {
+			return errors.Wrap(ErrInvalidSpec, "contract has neither address nor name")
+		}
+		if c.Name != "" {
+			if names[c.Name] {
+				return errors.Wrapf(ErrInvalidSpec, "duplicate contract name %s", c.Name)
+			}
+			names[c.Name] = true
+		}
+		if _, err := hex.DecodeString(c.Code); err != nil {
+			return errors.Wrapf(ErrInvalidSpec, "invalid code of contract %s", c.Address)
+		}
+		if _, err := c.DecodeStorage(); err != nil {
+			return err
+		}
+	}
+	for _, sc := range s.SubChains {
+		if _, err := ParseAmount(sc.SecurityDeposit); err != nil {
+			return errors.Wrapf(err, "invalid security deposit of sub-chain %d", sc.ChainID)
+		}
+		if _, err := ParseAmount(sc.OperationDeposit); err != nil {
+			return errors.Wrapf(err, "invalid operation deposit of sub-chain %d", sc.ChainID)
+		}
+	}
+	return nil
+}
+
+// Hash returns the hash of the spec, which is the hash of its JSON encoding with the map keys sorted
+func (s *Spec) Hash() (hash.Hash32B, error) {
+	data, err := json.Marshal(s)
+	if err != nil {
+		return hash.ZeroHash32B, errors.Wrap(err, "failed to marshal genesis spec")
+	}
+	return byteutil.BytesTo32B(hash.Hash256b(data)), nil
+}
+
+// DecodeStorage decodes the storage of the contract from hex
+func (c *Contract) DecodeStorage() (map[hash.Hash32B]hash.Hash32B, error) {
+	storage := make(map[hash.Hash32B]hash.Hash32B, len(c.Storage))
+	for k, v := range c.Storage {
+		key, err := hex.DecodeString(k)
+		if err != nil || len(key) != len(hash.Hash32B{}) {
+			return nil, errors.Wrapf(ErrInvalidSpec, "invalid storage key %s of contract %s", k, c.Address)
+		}
+		value, err := hex.DecodeString(v)
+		if err != nil || len(value) != len(hash.Hash32B{}) {
+			return nil, errors.Wrapf(ErrInvalidSpec, "invalid storage value %s of contract %s", v, c.Address)
+		}
+		storage[byteutil.BytesTo32B(key)] = byteutil.BytesTo32B(value)
+	}
+	return storage, nil
+}
+
+// ParseAmount parses the non-negative decimal amount in rau
+func ParseAmount(amount string) (*big.Int, error) {
+	a, ok := big.NewInt(0).SetString(amount, 10)
+	if !ok || a.Sign() < 0 {
+		return nil, errors.Wrapf(ErrInvalidSpec, "invalid amount %s", amount)
+	}
+	return a, nil
+}
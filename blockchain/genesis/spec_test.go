@@ -0,0 +1,134 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package genesis
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpecYAML = `chainID: 2
+timestamp: 1546300800
+creatorPubKey: creator
+balances:
+- address: alfa
+  amount: "1000000000000000000"
+candidates:
+- pubKey: bravo
+  name: bravo
+  operatorAddress: bravo
+  rewardAddress: bravo
+  selfStake: "2000000000000000000"
+contracts:
+- address: charlie
+  code: "6080"
+  storage:
+    "0000000000000000000000000000000000000000000000000000000000000001": "00000000000000000000000000000000000000000000000000000000000000ff"
+parameters:
+  numCandidates: 36
+forkSchedule:
+  feature: 100
+`
+
+const testSpecJSON = `{
+  "chainID": 2,
+  "timestamp": 1546300800,
+  "creatorPubKey": "creator",
+  "balances": [{"address": "alfa", "amount": "1000000000000000000"}],
+  "candidates": [{
+    "pubKey": "bravo",
+    "name": "bravo",
+    "operatorAddress": "bravo",
+    "rewardAddress": "bravo",
+    "selfStake": "2000000000000000000"
+  }],
+  "contracts": [{
+    "address": "charlie",
+    "code": "6080",
+    "storage": {
+      "0000000000000000000000000000000000000000000000000000000000000001": "00000000000000000000000000000000000000000000000000000000000000ff"
+    }
+  }],
+  "parameters": {"numCandidates": 36},
+  "forkSchedule": {"feature": 100}
+}`
+
+func TestLoadSpec(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "genesis")
+	require.NoError(err)
+	defer func() {
+		require.NoError(os.RemoveAll(dir))
+	}()
+	yamlPath := filepath.Join(dir, "genesis.yaml")
+	require.NoError(ioutil.WriteFile(yamlPath, []byte(testSpecYAML), 0644))
+	jsonPath := filepath.Join(dir, "genesis.json")
+	require.NoError(ioutil.WriteFile(jsonPath, []byte(testSpecJSON), 0644))
+
+	spec, err := LoadSpec(yamlPath)
+	require.NoError(err)
+	require.Equal(uint32(2), spec.ChainID)
+	require.Equal(int64(1546300800), spec.Timestamp)
+	require.Equal(uint64(36), spec.Parameters["numCandidates"])
+	require.True(spec.ForkSchedule.IsActive("feature", 100))
+	storage, err := spec.Contracts[0].DecodeStorage()
+	require.NoError(err)
+	require.Equal(1, len(storage))
+
+	// The same spec in JSON has the same hash
+	specJSON, err := LoadSpec(jsonPath)
+	require.NoError(err)
+	require.Equal(spec, specJSON)
+	h1, err := spec.Hash()
+	require.NoError(err)
+	h2, err := specJSON.Hash()
+	require.NoError(err)
+	require.Equal(h1, h2)
+	spec.Balances[0].Amount = "1"
+	h2, err = spec.Hash()
+	require.NoError(err)
+	require.NotEqual(h1, h2)
+
+	_, err = LoadSpec(filepath.Join(dir, "nonexistent.yaml"))
+	require.Error(err)
+}
+
+func TestSpec_Validate(t *testing.T) {
+	require := require.New(t)
+
+	spec := &Spec{CreatorPubKey: "creator", Balances: []Balance{{Address: "alfa", Amount: "100"}}}
+	require.NoError(spec.Validate())
+	spec.Balances[0].Amount = "-1"
+	require.Equal(ErrInvalidSpec, errors.Cause(spec.Validate()))
+	spec.Balances[0].Amount = "1e18"
+	require.Equal(ErrInvalidSpec, errors.Cause(spec.Validate()))
+	spec.Balances = nil
+	spec.Contracts = []Contract{{Address: "charlie", Code: "zz"}}
+	require.Equal(ErrInvalidSpec, errors.Cause(spec.Validate()))
+	spec.Contracts = []Contract{{Address: "charlie", Code: "6080", Storage: map[string]string{"01": "02"}}}
+	require.Equal(ErrInvalidSpec, errors.Cause(spec.Validate()))
+	spec.Contracts = []Contract{{Code: "6080"}}
+	require.Equal(ErrInvalidSpec, errors.Cause(spec.Validate()))
+	spec.Contracts = []Contract{{Name: "token", Code: "6080"}, {Name: "token", Code: "6080"}}
+	require.Equal(ErrInvalidSpec, errors.Cause(spec.Validate()))
+	spec.Contracts = []Contract{{Name: "token", Code: "6080"}, {Name: "registry", Code: "6080"}}
+	require.NoError(spec.Validate())
+	spec.Contracts = nil
+	spec.CreatorPubKey = ""
+	require.Equal(ErrInvalidSpec, errors.Cause(spec.Validate()))
+
+	amount, err := ParseAmount("1000000000000000000000")
+	require.NoError(err)
+	require.Equal(0, amount.Cmp(big.NewInt(0).Mul(big.NewInt(1000), big.NewInt(1000000000000000000))))
+}
@@ -9,14 +9,27 @@ package blockchain
 import (
 	"context"
 	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
+	"github.com/iotexproject/iotex-core/action/protocol/vote"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/testaddress"
 )
@@ -59,3 +72,170 @@ func TestGenesis(t *testing.T) {
 	h := genesisBlk.HashBlock()
 	assert.Equal(genesisHash, h[:])
 }
+
+func TestGenesisFile(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "genesis")
+	require.NoError(err)
+	defer func() {
+		require.NoError(os.RemoveAll(dir))
+	}()
+	alfa := testaddress.IotxAddrinfo["alfa"]
+	bravo := testaddress.IotxAddrinfo["bravo"]
+	charlie := testaddress.IotxAddrinfo["charlie"]
+	// The min self-stake is lowered to 10 IOTX in the genesis parameters
+	selfStake := ConvertIotxToRau(10)
+	spec := &genesis.Spec{
+		ChainID:       config.Default.Chain.ID,
+		Timestamp:     1546300800,
+		CreatorPubKey: keypair.EncodePublicKey(testaddress.IotxAddrinfo["producer"].PublicKey),
+		Balances: []genesis.Balance{
+			{Address: alfa.RawAddress, Amount: big.NewInt(0).Mul(selfStake, big.NewInt(2)).String()},
+			{Address: bravo.RawAddress, Amount: "100"},
+		},
+		Delegates: []string{keypair.EncodePublicKey(bravo.PublicKey)},
+		Candidates: []genesis.Candidate{
+			{
+				PubKey:          keypair.EncodePublicKey(alfa.PublicKey),
+				Name:            "alfa",
+				OperatorAddress: alfa.RawAddress,
+				RewardAddress:   alfa.RawAddress,
+				SelfStake:       selfStake.String(),
+			},
+		},
+		Contracts: []genesis.Contract{
+			{
+				Address: charlie.RawAddress,
+				Code:    "6080",
+				Storage: map[string]string{
+					"0000000000000000000000000000000000000000000000000000000000000001": "00000000000000000000000000000000000000000000000000000000000000ff",
+				},
+			},
+			{
+				// returns the value in slot 1 of the storage
+				Name: "registry",
+				Code: "60015460005260206000f3",
+				Storage: map[string]string{
+					"0000000000000000000000000000000000000000000000000000000000000001": "00000000000000000000000000000000000000000000000000000000000000ee",
+				},
+			},
+		},
+		Parameters:   map[string]uint64{governance.NumCandidates: 36, governance.MinSelfStake: 10},
+		ForkSchedule: genesis.ForkSchedule{"feature": 5},
+	}
+	writeSpec := func(name string, spec *genesis.Spec) string {
+		data, err := yaml.Marshal(spec)
+		require.NoError(err)
+		path := filepath.Join(dir, name)
+		require.NoError(ioutil.WriteFile(path, data, 0644))
+		return path
+	}
+
+	ctx := context.Background()
+	cfg := config.Default
+	cfg.DB.UseBadgerDB = false
+	cfg.Chain.TrieDBPath = filepath.Join(dir, "trie.db")
+	cfg.Chain.ChainDBPath = filepath.Join(dir, "chain.db")
+	cfg.Chain.GenesisPath = writeSpec("genesis.yaml", spec)
+	newChain := func(cfg config.Config) Blockchain {
+		bc, err := NewBlockchain(cfg, DefaultStateFactoryOption(), BoltDBDaoOption())
+		require.NoError(err)
+		require.NotNil(bc)
+		bc.GetFactory().AddActionHandlers(account.NewProtocol(), vote.NewProtocol(bc))
+		return bc
+	}
+
+	bc := newChain(cfg)
+	require.NoError(bc.Start(ctx))
+	genesisBlk, err := bc.GetBlockByHeight(0)
+	require.NoError(err)
+	specHash, err := spec.Hash()
+	require.NoError(err)
+	require.Equal(specHash, genesisBlk.PrevHash())
+	require.Equal(spec.Timestamp, genesisBlk.Timestamp())
+	require.Equal(genesis.ForkSchedule{"feature": 5}, bc.ForkSchedule())
+
+	sf := bc.GetFactory()
+	acct, err := sf.AccountState(alfa.RawAddress)
+	require.NoError(err)
+	require.Equal(selfStake, acct.Balance)
+	require.True(acct.IsCandidate)
+	require.Equal(selfStake, acct.VotingWeight)
+	acct, err = sf.AccountState(bravo.RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(100), acct.Balance)
+	require.True(acct.IsCandidate)
+	acct, err = sf.AccountState(charlie.RawAddress)
+	require.NoError(err)
+	require.NotEmpty(acct.CodeHash)
+	// The system contract is deployed at the address predetermined by its name
+	ex, err := action.NewExecution(
+		alfa.RawAddress,
+		SystemContractAddr(cfg.Chain.ID, "registry"),
+		1,
+		big.NewInt(0),
+		100000,
+		big.NewInt(0),
+		nil,
+	)
+	require.NoError(err)
+	receipt, err := bc.ExecuteContractRead(ex)
+	require.NoError(err)
+	require.Equal(
+		"00000000000000000000000000000000000000000000000000000000000000ee",
+		hex.EncodeToString(receipt.ReturnValue),
+	)
+	numCandidates, err := governance.Parameter(sf, governance.NumCandidates, 1, 101)
+	require.NoError(err)
+	require.Equal(uint64(36), numCandidates)
+	require.NoError(bc.Stop(ctx))
+
+	// The existing chain doesn't start from a different genesis file
+	spec.Timestamp++
+	cfg.Chain.GenesisPath = writeSpec("genesis2.yaml", spec)
+	bc = newChain(cfg)
+	err = bc.Start(ctx)
+	require.Equal(genesis.ErrInvalidSpec, errors.Cause(err))
+	require.NoError(bc.Stop(ctx))
+
+	// The chain ID of the genesis file has to match the config
+	spec.ChainID++
+	cfg.Chain.GenesisPath = writeSpec("genesis3.yaml", spec)
+	_, err = NewBlockchain(cfg, DefaultStateFactoryOption(), BoltDBDaoOption())
+	require.Equal(genesis.ErrInvalidSpec, errors.Cause(err))
+}
+
+func TestLegacyGenesisSpec(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "genesis")
+	require.NoError(err)
+	defer func() {
+		require.NoError(os.RemoveAll(dir))
+	}()
+	alfa := testaddress.IotxAddrinfo["alfa"]
+	producer := testaddress.IotxAddrinfo["producer"]
+	// The creator and alfa are funded more than once by the genesis actions
+	actions := GenesisAction{
+		Creation: Creator{PubKey: keypair.EncodePublicKey(producer.PublicKey)},
+		Transfers: []Transfer{
+			{Amount: 10, RecipientPK: keypair.EncodePublicKey(alfa.PublicKey)},
+			{Amount: 5, RecipientPK: keypair.EncodePublicKey(producer.PublicKey)},
+			{Amount: 20, RecipientPK: keypair.EncodePublicKey(alfa.PublicKey)},
+		},
+	}
+	data, err := yaml.Marshal(actions)
+	require.NoError(err)
+	cfg := config.Default
+	cfg.Chain.GenesisActionsPath = filepath.Join(dir, "actions.yaml")
+	require.NoError(ioutil.WriteFile(cfg.Chain.GenesisActionsPath, data, 0644))
+
+	spec, err := GenesisSpec(cfg.Chain)
+	require.NoError(err)
+	require.NoError(spec.Validate())
+	require.Equal([]genesis.Balance{
+		{Address: alfa.RawAddress, Amount: ConvertIotxToRau(10).String()},
+		{Address: producer.RawAddress, Amount: ConvertIotxToRau(5).String()},
+	}, spec.Balances)
+}
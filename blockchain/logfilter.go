@@ -0,0 +1,187 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+)
+
+// LogFilter selects the logs emitted within a range of heights. A log matches if it's emitted by any of the addresses,
+// and its topics match the topics position by position, where an empty position matches any topic and a non-empty one
+// matches any of the topics in it. A zero to height stands for the tip height.
+type LogFilter struct {
+	FromHeight uint64
+	ToHeight   uint64
+	Addresses  []string
+	Topics     [][]hash.Hash32B
+}
+
+// Match returns true if the log matches the addresses and the topics of the filter
+func (f *LogFilter) Match(l *action.Log) bool {
+	if len(f.Addresses) > 0 {
+		found := false
+		for _, addr := range f.Addresses {
+			if addr == l.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Topics) > len(l.Topics) {
+		return false
+	}
+	for i, topics := range f.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range topics {
+			if topic == l.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchBloom returns false if the logs bloom tells that no log of the block matches the filter
+func (f *LogFilter) MatchBloom(bloom block.Bloom) bool {
+	if len(f.Addresses) > 0 {
+		found := false
+		for _, addr := range f.Addresses {
+			if bloom.Test([]byte(addr)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, topics := range f.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range topics {
+			if bloom.Test(topic[:]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// GetLogs returns the logs matching the filter, in the order they are emitted
+func (bc *blockchain) GetLogs(filter *LogFilter) ([]*action.Log, error) {
+	if !bc.config.Explorer.Enabled {
+		return nil, errors.New("explorer not enabled")
+	}
+	to := filter.ToHeight
+	if tipHeight := bc.TipHeight(); to == 0 || to > tipHeight {
+		to = tipHeight
+	}
+	if filter.FromHeight > to {
+		return nil, nil
+	}
+	if limit := bc.config.Explorer.MaxLogQueryRange; limit > 0 && to-filter.FromHeight >= limit {
+		return nil, errors.Errorf("range of heights exceeds the limit %d", limit)
+	}
+	heights, err := bc.logHeights(filter, filter.FromHeight, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*action.Log
+	for _, height := range heights {
+		blk, err := bc.getBlockByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		if bc.forkSchedule.IsActive(genesis.LogsBloom, height) && !filter.MatchBloom(blk.LogsBloom()) {
+			continue
+		}
+		for _, selp := range blk.Actions {
+			receipt, err := bc.dao.getReceiptByActionHash(selp.Hash())
+			if err != nil {
+				if errors.Cause(err) == db.ErrNotExist || errors.Cause(err) == bolt.ErrBucketNotFound {
+					continue
+				}
+				return nil, err
+			}
+			for _, l := range receipt.Logs {
+				if filter.Match(l) {
+					logs = append(logs, l)
+				}
+			}
+		}
+	}
+	return logs, nil
+}
+
+// logHeights returns the heights within [from, to] of the blocks which could have the logs matching the filter. They
+// are looked up in the index of the addresses if any, otherwise in the index of the first topics of the filter.
+func (bc *blockchain) logHeights(filter *LogFilter, from uint64, to uint64) ([]uint64, error) {
+	if len(filter.Addresses) > 0 {
+		return mergeHeights(len(filter.Addresses), func(i int) ([]uint64, error) {
+			return bc.dao.getLogHeightsByAddress(filter.Addresses[i], from, to)
+		})
+	}
+	for _, topics := range filter.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		return mergeHeights(len(topics), func(i int) ([]uint64, error) {
+			return bc.dao.getLogHeightsByTopic(topics[i], from, to)
+		})
+	}
+	heights := make([]uint64, 0, to-from+1)
+	for height := from; height <= to; height++ {
+		heights = append(heights, height)
+	}
+	return heights, nil
+}
+
+// mergeHeights returns the sorted union of the n lists of heights
+func mergeHeights(n int, heightsAt func(int) ([]uint64, error)) ([]uint64, error) {
+	seen := make(map[uint64]bool)
+	var res []uint64
+	for i := 0; i < n; i++ {
+		heights, err := heightsAt(i)
+		if err != nil {
+			return nil, err
+		}
+		for _, height := range heights {
+			if !seen[height] {
+				seen[height] = true
+				res = append(res, height)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i] < res[j] })
+	return res, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	ta "github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestLogFilter(t *testing.T) {
+	require := require.New(t)
+
+	topic1 := hash.Hash256b([]byte("topic1"))
+	topic2 := hash.Hash256b([]byte("topic2"))
+	topic3 := hash.Hash256b([]byte("topic3"))
+	l := &action.Log{Address: "io1a", Topics: []hash.Hash32B{topic1, topic2}}
+	bloom := block.CreateLogsBloom(map[hash.Hash32B]*action.Receipt{hash.ZeroHash32B: {Logs: []*action.Log{l}}})
+
+	for _, c := range []struct {
+		filter  LogFilter
+		matched bool
+	}{
+		{LogFilter{}, true},
+		{LogFilter{Addresses: []string{"io1b", "io1a"}}, true},
+		{LogFilter{Addresses: []string{"io1b"}}, false},
+		{LogFilter{Topics: [][]hash.Hash32B{{topic1}}}, true},
+		{LogFilter{Topics: [][]hash.Hash32B{nil, {topic3, topic2}}}, true},
+		{LogFilter{Topics: [][]hash.Hash32B{{topic2}}}, false},
+		{LogFilter{Topics: [][]hash.Hash32B{nil, nil, nil}}, false},
+		{LogFilter{Addresses: []string{"io1a"}, Topics: [][]hash.Hash32B{{topic3}}}, false},
+	} {
+		require.Equal(c.matched, c.filter.Match(l), "%+v", c.filter)
+		if c.matched {
+			require.True(c.filter.MatchBloom(bloom), "%+v", c.filter)
+		}
+	}
+	filter := LogFilter{Addresses: []string{"io1b"}}
+	require.False(filter.MatchBloom(bloom))
+	require.False(filter.MatchBloom(block.Bloom{}))
+
+	heights, err := mergeHeights(2, func(i int) ([]uint64, error) {
+		return [][]uint64{{1, 3, 5}, {2, 3, 6}}[i], nil
+	})
+	require.NoError(err)
+	require.Equal([]uint64{1, 2, 3, 5, 6}, heights)
+}
+
+func TestGetLogs_MaxLogQueryRange(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default
+	cfg.Explorer.Enabled = true
+	cfg.Explorer.MaxLogQueryRange = 2
+	bc, err := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption())
+	require.NoError(err)
+	require.NoError(bc.Start(context.Background()))
+	defer func() {
+		require.NoError(bc.Stop(context.Background()))
+	}()
+	for i := 0; i < 3; i++ {
+		blk, err := bc.MintNewBlock(nil, ta.IotxAddrinfo["producer"], nil, nil, "")
+		require.NoError(err)
+		require.NoError(bc.ValidateBlock(blk, true))
+		require.NoError(bc.CommitBlock(blk))
+	}
+
+	_, err = bc.GetLogs(&LogFilter{FromHeight: 2, ToHeight: 3})
+	require.NoError(err)
+	// The range is capped at the tip height before it's checked against the limit
+	_, err = bc.GetLogs(&LogFilter{FromHeight: 2, ToHeight: 100})
+	require.NoError(err)
+	_, err = bc.GetLogs(&LogFilter{FromHeight: 1})
+	require.Error(err)
+}
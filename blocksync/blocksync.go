@@ -195,10 +195,15 @@ func (bs *blockSyncer) ProcessSyncRequest(sender string, sync *iproto.BlockSync)
 		if err != nil {
 			return err
 		}
+		blkPb := blk.ConvertToBlockPb()
+		if blkPb == nil {
+			log.L().Warn("Failed to convert block into proto.", zap.Uint64("height", i))
+			continue
+		}
 		// TODO: send back multiple blocks in one shot
 		if err := bs.unicastHandler(
 			node.NewTCPNode(sender),
-			&iproto.BlockContainer{Block: blk.ConvertToBlockPb()},
+			&iproto.BlockContainer{Block: blkPb},
 		); err != nil {
 			log.L().Warn("Failed to response to ProcessSyncRequest.", zap.Error(err))
 		}
@@ -89,16 +89,19 @@ func New(
 	}
 
 	// create Blockchain
-	chain := blockchain.NewBlockchain(cfg, chainOpts...)
-	if chain == nil && cfg.Chain.EnableFallBackToFreshDB {
-		log.L().Warn("Chain db and trie db are falling back to fresh ones.")
+	chain, err := blockchain.NewBlockchain(cfg, chainOpts...)
+	if err != nil && cfg.Chain.EnableFallBackToFreshDB {
+		log.L().Warn("Chain db and trie db are falling back to fresh ones.", zap.Error(err))
 		if err := os.Rename(cfg.Chain.ChainDBPath, cfg.Chain.ChainDBPath+".old"); err != nil {
 			return nil, errors.Wrap(err, "failed to rename old chain db")
 		}
 		if err := os.Rename(cfg.Chain.TrieDBPath, cfg.Chain.TrieDBPath+".old"); err != nil {
 			return nil, errors.Wrap(err, "failed to rename old trie db")
 		}
-		chain = blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+		chain, err = blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create blockchain")
 	}
 
 	// Create ActPool
@@ -182,6 +185,9 @@ func (cs *ChainService) Start(ctx context.Context) error {
 	if err := cs.chain.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting blockchain")
 	}
+	if err := cs.actpool.Start(ctx); err != nil {
+		return errors.Wrap(err, "error when starting actpool")
+	}
 	if err := cs.consensus.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting consensus")
 	}
@@ -214,6 +220,9 @@ func (cs *ChainService) Stop(ctx context.Context) error {
 	if err := cs.blocksync.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blocksync")
 	}
+	if err := cs.actpool.Stop(ctx); err != nil {
+		return errors.Wrap(err, "error when stopping actpool")
+	}
 	if err := cs.chain.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blockchain")
 	}
@@ -72,6 +72,7 @@ var (
 			ProducerPubKey:               keypair.EncodePublicKey(keypair.ZeroPublicKey),
 			ProducerPrivKey:              keypair.EncodePrivateKey(keypair.ZeroPrivateKey),
 			GenesisActionsPath:           "",
+			GenesisPath:                  "",
 			EmptyGenesis:                 false,
 			NumCandidates:                101,
 			EnableFallBackToFreshDB:      false,
@@ -79,9 +80,14 @@ var (
 			EnableGasCharge:              false,
 		},
 		ActPool: ActPool{
-			MaxNumActsPerPool: 32000,
-			MaxNumActsPerAcct: 2000,
-			MaxNumActsToPick:  0,
+			MaxNumActsPerPool:      32000,
+			MaxNumActsPerAcct:      2000,
+			MaxNumActsToPick:       0,
+			PriceBumpPercent:       10,
+			ActionExpiry:           10 * time.Minute,
+			MinNumActsPerAcct:      16,
+			JournalPath:            "",
+			JournalCompactInterval: 10 * time.Minute,
 		},
 		Consensus: Consensus{
 			Scheme: NOOPScheme,
@@ -100,6 +106,8 @@ var (
 				NumDelegates:      21,
 				TimeBasedRotation: false,
 				EnableDKG:         false,
+
+				EnableAggregatedCommit: false,
 			},
 			BlockCreationInterval: 10 * time.Second,
 		},
@@ -121,6 +129,10 @@ var (
 				Percentile:         60,
 			},
 			MaxTransferPayloadBytes: 1024,
+			ActPoolEventBufferSize:  1000,
+			MaxActPoolEventTimeout:  30 * time.Second,
+			MaxLogQueryRange:        10000,
+			LogStreamBufferSize:     1000,
 		},
 		Indexer: Indexer{
 			Enabled:  false,
@@ -172,6 +184,7 @@ type (
 		ProducerPubKey               string `yaml:"producerPubKey"`
 		ProducerPrivKey              string `yaml:"producerPrivKey"`
 		GenesisActionsPath           string `yaml:"genesisActionsPath"`
+		GenesisPath                  string `yaml:"genesisPath"`
 		EmptyGenesis                 bool   `yaml:"emptyGenesis"`
 		NumCandidates                uint   `yaml:"numCandidates"`
 		EnableFallBackToFreshDB      bool   `yaml:"enableFallbackToFreshDb"`
@@ -211,6 +224,8 @@ type (
 		NumDelegates             uint          `yaml:"numDelegates"`
 		TimeBasedRotation        bool          `yaml:"timeBasedRotation"`
 		EnableDKG                bool          `yaml:"enableDKG"`
+		// EnableAggregatedCommit aggregates the COMMIT endorsements of a block into one BLS signature with the DKG keys
+		EnableAggregatedCommit bool `yaml:"enableAggregatedCommit"`
 	}
 
 	// Dispatcher is the dispatcher config
@@ -228,6 +243,14 @@ type (
 		GasStation GasStation `yaml:"gasStation"`
 		// MaxTransferPayloadBytes limits how many bytes a playload can contain at most
 		MaxTransferPayloadBytes uint64 `yaml:"maxTransferPayloadBytes"`
+		// ActPoolEventBufferSize is the number of the latest actpool events kept for long-polling
+		ActPoolEventBufferSize int `yaml:"actPoolEventBufferSize"`
+		// MaxActPoolEventTimeout caps how long a long-polling request of actpool events can wait
+		MaxActPoolEventTimeout time.Duration `yaml:"maxActPoolEventTimeout"`
+		// MaxLogQueryRange caps how many blocks a query of logs can range over
+		MaxLogQueryRange uint64 `yaml:"maxLogQueryRange"`
+		// LogStreamBufferSize is the number of the log notices buffered for a subscriber of the log stream
+		LogStreamBufferSize int `yaml:"logStreamBufferSize"`
 	}
 
 	// GasStation is the gas station config
@@ -262,6 +285,24 @@ type (
 		// MaxNumActsToPick indicates maximum number of actions to pick to mint a block. Default is 0, which means no
 		// limit on the number of actions to pick.
 		MaxNumActsToPick uint64 `yaml:"maxNumActsToPick"`
+		// PriceBumpPercent indicates by how many percent the gas price of an action has to be higher than the one of
+		// the queued action of the same nonce to replace it
+		PriceBumpPercent uint64 `yaml:"priceBumpPercent"`
+		// ActionExpiry indicates how long an action can stay in the actpool before being dropped. Default is 0, which
+		// means actions never expire.
+		ActionExpiry time.Duration `yaml:"actionExpiry"`
+		// MinNumActsPerAcct indicates the number of actions an account is guaranteed to keep in the actpool when
+		// actions are evicted to make room for higher priced ones
+		MinNumActsPerAcct uint64 `yaml:"minNumActsPerAcct"`
+		// JournalPath is the path of the file to persist the actions in the actpool across restarts. Default is empty,
+		// which means the journal is disabled.
+		JournalPath string `yaml:"journalPath"`
+		// JournalCompactInterval indicates how often the journal is regenerated from the actions in the actpool. Default
+		// is 0, which means the journal is only compacted on start and stop.
+		JournalCompactInterval time.Duration `yaml:"journalCompactInterval"`
+		// LocalAccounts are the addresses of the accounts whose actions are picked first, never evicted or expired, and
+		// not limited by MaxNumActsPerAcct
+		LocalAccounts []string `yaml:"localAccounts"`
 	}
 
 	// DB is the config for database
@@ -433,8 +474,8 @@ func ValidateKeyPair(cfg Config) error {
 	}
 	// Validate producer pubkey and prikey by signing a dummy message and verify it
 	validationMsg := "connecting the physical world block by block"
-	sig := crypto.EC283.Sign(priKey, []byte(validationMsg))
-	if !crypto.EC283.Verify(pubKey, []byte(validationMsg), sig) {
+	sig := crypto.Sign(priKey, []byte(validationMsg))
+	if !crypto.Verify(pubKey, []byte(validationMsg), sig) {
 		return errors.Wrap(ErrInvalidCfg, "block producer has unmatched pubkey and prikey")
 	}
 	return nil
@@ -493,6 +534,9 @@ func ValidateRollDPoS(cfg Config) error {
 	if ttl >= rollDPoS.ProposerInterval {
 		return errors.Wrap(ErrInvalidCfg, "roll-DPoS ttl sum is larger than proposer interval")
 	}
+	if rollDPoS.EnableAggregatedCommit && !rollDPoS.EnableDKG {
+		return errors.Wrap(ErrInvalidCfg, "roll-DPoS aggregated commit requires DKG to be enabled")
+	}
 
 	return nil
 }
@@ -521,6 +565,18 @@ func ValidateActPool(cfg Config) error {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		)
 	}
+	if cfg.ActPool.MinNumActsPerAcct > maxNumActPerAcct {
+		return errors.Wrap(
+			ErrInvalidCfg,
+			"guaranteed number of actions per account cannot be greater than maximum number of actions per account",
+		)
+	}
+	if cfg.ActPool.ActionExpiry < 0 {
+		return errors.Wrap(ErrInvalidCfg, "action expiry cannot be negative")
+	}
+	if cfg.ActPool.JournalCompactInterval < 0 {
+		return errors.Wrap(ErrInvalidCfg, "journal compaction interval cannot be negative")
+	}
 	return nil
 }
 
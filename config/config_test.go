@@ -312,6 +312,20 @@ func TestValidateRollDPoS(t *testing.T) {
 		t,
 		strings.Contains(err.Error(), "roll-DPoS event delegate number should be greater than 0"),
 	)
+
+	cfg.Consensus.RollDPoS.NumDelegates = 21
+	cfg.Consensus.RollDPoS.ProposerInterval = 10 * time.Second
+	cfg.Consensus.RollDPoS.EnableAggregatedCommit = true
+	err = ValidateRollDPoS(cfg)
+	require.NotNil(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(
+		t,
+		strings.Contains(err.Error(), "roll-DPoS aggregated commit requires DKG to be enabled"),
+	)
+
+	cfg.Consensus.RollDPoS.EnableDKG = true
+	require.NoError(t, ValidateRollDPoS(cfg))
 }
 
 func TestValidateActPool(t *testing.T) {
@@ -352,6 +366,29 @@ func TestValidateActPool(t *testing.T) {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		),
 	)
+
+	cfg.ActPool.MaxNumActsPerPool = 1000
+	cfg.ActPool.MinNumActsPerAcct = 101
+	err = ValidateActPool(cfg)
+	require.NotNil(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(
+		t,
+		strings.Contains(
+			err.Error(),
+			"guaranteed number of actions per account cannot be greater than maximum number of actions per account",
+		),
+	)
+
+	cfg.ActPool.MinNumActsPerAcct = 10
+	cfg.ActPool.ActionExpiry = -time.Second
+	err = ValidateActPool(cfg)
+	require.NotNil(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "action expiry cannot be negative"))
+
+	cfg.ActPool.ActionExpiry = time.Minute
+	require.NoError(t, ValidateActPool(cfg))
 }
 
 func TestCheckNodeType(t *testing.T) {
@@ -116,7 +116,6 @@ func NewConsensus(
 		return nil
 	}
 
-	var err error
 	clock := clock.New()
 	switch cfg.Consensus.Scheme {
 	case config.RollDPoSScheme:
@@ -162,10 +161,15 @@ func NewConsensus(
 			})
 			bd = bd.SetRootChainAPI(ops.rootChainAPI)
 		}
-		cs.scheme, err = bd.Build()
+		rollDPoS, err := bd.Build()
 		if err != nil {
 			log.L().Panic("Error when constructing RollDPoS.", zap.Error(err))
 		}
+		// The aggregated commits of the blocks are verified even if the node does not aggregate them itself
+		if bc.Validator() != nil {
+			bc.Validator().SetCommitVerifier(rollDPoS)
+		}
+		cs.scheme = rollDPoS
 	case config.NOOPScheme:
 		cs.scheme = scheme.NewNoop()
 	case config.StandaloneScheme:
@@ -281,12 +281,16 @@ func (m *cFSM) handleRollDelegatesEvt(_ fsm.Event) (fsm.State, error) {
 	}
 	// Update CryptoSort seed
 	// TODO: Consider persist the most recent seed
-	if !m.ctx.cfg.EnableDKG {
-		m.ctx.epoch.seed = crypto.CryptoSeed
-	} else if m.ctx.epoch.seed, err = m.ctx.updateSeed(); err != nil {
-		log.L().Error("Failed to generate new seed from last epoch.", zap.Error(err))
+	seed := crypto.CryptoSeed
+	if m.ctx.cfg.EnableDKG {
+		if seed, err = m.ctx.updateSeed(); err != nil {
+			log.L().Error("Failed to generate new seed from last epoch.", zap.Error(err))
+		}
 	}
-	delegates, err := m.ctx.rollingDelegates(epochNum)
+	m.ctx.epochMutex.Lock()
+	m.ctx.epoch.seed = seed
+	m.ctx.epochMutex.Unlock()
+	delegates, err := m.ctx.rollingDelegates(epochNum, seed)
 	if err != nil {
 		// Even if error happens, we still need to schedule next check of delegate to tolerate transit error
 		m.produce(m.newCEvt(eRollDelegates), m.ctx.cfg.DelegateInterval)
@@ -298,12 +302,14 @@ func (m *cFSM) handleRollDelegatesEvt(_ fsm.Event) (fsm.State, error) {
 	// If the current node is the delegate, move to the next state
 	if m.isDelegate(delegates) {
 		// The epochStart start height is going to be the next block to generate
+		m.ctx.epochMutex.Lock()
 		m.ctx.epoch.num = epochNum
 		m.ctx.epoch.height = epochHeight
 		m.ctx.epoch.delegates = delegates
 		m.ctx.epoch.numSubEpochs = m.ctx.getNumSubEpochs()
 		m.ctx.epoch.subEpochNum = uint64(0)
 		m.ctx.epoch.committedSecrets = make(map[string][]uint32)
+		m.ctx.epochMutex.Unlock()
 
 		// Trigger the event to generate DKG
 		m.produce(m.newCEvt(eGenerateDKG), 0)
@@ -326,8 +332,10 @@ func (m *cFSM) handleGenerateDKGEvt(_ fsm.Event) (fsm.State, error) {
 		if err != nil {
 			return sEpochStart, err
 		}
+		m.ctx.epochMutex.Lock()
 		m.ctx.epoch.secrets = secrets
 		m.ctx.epoch.witness = witness
+		m.ctx.epochMutex.Unlock()
 	}
 	if err := m.produceStartRoundEvt(); err != nil {
 		return sEpochStart, errors.Wrapf(err, "error when producing %s", eStartRound)
@@ -343,7 +351,9 @@ func (m *cFSM) handleStartRoundEvt(_ fsm.Event) (fsm.State, error) {
 			"error when determining the sub-epoch ordinal number",
 		)
 	}
+	m.ctx.epochMutex.Lock()
 	m.ctx.epoch.subEpochNum = subEpochNum
+	m.ctx.epochMutex.Unlock()
 
 	proposer, height, round, err := m.ctx.rotatedProposer()
 	if err != nil {
@@ -647,8 +657,10 @@ func (m *cFSM) handleFinishEpochEvt(evt fsm.Event) (fsm.State, error) {
 		if err != nil {
 			return sEpochStart, errors.Wrap(err, "error when generating DKG key pair")
 		}
+		m.ctx.epochMutex.Lock()
 		m.ctx.epoch.dkgAddress.PublicKey = dkgPubKey
 		m.ctx.epoch.dkgAddress.PrivateKey = dkgPriKey
+		m.ctx.epochMutex.Unlock()
 	}
 
 	epochFinished, err := m.ctx.isEpochFinished()
@@ -731,7 +743,14 @@ func (m *cFSM) newEndorseEvtWithEndorsePb(ePb *iproto.EndorsePb) (*endorseEvt, e
 }
 
 func (m *cFSM) newEndorseEvt(blkHash []byte, topic endorsement.ConsensusVoteTopic) *endorseEvt {
-	return newEndorseEvt(topic, blkHash, m.ctx.round.height, m.ctx.round.number, m.ctx.addr, m.ctx.clock)
+	evt := newEndorseEvt(topic, blkHash, m.ctx.round.height, m.ctx.round.number, m.ctx.addr, m.ctx.clock)
+	// Commit endorsements additionally carry a DKG signature share to be aggregated into the block footer
+	if m.ctx.cfg.EnableAggregatedCommit && topic == endorsement.COMMIT && len(m.ctx.epoch.dkgAddress.PrivateKey) > 0 {
+		if err := evt.endorse.SignDKG(m.ctx.epoch.dkgAddress.PrivateKey); err != nil {
+			log.L().Error("Error when signing the commit endorsement with DKG key.", zap.Error(err))
+		}
+	}
+	return evt
 }
 
 func (m *cFSM) newTimeoutEvt(t fsm.EventType) *timeoutEvt {
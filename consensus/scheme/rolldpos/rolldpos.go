@@ -8,6 +8,8 @@ package rolldpos
 
 import (
 	"context"
+	"encoding/hex"
+	"sync"
 	"time"
 
 	"github.com/facebookgo/clock"
@@ -67,19 +69,25 @@ var (
 	ErrZeroDelegate = errors.New("zero delegates in the network")
 )
 
+// maxCachedEpochs is the max number of epochs whose delegate data is cached
+const maxCachedEpochs = 4
+
 type rollDPoSCtx struct {
 	cfg              config.RollDPoS
 	addr             *iotxaddress.Address
 	chain            blockchain.Blockchain
 	actPool          actpool.ActPool
 	broadcastHandler scheme.Broadcast
-	epoch            epochCtx
-	round            roundCtx
-	clock            clock.Clock
-	rootChainAPI     explorer.Explorer
+	// epochMutex guards the writes of the epoch by the FSM and the reads of it out of the FSM
+	epochMutex   sync.RWMutex
+	epoch        epochCtx
+	round        roundCtx
+	clock        clock.Clock
+	rootChainAPI explorer.Explorer
 	// candidatesByHeightFunc is only used for testing purpose
 	candidatesByHeightFunc func(uint64) ([]*state.Candidate, error)
 	sync                   blocksync.BlockSync
+	epochDelegatesCache    epochDelegatesCache
 }
 
 var (
@@ -93,11 +101,21 @@ func (ctx *rollDPoSCtx) OnConsensusReached() error {
 	if ctx.shouldHandleDKG() {
 		for _, secretProposal := range pendingBlock.SecretProposals {
 			if secretProposal.DstAddr() == ctx.addr.RawAddress {
+				ctx.epochMutex.Lock()
 				ctx.epoch.committedSecrets[secretProposal.SrcAddr()] = secretProposal.Secret()
+				ctx.epochMutex.Unlock()
 				break
 			}
 		}
 	}
+	// Aggregate the commit endorsements into the footer of the pending block
+	if ctx.cfg.EnableAggregatedCommit {
+		if err := ctx.attachAggregatedCommits(pendingBlock.Block); err != nil {
+			log.L().Warn("Failed to aggregate commit endorsements.",
+				zap.Error(err),
+				zap.Uint64("blockHeight", pendingBlock.Height()))
+		}
+	}
 	// Commit and broadcast the pending block
 	if err := ctx.chain.CommitBlock(pendingBlock.Block); err != nil {
 		log.L().Error("Error when committing a block.",
@@ -245,8 +263,9 @@ func (ctx *rollDPoSCtx) Broadcast(msg proto.Message) error {
 	return ctx.broadcastHandler(consensusMsg)
 }
 
-// rollingDelegates will only allows the delegates chosen for given epoch to enter the epoch
-func (ctx *rollDPoSCtx) rollingDelegates(epochNum uint64) ([]string, error) {
+// rollingDelegates will only allows the delegates chosen for given epoch to enter the epoch, which are sorted by the
+// seed of the epoch
+func (ctx *rollDPoSCtx) rollingDelegates(epochNum uint64, seed []byte) ([]string, error) {
 	numDlgs := ctx.cfg.NumDelegates
 	height := uint64(numDlgs) * uint64(ctx.cfg.NumSubEpochs) * (epochNum - 1)
 	var candidates []*state.Candidate
@@ -268,7 +287,7 @@ func (ctx *rollDPoSCtx) rollingDelegates(epochNum uint64) ([]string, error) {
 	for _, candidate := range candidates {
 		candidatesAddress = append(candidatesAddress, candidate.Address)
 	}
-	crypto.SortCandidates(candidatesAddress, epochNum, ctx.epoch.seed)
+	crypto.SortCandidates(candidatesAddress, epochNum, seed)
 
 	return candidatesAddress[:numDlgs], nil
 }
@@ -310,7 +329,9 @@ func (ctx *rollDPoSCtx) generateDKGSecrets() ([][]uint32, [][]byte, error) {
 		dkgID := iotxaddress.CreateID(addr)
 		idList = append(idList, dkgID)
 		if addr == ctx.addr.RawAddress {
+			ctx.epochMutex.Lock()
 			ctx.epoch.dkgAddress = iotxaddress.DKGAddress{ID: dkgID}
+			ctx.epochMutex.Unlock()
 		}
 	}
 	_, secrets, witness, err := crypto.DKG.Init(crypto.DKG.SkGeneration(), idList)
@@ -499,13 +520,20 @@ func (ctx *rollDPoSCtx) isDKGFinished() bool {
 
 // updateSeed returns the seed for the next epoch
 func (ctx *rollDPoSCtx) updateSeed() ([]byte, error) {
-	epochNum, epochHeight, err := ctx.calcEpochNumAndHeight()
+	epochNum, _, err := ctx.calcEpochNumAndHeight()
 	if err != nil {
 		return hash.Hash256b(ctx.epoch.seed), errors.Wrap(err, "Failed to do decode seed")
 	}
+	return ctx.calcSeed(epochNum, ctx.epoch.seed)
+}
+
+// calcSeed returns the seed of the epoch, which aggregates the DKG signatures of the last seed carried by the blocks
+// of the last epoch. If they cannot be aggregated, the hash of the last seed is returned along with the error.
+func (ctx *rollDPoSCtx) calcSeed(epochNum uint64, lastSeed []byte) ([]byte, error) {
 	if epochNum <= 1 {
 		return crypto.CryptoSeed, nil
 	}
+	epochHeight := uint64(ctx.cfg.NumDelegates)*uint64(ctx.getNumSubEpochs())*(epochNum-1) + 1
 	selectedID := make([][]uint8, 0)
 	selectedSig := make([][]byte, 0)
 	selectedPK := make([][]byte, 0)
@@ -522,19 +550,154 @@ func (ctx *rollDPoSCtx) updateSeed() ([]byte, error) {
 	}
 
 	if len(selectedID) <= crypto.Degree {
-		return hash.Hash256b(ctx.epoch.seed), errors.New("DKG signature/pubic key is not enough to aggregate")
+		return hash.Hash256b(lastSeed), errors.New("DKG signature/pubic key is not enough to aggregate")
 	}
 
 	aggregateSig, err := crypto.BLS.SignAggregate(selectedID, selectedSig)
 	if err != nil {
-		return hash.Hash256b(ctx.epoch.seed), errors.Wrap(err, "Failed to generate aggregate signature to update Seed")
+		return hash.Hash256b(lastSeed), errors.Wrap(err, "Failed to generate aggregate signature to update Seed")
 	}
-	if err = crypto.BLS.VerifyAggregate(selectedID, selectedPK, ctx.epoch.seed, aggregateSig); err != nil {
-		return hash.Hash256b(ctx.epoch.seed), errors.Wrap(err, "Failed to verify aggregate signature to update Seed")
+	if err = crypto.BLS.VerifyAggregate(selectedID, selectedPK, lastSeed, aggregateSig); err != nil {
+		return hash.Hash256b(lastSeed), errors.Wrap(err, "Failed to verify aggregate signature to update Seed")
 	}
 	return aggregateSig, nil
 }
 
+// attachAggregatedCommits aggregates the DKG signed commit endorsements of the block and attaches them as its footer
+func (ctx *rollDPoSCtx) attachAggregatedCommits(blk *block.Block) error {
+	endorsementSet, ok := ctx.round.endorsementSets[hex.EncodeToString(ctx.round.block.Hash())]
+	if !ok {
+		return errors.New("no endorsement of the pending block")
+	}
+	delegates, dkgPubkeys, err := ctx.epochDelegates(blk.Height())
+	if err != nil {
+		return errors.Wrap(err, "failed to load the delegates of the epoch")
+	}
+	if len(blk.DKGPubkey()) > 0 {
+		dkgPubkeys[blk.ProducerAddress()] = blk.DKGPubkey()
+	}
+	aggregated, err := endorsementSet.AggregateCommits(blk.Height(), ctx.round.number, delegates, dkgPubkeys)
+	if err != nil {
+		return err
+	}
+	if err := aggregated.VerifyAggregatedCommits(blk.Height(), delegates, dkgPubkeys); err != nil {
+		return errors.Wrap(err, "failed to verify aggregated commit endorsements")
+	}
+	blk.Footer = block.NewFooter(aggregated, uint64(ctx.clock.Now().Unix()))
+	return nil
+}
+
+// VerifyAggregatedCommits verifies the aggregated commit endorsements in the footer of a block against the delegates
+// of the epoch which the block belongs to
+func (ctx *rollDPoSCtx) VerifyAggregatedCommits(blk *block.Block) error {
+	delegates, dkgPubkeys, err := ctx.epochDelegates(blk.Height())
+	if err != nil {
+		return errors.Wrap(err, "failed to load the delegates of the epoch")
+	}
+	if len(blk.DKGPubkey()) > 0 {
+		dkgPubkeys[blk.ProducerAddress()] = blk.DKGPubkey()
+	}
+	return blk.Footer.Endorsements().VerifyAggregatedCommits(blk.Height(), delegates, dkgPubkeys)
+}
+
+// epochCopy returns a copy of the context of the current epoch, which is safe to read out of the FSM
+func (ctx *rollDPoSCtx) epochCopy() epochCtx {
+	ctx.epochMutex.RLock()
+	defer ctx.epochMutex.RUnlock()
+	return ctx.epoch
+}
+
+// epochDelegates returns the delegates of the epoch which the height belongs to, and the DKG public keys of them
+// carried by the blocks of the epoch committed before the height. The delegate data is loaded from the chain, so
+// that the blocks of past epochs are verified the same way as those of the current one.
+func (ctx *rollDPoSCtx) epochDelegates(height uint64) ([]string, map[string][]byte, error) {
+	if height == 0 {
+		return nil, nil, errors.New("the genesis block does not belong to any epoch")
+	}
+	numBlocks := uint64(ctx.cfg.NumDelegates) * uint64(ctx.getNumSubEpochs())
+	epochNum := (height-1)/numBlocks + 1
+	epochHeight := numBlocks*(epochNum-1) + 1
+
+	cache := &ctx.epochDelegatesCache
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+	data, ok := cache.epochs[epochNum]
+	if !ok {
+		delegates, err := ctx.rollingDelegates(epochNum, ctx.epochSeed(epochNum))
+		if err != nil {
+			return nil, nil, err
+		}
+		data = &epochDelegateData{
+			delegates:  delegates,
+			nextHeight: epochHeight,
+			dkgPubkeys: make(map[string][]byte),
+		}
+		// Only the epochs being verified recently are kept
+		if len(cache.epochs) >= maxCachedEpochs {
+			cache.epochs = nil
+		}
+		if cache.epochs == nil {
+			cache.epochs = make(map[uint64]*epochDelegateData)
+		}
+		cache.epochs[epochNum] = data
+	}
+	for ; data.nextHeight < height && data.nextHeight <= ctx.chain.TipHeight(); data.nextHeight++ {
+		blk, err := ctx.chain.GetBlockByHeight(data.nextHeight)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "failed to get block %d", data.nextHeight)
+		}
+		if len(blk.DKGPubkey()) > 0 {
+			data.dkgPubkeys[blk.ProducerAddress()] = blk.DKGPubkey()
+		}
+	}
+	dkgPubkeys := make(map[string][]byte, len(data.dkgPubkeys)+1)
+	for addr, pubkey := range data.dkgPubkeys {
+		dkgPubkeys[addr] = pubkey
+	}
+	return data.delegates, dkgPubkeys, nil
+}
+
+// epochSeed returns the seed of the epoch, which is derived from the seeds of the past epochs. The seeds are cached,
+// and the caller must hold the lock of the cache.
+func (ctx *rollDPoSCtx) epochSeed(epochNum uint64) []byte {
+	if !ctx.cfg.EnableDKG {
+		return crypto.CryptoSeed
+	}
+	cache := &ctx.epochDelegatesCache
+	if cache.seeds == nil {
+		cache.seeds = map[uint64][]byte{1: crypto.CryptoSeed}
+	}
+	num := epochNum
+	for ; num > 1; num-- {
+		if _, ok := cache.seeds[num]; ok {
+			break
+		}
+	}
+	for ; num < epochNum; num++ {
+		// The hash of the last seed is taken when the DKG signatures cannot be aggregated, as the FSM does
+		seed, err := ctx.calcSeed(num+1, cache.seeds[num])
+		if err != nil {
+			log.L().Debug("Failed to aggregate the seed of the epoch.", zap.Uint64("epoch", num+1), zap.Error(err))
+		}
+		cache.seeds[num+1] = seed
+	}
+	return cache.seeds[epochNum]
+}
+
+// epochDelegateData keeps the delegates of an epoch and the DKG public keys carried by the blocks of the epoch
+type epochDelegateData struct {
+	delegates  []string
+	nextHeight uint64
+	dkgPubkeys map[string][]byte
+}
+
+// epochDelegatesCache caches the delegate data and the seeds of the epochs
+type epochDelegatesCache struct {
+	mu     sync.Mutex
+	epochs map[uint64]*epochDelegateData
+	seeds  map[uint64][]byte
+}
+
 // epochCtx keeps the context data for the current epoch
 type epochCtx struct {
 	// num is the ordinal number of an epoch
@@ -631,12 +794,13 @@ func (r *RollDPoS) HandleConsensusMsg(msg *iproto.ConsensusPb) error {
 func (r *RollDPoS) Metrics() (scheme.ConsensusMetrics, error) {
 	var metrics scheme.ConsensusMetrics
 	// Compute the epoch ordinal number
-	epochNum, _, err := r.ctx.calcEpochNumAndHeight()
+	epochNum, epochHeight, err := r.ctx.calcEpochNumAndHeight()
 	if err != nil {
 		return metrics, errors.Wrap(err, "error when calculating the epoch ordinal number")
 	}
 	// Compute delegates
-	delegates, err := r.ctx.rollingDelegates(epochNum)
+	seed := r.ctx.epochCopy().seed
+	delegates, err := r.ctx.rollingDelegates(epochNum, seed)
 	if err != nil {
 		return metrics, errors.Wrap(err, "error when getting the rolling delegates")
 	}
@@ -657,7 +821,12 @@ func (r *RollDPoS) Metrics() (scheme.ConsensusMetrics, error) {
 		candidateAddresses[i] = c.Address
 	}
 
-	crypto.SortCandidates(candidateAddresses, epochNum, r.ctx.epoch.seed)
+	crypto.SortCandidates(candidateAddresses, epochNum, seed)
+	// Count the blocks produced in the epoch
+	productivity, err := r.ctx.chain.Productivity(epochHeight, height)
+	if err != nil {
+		return metrics, errors.Wrap(err, "error when counting the blocks produced in the epoch")
+	}
 
 	return scheme.ConsensusMetrics{
 		LatestEpoch:         epochNum,
@@ -665,9 +834,15 @@ func (r *RollDPoS) Metrics() (scheme.ConsensusMetrics, error) {
 		LatestDelegates:     delegates,
 		LatestBlockProducer: producer,
 		Candidates:          candidateAddresses,
+		Productivity:        productivity,
 	}, nil
 }
 
+// VerifyAggregatedCommits verifies the aggregated commit endorsements in the footer of a block
+func (r *RollDPoS) VerifyAggregatedCommits(blk *block.Block) error {
+	return r.ctx.VerifyAggregatedCommits(blk)
+}
+
 // NumPendingEvts returns the number of pending events
 func (r *RollDPoS) NumPendingEvts() int {
 	return len(r.cfsm.evtq)
@@ -103,7 +103,7 @@ func TestRollDPoSCtx(t *testing.T) {
 	assert.Equal(t, uint64(0), subEpoch)
 
 	ctx.epoch.seed = crypto.CryptoSeed
-	delegates, err := ctx.rollingDelegates(epoch)
+	delegates, err := ctx.rollingDelegates(epoch, ctx.epoch.seed)
 	require.NoError(t, err)
 	crypto.SortCandidates(candidates, epoch, crypto.CryptoSeed)
 	assert.Equal(t, candidates, delegates)
@@ -150,6 +150,77 @@ func TestRollDPoSCtx(t *testing.T) {
 	assert.True(t, no)
 }
 
+func TestEpochDelegates(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	candidates := make([]string, 4)
+	for i := 0; i < len(candidates); i++ {
+		candidates[i] = testAddrs[i].RawAddress
+	}
+	clock := clock.NewMock()
+	newBlock := func(height uint64, producer *iotxaddress.Address, dkgPubkey []byte) *block.Block {
+		blk, err := block.NewTestingBuilder().
+			SetChainID(config.Default.Chain.ID).
+			SetHeight(height).
+			SetTimeStamp(testutil.TimestampNowFromClock(clock)).
+			SetDKG(iotxaddress.CreateID(producer.RawAddress), dkgPubkey, nil).
+			SignAndBuild(producer)
+		require.NoError(t, err)
+		return &blk
+	}
+	ctx := makeTestRollDPoSCtx(
+		testAddrs[0],
+		ctrl,
+		config.RollDPoS{
+			NumSubEpochs: 1,
+			NumDelegates: 4,
+		},
+		func(blockchain *mock_blockchain.MockBlockchain) {
+			blockchain.EXPECT().TipHeight().Return(uint64(6)).AnyTimes()
+			// The blocks of the epoch are only scanned once
+			blockchain.EXPECT().GetBlockByHeight(uint64(5)).Return(newBlock(5, testAddrs[0], []byte("pk0")), nil).Times(1)
+			blockchain.EXPECT().GetBlockByHeight(uint64(6)).Return(newBlock(6, testAddrs[1], nil), nil).Times(1)
+			blockchain.EXPECT().CandidatesByHeight(gomock.Any()).Return([]*state.Candidate{
+				{Address: candidates[0]},
+				{Address: candidates[1]},
+				{Address: candidates[2]},
+				{Address: candidates[3]},
+			}, nil).Times(2)
+		},
+		func(_ *mock_actpool.MockActPool) {},
+		nil,
+		clock,
+	)
+
+	// The delegates of the second epoch, which starts at height 5, are loaded from the chain
+	delegates, dkgPubkeys, err := ctx.epochDelegates(7)
+	require.NoError(t, err)
+	sorted := append([]string{}, candidates...)
+	crypto.SortCandidates(sorted, 2, crypto.CryptoSeed)
+	assert.Equal(t, sorted, delegates)
+	assert.Equal(t, map[string][]byte{candidates[0]: []byte("pk0")}, dkgPubkeys)
+	// The cached data is reused, and the returned keys are a copy
+	dkgPubkeys[candidates[1]] = []byte("pk1")
+	delegates, dkgPubkeys, err = ctx.epochDelegates(8)
+	require.NoError(t, err)
+	assert.Equal(t, sorted, delegates)
+	assert.Equal(t, map[string][]byte{candidates[0]: []byte("pk0")}, dkgPubkeys)
+
+	// A past epoch is loaded the same way
+	delegates, dkgPubkeys, err = ctx.epochDelegates(1)
+	require.NoError(t, err)
+	sorted = append([]string{}, candidates...)
+	crypto.SortCandidates(sorted, 1, crypto.CryptoSeed)
+	assert.Equal(t, sorted, delegates)
+	assert.Empty(t, dkgPubkeys)
+
+	_, _, err = ctx.epochDelegates(0)
+	require.Error(t, err)
+}
+
 func TestIsEpochFinished(t *testing.T) {
 	t.Parallel()
 
@@ -433,6 +504,7 @@ func TestRollDPoS_Metrics(t *testing.T) {
 		{Address: candidates[3]},
 		{Address: candidates[4]},
 	}, nil).AnyTimes()
+	blockchain.EXPECT().Productivity(uint64(9), uint64(8)).Return(map[string]uint64{}, nil).Times(1)
 
 	r, err := NewRollDPoSBuilder().
 		SetConfig(config.RollDPoS{NumDelegates: 4}).
@@ -453,6 +525,7 @@ func TestRollDPoS_Metrics(t *testing.T) {
 	assert.Equal(t, candidates[:4], m.LatestDelegates)
 	assert.Equal(t, candidates[1], m.LatestBlockProducer)
 	assert.Equal(t, candidates, m.Candidates)
+	assert.Equal(t, map[string]uint64{}, m.Productivity)
 }
 
 func TestRollDPoS_convertToConsensusEvt(t *testing.T) {
@@ -542,14 +615,14 @@ func TestRollDPoS_convertToConsensusEvt(t *testing.T) {
 func TestUpdateSeed(t *testing.T) {
 	require := require.New(t)
 	lastSeed, _ := hex.DecodeString("9de6306b08158c423330f7a27243a1a5cbe39bfd764f07818437882d21241567")
-	chain := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	chain, err := blockchain.NewBlockchain(config.Default, blockchain.InMemStateFactoryOption(), blockchain.InMemDaoOption())
+	require.NoError(err)
 	chain.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(chain))
 	chain.Validator().AddActionValidators(account.NewProtocol())
 	require.NoError(chain.Start(context.Background()))
 	ctx := rollDPoSCtx{cfg: config.Default.Consensus.RollDPoS, chain: chain, epoch: epochCtx{seed: lastSeed}}
 	fsm := cFSM{ctx: &ctx}
 
-	var err error
 	const numNodes = 21
 	addresses := make([]string, numNodes)
 	skList := make([][]uint32, numNodes)
@@ -765,7 +838,8 @@ func TestRollDPoSConsensus(t *testing.T) {
 				require.NoError(t, err)
 				require.NoError(t, sf.Commit(ws))
 			}
-			chain := blockchain.NewBlockchain(cfg, blockchain.InMemDaoOption(), blockchain.PrecreatedStateFactoryOption(sf))
+			chain, err := blockchain.NewBlockchain(cfg, blockchain.InMemDaoOption(), blockchain.PrecreatedStateFactoryOption(sf))
+			require.NoError(t, err)
 			chain.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(chain))
 			chain.Validator().AddActionValidators(account.NewProtocol())
 			chains = append(chains, chain)
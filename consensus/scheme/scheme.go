@@ -44,4 +44,6 @@ type ConsensusMetrics struct {
 	LatestDelegates     []string
 	LatestBlockProducer string
 	Candidates          []string
+	// Productivity is the number of blocks each producer has produced in the latest epoch
+	Productivity map[string]uint64
 }
@@ -4,6 +4,9 @@
 // permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
 // License 2.0 that can be found in the LICENSE file.
 
+//go:build !nocgo
+// +build !nocgo
+
 package crypto
 
 //#include "lib/blslib/bls.h"
@@ -21,27 +24,8 @@ import (
 	"github.com/pkg/errors"
 )
 
-const (
-	// Degree is used for threshold BLS
-	Degree      = 10
-	idlength    = 32
-	sigSize     = 5 // number of uint32s in sig
-	privkeySize = 5
-	numnodes    = 21
-)
-
-var (
-	// BLS represents a bls struct singleton that contains the set of cryptography functions
-	BLS bls
-	// ErrSignError indicates error for failing to sign
-	ErrSignError = errors.New("Could not sign message")
-	// ErrKeyGeneration indicates error for failing to generate keys
-	ErrKeyGeneration = errors.New("Could not generate keys")
-	// ErrInvalidKey indicates error for public key
-	ErrInvalidKey = errors.New("Key is invalid")
-	// ErrInvalidSignature indicates error for signature
-	ErrInvalidSignature = errors.New("Signature is invalid")
-)
+// BLS represents a bls struct singleton that contains the set of cryptography functions
+var BLS bls
 
 type bls struct {
 }
@@ -0,0 +1,31 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import "github.com/pkg/errors"
+
+const (
+	// Degree is used for threshold BLS
+	Degree      = 10
+	idlength    = 32
+	sigSize     = 5 // number of uint32s in sig
+	privkeySize = 5
+	numnodes    = 21
+)
+
+var (
+	// ErrSignError indicates error for failing to sign
+	ErrSignError = errors.New("Could not sign message")
+	// ErrKeyGeneration indicates error for failing to generate keys
+	ErrKeyGeneration = errors.New("Could not generate keys")
+	// ErrInvalidKey indicates error for public key
+	ErrInvalidKey = errors.New("Key is invalid")
+	// ErrInvalidSignature indicates error for signature
+	ErrInvalidSignature = errors.New("Signature is invalid")
+	// ErrNoCgo indicates that the function relies on the cgo library, which is excluded by the nocgo build tag
+	ErrNoCgo = errors.New("not supported in a nocgo build")
+)
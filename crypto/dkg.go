@@ -4,6 +4,9 @@
 // permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
 // License 2.0 that can be found in the LICENSE file.
 
+//go:build !nocgo
+// +build !nocgo
+
 package crypto
 
 //#include "lib/blslib/dkg.h"
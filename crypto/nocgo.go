@@ -0,0 +1,130 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+//go:build nocgo
+// +build nocgo
+
+package crypto
+
+import (
+	"crypto/rand"
+
+	"github.com/iotexproject/iotex-core/pkg/keypair"
+)
+
+// The functions below stand in for the cgo based ec283, ec160, bls and dkg implementations when building with the
+// nocgo tag. Key generation and signing fail with ErrNoCgo and verification always fails, so a nocgo node only accepts
+// signatures of the pure Go schemes, such as secp256k1.
+
+var (
+	// EC283 represents an ec283 struct singleton. It is not functional in a nocgo build.
+	EC283 ec283
+	// EC160 represents an ec160 struct singleton. It is not functional in a nocgo build.
+	EC160 ec160
+	// BLS represents a bls struct singleton. It is not functional in a nocgo build.
+	BLS bls
+	// DKG represents a dkg struct singleton. It is not functional in a nocgo build.
+	DKG dkg
+)
+
+type (
+	ec283 struct{}
+	ec160 struct{}
+	bls   struct{}
+	dkg   struct{}
+)
+
+// NewKeyPair generates a new public/private key pair
+func (c *ec283) NewKeyPair() (keypair.PublicKey, keypair.PrivateKey, error) {
+	return keypair.ZeroPublicKey, keypair.ZeroPrivateKey, ErrNoCgo
+}
+
+// NewPubKey generates a new public key
+func (c *ec283) NewPubKey(priv keypair.PrivateKey) (keypair.PublicKey, error) {
+	return keypair.ZeroPublicKey, ErrNoCgo
+}
+
+// Sign signs the msg
+func (c *ec283) Sign(priv keypair.PrivateKey, msg []byte) []byte { return nil }
+
+// Verify verifies the signature
+func (c *ec283) Verify(pub keypair.PublicKey, msg []byte, sig []byte) bool { return false }
+
+// NewKeyPair generates a new public/private key pair
+func (c *ec160) NewKeyPair() ([]byte, []byte, error) { return nil, nil, ErrNoCgo }
+
+// NewPubKey generates a new public key
+func (c *ec160) NewPubKey(priv []byte) ([]byte, error) { return nil, ErrNoCgo }
+
+// Sign signs the msg
+func (c *ec160) Sign(priv []byte, msg []byte) []byte { return nil }
+
+// Verify verifies the signature
+func (c *ec160) Verify(pub []byte, msg []byte, sig []byte) bool { return false }
+
+// NewPubKey generate public key from secret key
+func (b *bls) NewPubKey(sk []uint32) ([]byte, error) { return []byte{}, ErrNoCgo }
+
+// Sign signs a message given a private key
+func (b *bls) Sign(privkey []uint32, msg []byte) (bool, []byte, error) {
+	return false, []byte{}, ErrNoCgo
+}
+
+// Verify verifies a signature given a message and a public key
+func (b *bls) Verify(pubkey []byte, msg []byte, signature []byte) error { return ErrNoCgo }
+
+// PkValidation returns whether a public key is valid or not
+func (b *bls) PkValidation(pk []byte) error { return ErrNoCgo }
+
+// SignShare signs the message and returns the signature
+func (b *bls) SignShare(privkey []uint32, msg []byte) (bool, []byte, error) {
+	return b.Sign(privkey, msg)
+}
+
+// VerifyShare verifies a signature given a message and a public key
+func (b *bls) VerifyShare(pubkey []byte, msg []byte, sig []byte) error {
+	return b.Verify(pubkey, msg, sig)
+}
+
+// SignAggregate generates an aggregate signature
+func (b *bls) SignAggregate(ids [][]uint8, sigs [][]byte) ([]byte, error) { return []byte{}, ErrNoCgo }
+
+// VerifyAggregate verifies the aggregate signature given that there are at least Degree+1 signers
+func (b *bls) VerifyAggregate(ids [][]uint8, pubkeys [][]byte, msg []byte, aggsig []byte) error {
+	return ErrNoCgo
+}
+
+// KeyPairGeneration generates a dkg key pair
+func (d *dkg) KeyPairGeneration(shares [][]uint32, statusMatrix [][numnodes]bool) ([]byte, []byte, []uint32, error) {
+	return []byte{}, []byte{}, []uint32{}, ErrNoCgo
+}
+
+// SkGeneration generates a secret key
+func (d *dkg) SkGeneration() []uint32 { return make([]uint32, privkeySize) }
+
+// Init initializes the DKG protocol
+func (d *dkg) Init(ms []uint32, ids [][]uint8) ([][]uint32, [][]uint32, [][]byte, error) {
+	return nil, nil, nil, ErrNoCgo
+}
+
+// SharesCollect collects the shares and verifies them
+func (d *dkg) SharesCollect(id []uint8, shares [][]uint32, witnesses [][][]byte) ([numnodes]bool, error) {
+	return [numnodes]bool{}, ErrNoCgo
+}
+
+// ShareVerify verifies a share
+func (d *dkg) ShareVerify(id []uint8, share []uint32, witness [][]byte) (bool, error) {
+	return false, ErrNoCgo
+}
+
+// RndGenerate generates a random byte array of IDLENGTH size
+func RndGenerate() []uint8 {
+	result := make([]uint8, idlength)
+	if _, err := rand.Read(result); err != nil {
+		return nil
+	}
+	return result
+}
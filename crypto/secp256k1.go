@@ -0,0 +1,88 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
+)
+
+// Secp256k1 represents a secp256k1 struct singleton that contains the set of cryptography functions based on the
+// elliptic curve secp256k1. It is implemented in pure Go, so it is available in builds without cgo.
+var Secp256k1 secp256k1
+
+type secp256k1 struct {
+}
+
+// NewKeyPair generates a new public/private key pair
+func (c *secp256k1) NewKeyPair() (keypair.PublicKey, keypair.PrivateKey, error) {
+	sk, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return keypair.ZeroPublicKey, keypair.ZeroPrivateKey, errors.Wrap(err, "failed to generate secp256k1 key")
+	}
+	priv, err := keypair.PackPrivateKey(keypair.Secp256k1, c.privateKeySerialization(sk))
+	if err != nil {
+		return keypair.ZeroPublicKey, keypair.ZeroPrivateKey, err
+	}
+	pub, err := keypair.PackPublicKey(keypair.Secp256k1, sk.PubKey().SerializeCompressed())
+	if err != nil {
+		return keypair.ZeroPublicKey, keypair.ZeroPrivateKey, err
+	}
+	return pub, priv, nil
+}
+
+// NewPubKey generates a new public key
+func (c *secp256k1) NewPubKey(priv keypair.PrivateKey) (keypair.PublicKey, error) {
+	raw, err := keypair.UnpackPrivateKey(keypair.Secp256k1, priv)
+	if err != nil {
+		return keypair.ZeroPublicKey, err
+	}
+	_, pk := btcec.PrivKeyFromBytes(btcec.S256(), raw)
+	return keypair.PackPublicKey(keypair.Secp256k1, pk.SerializeCompressed())
+}
+
+// Sign signs the msg
+func (c *secp256k1) Sign(priv keypair.PrivateKey, msg []byte) []byte {
+	raw, err := keypair.UnpackPrivateKey(keypair.Secp256k1, priv)
+	if err != nil {
+		return nil
+	}
+	sk, _ := btcec.PrivKeyFromBytes(btcec.S256(), raw)
+	sig, err := sk.Sign(hash.Hash256b(msg))
+	if err != nil {
+		return nil
+	}
+	return sig.Serialize()
+}
+
+// Verify verifies the signature
+func (c *secp256k1) Verify(pub keypair.PublicKey, msg []byte, sig []byte) bool {
+	raw, err := keypair.UnpackPublicKey(keypair.Secp256k1, pub)
+	if err != nil {
+		return false
+	}
+	pk, err := btcec.ParsePubKey(raw, btcec.S256())
+	if err != nil {
+		return false
+	}
+	signature, err := btcec.ParseDERSignature(sig, btcec.S256())
+	if err != nil {
+		return false
+	}
+	return signature.Verify(hash.Hash256b(msg), pk)
+}
+
+func (*secp256k1) privateKeySerialization(sk *btcec.PrivateKey) []byte {
+	// D may be shorter than 32 bytes, so left pad it
+	raw := make([]byte, btcec.PrivKeyBytesLen)
+	d := sk.D.Bytes()
+	copy(raw[btcec.PrivKeyBytesLen-len(d):], d)
+	return raw
+}
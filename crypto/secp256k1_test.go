@@ -0,0 +1,41 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/pkg/keypair"
+)
+
+func TestSecp256k1SignVerify(t *testing.T) {
+	require := require.New(t)
+	pub, pri, err := Secp256k1.NewKeyPair()
+	require.NoError(err)
+	require.Equal(keypair.Secp256k1, keypair.PublicKeyType(pub))
+	require.Equal(keypair.Secp256k1, keypair.PrivateKeyType(pri))
+
+	actualPub, err := Secp256k1.NewPubKey(pri)
+	require.NoError(err)
+	require.Equal(pub, actualPub)
+
+	message := []byte("hello iotex message")
+	sig := Secp256k1.Sign(pri, message)
+	require.True(Secp256k1.Verify(pub, message, sig))
+	require.True(Verify(pub, message, sig))
+	// signatures are deterministic as per RFC6979
+	require.Equal(sig, Sign(pri, message))
+
+	wrongMessage := []byte("wrong message")
+	require.False(Secp256k1.Verify(pub, wrongMessage, sig))
+
+	otherPub, _, err := Secp256k1.NewKeyPair()
+	require.NoError(err)
+	require.False(Secp256k1.Verify(otherPub, message, sig))
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/keypair"
+)
+
+// NewKeyPair generates a new public/private key pair of the given key type
+func NewKeyPair(t keypair.KeyType) (keypair.PublicKey, keypair.PrivateKey, error) {
+	switch t {
+	case keypair.EC283:
+		return EC283.NewKeyPair()
+	case keypair.Secp256k1:
+		return Secp256k1.NewKeyPair()
+	default:
+		return keypair.ZeroPublicKey, keypair.ZeroPrivateKey, errors.Wrapf(ErrInvalidKey, "unsupported key type %d", t)
+	}
+}
+
+// NewPubKey generates the public key of a private key, choosing the scheme by the key type tag
+func NewPubKey(priv keypair.PrivateKey) (keypair.PublicKey, error) {
+	switch keypair.PrivateKeyType(priv) {
+	case keypair.EC283:
+		return EC283.NewPubKey(priv)
+	case keypair.Secp256k1:
+		return Secp256k1.NewPubKey(priv)
+	default:
+		return keypair.ZeroPublicKey, errors.Wrap(ErrInvalidKey, "unsupported private key type")
+	}
+}
+
+// Sign signs the msg, choosing the scheme by the key type tag of the private key
+func Sign(priv keypair.PrivateKey, msg []byte) []byte {
+	switch keypair.PrivateKeyType(priv) {
+	case keypair.EC283:
+		return EC283.Sign(priv, msg)
+	case keypair.Secp256k1:
+		return Secp256k1.Sign(priv, msg)
+	default:
+		return nil
+	}
+}
+
+// Verify verifies the signature, choosing the scheme by the key type tag of the public key
+func Verify(pub keypair.PublicKey, msg []byte, sig []byte) bool {
+	switch keypair.PublicKeyType(pub) {
+	case keypair.EC283:
+		return EC283.Verify(pub, msg, sig)
+	case keypair.Secp256k1:
+		return Secp256k1.Verify(pub, msg, sig)
+	default:
+		return false
+	}
+}
@@ -85,10 +85,12 @@ func TestLocalActPool(t *testing.T) {
 	tsf1, err := testutil.SignedTransfer(from, to, uint64(1), big.NewInt(1),
 		[]byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
+	tsf1Pb, err := tsf1.Proto()
+	require.NoError(err)
 	p2pCtx := p2p.WitContext(ctx, p2p.Context{ChainID: chainID})
 	// Wait until server receives the 1st action
 	require.NoError(testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
-		require.NoError(cli.Broadcast(p2pCtx, tsf1.Proto()))
+		require.NoError(cli.Broadcast(p2pCtx, tsf1Pb))
 		acts := svr.ChainService(chainID).ActionPool().PickActs()
 		return len(acts) == 1, nil
 	}))
@@ -107,10 +109,11 @@ func TestLocalActPool(t *testing.T) {
 	vote5, err := testutil.SignedVote(from, from, uint64(2), uint64(100000), big.NewInt(0))
 	require.NoError(err)
 
-	require.NoError(cli.Broadcast(p2pCtx, vote2.Proto()))
-	require.NoError(cli.Broadcast(p2pCtx, tsf3.Proto()))
-	require.NoError(cli.Broadcast(p2pCtx, exec4.Proto()))
-	require.NoError(cli.Broadcast(p2pCtx, vote5.Proto()))
+	for _, selp := range []action.SealedEnvelope{vote2, tsf3, exec4, vote5} {
+		actPb, err := selp.Proto()
+		require.NoError(err)
+		require.NoError(cli.Broadcast(p2pCtx, actPb))
+	}
 
 	// Wait until server receives all the transfers
 	require.NoError(testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
@@ -167,9 +170,11 @@ func TestPressureActPool(t *testing.T) {
 	tsf, err := testutil.SignedTransfer(from, to, 1, big.NewInt(int64(0)),
 		[]byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
+	tsfPb, err := tsf.Proto()
+	require.NoError(err)
 	// Wait until server receives the 1st action
 	require.NoError(testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
-		require.NoError(cli.Broadcast(p2pCtx, tsf.Proto()))
+		require.NoError(cli.Broadcast(p2pCtx, tsfPb))
 		acts := svr.ChainService(chainID).ActionPool().PickActs()
 		return len(acts) == 1, nil
 	}))
@@ -178,7 +183,9 @@ func TestPressureActPool(t *testing.T) {
 		tsf, err := testutil.SignedTransfer(from, to, uint64(i), big.NewInt(int64(i)),
 			[]byte{}, uint64(100000), big.NewInt(0))
 		require.NoError(err)
-		require.NoError(cli.Broadcast(p2pCtx, tsf.Proto()))
+		tsfPb, err := tsf.Proto()
+		require.NoError(err)
+		require.NoError(cli.Broadcast(p2pCtx, tsfPb))
 	}
 
 	// Wait until committed blocks contain all broadcasted actions
@@ -154,7 +154,8 @@ func TestLocalCommit(t *testing.T) {
 	cfg.Chain.ChainDBPath = testDBPath2
 	require.NoError(copyDB(testTriePath, testTriePath2))
 	require.NoError(copyDB(testDBPath, testDBPath2))
-	chain := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+	chain, err := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+	require.NoError(err)
 	chain.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(chain))
 	chain.Validator().AddActionValidators(account.NewProtocol())
 	require.NotNil(chain)
@@ -174,7 +175,8 @@ func TestLocalCommit(t *testing.T) {
 	tsf1, err := testutil.SignedTransfer(ta.IotxAddrinfo["charlie"], ta.IotxAddrinfo["alfa"], s.Nonce+1, big.NewInt(1), []byte{}, 100000, big.NewInt(0))
 	require.NoError(err)
 
-	act1 := tsf1.Proto()
+	act1, err := tsf1.Proto()
+	require.NoError(err)
 	err = testutil.WaitUntil(10*time.Millisecond, 2*time.Second, func() (bool, error) {
 		if err := p.Broadcast(p2pCtx, act1); err != nil {
 			return false, err
@@ -202,7 +204,8 @@ func TestLocalCommit(t *testing.T) {
 	require.Nil(chain.ValidateBlock(blk2, true))
 	require.Nil(chain.CommitBlock(blk2))
 	// broadcast to P2P
-	act2 := tsf2.Proto()
+	act2, err := tsf2.Proto()
+	require.NoError(err)
 	err = testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
 		if err := p.Broadcast(p2pCtx, act2); err != nil {
 			return false, err
@@ -223,7 +226,8 @@ func TestLocalCommit(t *testing.T) {
 	require.Nil(chain.ValidateBlock(blk3, true))
 	require.Nil(chain.CommitBlock(blk3))
 	// broadcast to P2P
-	act3 := tsf3.Proto()
+	act3, err := tsf3.Proto()
+	require.NoError(err)
 	err = testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
 		if err := p.Broadcast(p2pCtx, act3); err != nil {
 			return false, err
@@ -244,7 +248,8 @@ func TestLocalCommit(t *testing.T) {
 	require.Nil(chain.ValidateBlock(blk4, true))
 	require.Nil(chain.CommitBlock(blk4))
 	// broadcast to P2P
-	act4 := tsf4.Proto()
+	act4, err := tsf4.Proto()
+	require.NoError(err)
 	err = testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
 		if err := p.Broadcast(p2pCtx, act4); err != nil {
 			return false, err
@@ -508,7 +513,8 @@ func TestVoteLocalCommit(t *testing.T) {
 	cfg.Chain.ChainDBPath = testDBPath2
 	require.NoError(copyDB(testTriePath, testTriePath2))
 	require.NoError(copyDB(testDBPath, testDBPath2))
-	chain := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+	chain, err := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+	require.NoError(err)
 	chain.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(chain))
 	chain.Validator().AddActionValidators(account.NewProtocol(),
 		vote.NewProtocol(chain))
@@ -545,13 +551,20 @@ func TestVoteLocalCommit(t *testing.T) {
 	vote3, err := testutil.SignedVote(ta.IotxAddrinfo["charlie"], ta.IotxAddrinfo["charlie"], 6, 100000, big.NewInt(0))
 	require.NoError(err)
 
-	act1 := vote1.Proto()
-	act2 := vote2.Proto()
-	act3 := vote3.Proto()
-	acttsf1 := tsf1.Proto()
-	acttsf2 := tsf2.Proto()
-	acttsf3 := tsf3.Proto()
-	acttsf4 := tsf4.Proto()
+	act1, err := vote1.Proto()
+	require.NoError(err)
+	act2, err := vote2.Proto()
+	require.NoError(err)
+	act3, err := vote3.Proto()
+	require.NoError(err)
+	acttsf1, err := tsf1.Proto()
+	require.NoError(err)
+	acttsf2, err := tsf2.Proto()
+	require.NoError(err)
+	acttsf3, err := tsf3.Proto()
+	require.NoError(err)
+	acttsf4, err := tsf4.Proto()
+	require.NoError(err)
 
 	p2pCtx := p2p.WitContext(ctx, p2p.Context{ChainID: cfg.Chain.ID})
 	err = testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
@@ -608,8 +621,10 @@ func TestVoteLocalCommit(t *testing.T) {
 	require.Nil(chain.ValidateBlock(blk2, true))
 	require.Nil(chain.CommitBlock(blk2))
 	// broadcast to P2P
-	act4 := vote4.Proto()
-	act5 := vote5.Proto()
+	act4, err := vote4.Proto()
+	require.NoError(err)
+	act5, err := vote5.Proto()
+	require.NoError(err)
 	err = testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
 		if err := p.Broadcast(p2pCtx, act4); err != nil {
 			return false, err
@@ -654,7 +669,8 @@ func TestVoteLocalCommit(t *testing.T) {
 	require.Nil(chain.ValidateBlock(blk3, true))
 	require.Nil(chain.CommitBlock(blk3))
 	// broadcast to P2P
-	act6 := vote6.Proto()
+	act6, err := vote6.Proto()
+	require.NoError(err)
 	err = testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
 		if err := p.Broadcast(p2pCtx, act6); err != nil {
 			return false, err
@@ -702,7 +718,8 @@ func TestVoteLocalCommit(t *testing.T) {
 	require.Nil(chain.ValidateBlock(blk4, true))
 	require.Nil(chain.CommitBlock(blk4))
 	// broadcast to P2P
-	act7 := selp.Proto()
+	act7, err := selp.Proto()
+	require.NoError(err)
 	err = testutil.WaitUntil(100*time.Millisecond, 60*time.Second, func() (bool, error) {
 		if err := p.Broadcast(p2pCtx, act7); err != nil {
 			return false, err
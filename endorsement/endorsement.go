@@ -66,6 +66,8 @@ type Endorsement struct {
 	endorser       string
 	endorserPubkey keypair.PublicKey
 	signature      []byte
+	// dkgSignature is the BLS signature share of the vote with endorser's DKG private key, which is optional
+	dkgSignature []byte
 }
 
 // NewEndorsement creates an Endorsement for an consensus vote
@@ -75,7 +77,7 @@ func NewEndorsement(object *ConsensusVote, endorser *iotxaddress.Address) *Endor
 		object:         object,
 		endorser:       endorser.RawAddress,
 		endorserPubkey: endorser.PublicKey,
-		signature:      crypto.EC283.Sign(endorser.PrivateKey, hash[:]),
+		signature:      crypto.Sign(endorser.PrivateKey, hash[:]),
 	}
 }
 
@@ -99,10 +101,26 @@ func (en *Endorsement) Signature() []byte {
 	return en.signature
 }
 
+// DKGSignature returns the DKG signature share of this endorsement
+func (en *Endorsement) DKGSignature() []byte {
+	return en.dkgSignature
+}
+
+// SignDKG signs the consensus vote with the endorser's DKG private key
+func (en *Endorsement) SignDKG(dkgPriKey []uint32) error {
+	hash := en.object.Hash()
+	_, sig, err := crypto.BLS.SignShare(dkgPriKey, hash[:])
+	if err != nil {
+		return errors.Wrap(err, "failed to sign the consensus vote with DKG private key")
+	}
+	en.dkgSignature = sig
+	return nil
+}
+
 // VerifySignature verifies that the endorse with pubkey
 func (en *Endorsement) VerifySignature() bool {
 	hash := en.object.Hash()
-	return crypto.EC283.Verify(en.endorserPubkey, hash[:], en.signature)
+	return crypto.Verify(en.endorserPubkey, hash[:], en.signature)
 }
 
 // ToProtoMsg converts an endorsement to endorse proto
@@ -130,6 +148,7 @@ func (en *Endorsement) ToProtoMsg() *iproto.EndorsePb {
 		EndorserPubKey: pubkey[:],
 		Decision:       true,
 		Signature:      en.Signature(),
+		DkgSignature:   en.DKGSignature(),
 	}
 }
 
@@ -164,5 +183,6 @@ func FromProtoMsg(endorsePb *iproto.EndorsePb) (*Endorsement, error) {
 		endorser:       endorsePb.Endorser,
 		endorserPubkey: pubKey,
 		signature:      endorsePb.Signature,
+		dkgSignature:   endorsePb.DkgSignature,
 	}, nil
 }
@@ -11,6 +11,8 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/iotexproject/iotex-core/crypto"
+	"github.com/iotexproject/iotex-core/iotxaddress"
 	"github.com/iotexproject/iotex-core/proto"
 )
 
@@ -21,6 +23,10 @@ var (
 	ErrInvalidHash = errors.New("the endorsement hash is different from the set")
 	// ErrInvalidEndorsement indicates that the signature of the endorsement is invalid
 	ErrInvalidEndorsement = errors.New("the endorsement's signature is invalid")
+	// ErrNotEnoughDKGSignatures indicates that there are not enough DKG signed commit endorsements to aggregate
+	ErrNotEnoughDKGSignatures = errors.New("not enough DKG signed commit endorsements to aggregate")
+	// ErrInvalidAggregatedSignature indicates that the aggregated signature of the set is invalid
+	ErrInvalidAggregatedSignature = errors.New("the aggregated signature is invalid")
 )
 
 // Set is a collection of endorsements for block
@@ -28,6 +34,11 @@ type Set struct {
 	blkHash      []byte
 	round        uint32 // locked round number
 	endorsements []*Endorsement
+	// aggregatedSignature is the BLS signature aggregated from the DKG signatures of COMMIT endorsements, which
+	// replaces the individual endorsements
+	aggregatedSignature []byte
+	// signerBitmap marks the delegates whose signatures are aggregated, in the order of the delegate list
+	signerBitmap []byte
 }
 
 // NewSet creates an endorsement set
@@ -50,6 +61,8 @@ func (s *Set) FromProto(sPb *iproto.EndorsementSet) error {
 		}
 		s.endorsements = append(s.endorsements, en)
 	}
+	s.aggregatedSignature = sPb.AggregatedSignature
+	s.signerBitmap = sPb.SignerBitmap
 
 	return nil
 }
@@ -124,8 +137,167 @@ func (s *Set) ToProto() *iproto.EndorsementSet {
 	}
 
 	return &iproto.EndorsementSet{
-		BlockHash:    s.blkHash[:],
-		Round:        s.round,
-		Endorsements: endorsements,
+		BlockHash:           s.blkHash[:],
+		Round:               s.round,
+		Endorsements:        endorsements,
+		AggregatedSignature: s.aggregatedSignature,
+		SignerBitmap:        s.signerBitmap,
 	}
 }
+
+// IsAggregated returns true if the endorsements are aggregated into one signature
+func (s *Set) IsAggregated() bool {
+	return len(s.aggregatedSignature) > 0
+}
+
+// AggregatedSignature returns the aggregated signature of the commit endorsements
+func (s *Set) AggregatedSignature() []byte {
+	return s.aggregatedSignature
+}
+
+// SignerBitmap returns the bitmap of the delegates whose signatures are aggregated
+func (s *Set) SignerBitmap() []byte {
+	return s.signerBitmap
+}
+
+// AggregateCommits creates a set which aggregates the DKG signatures of the COMMIT endorsements on the given height
+// and round into one BLS signature. The signers are picked in the order of delegates, which the bitmap refers to,
+// among those whose DKG public keys are known, so that the aggregated signature can be verified. As the threshold
+// signature only takes Degree+1 signers, the COMMIT endorsements of further delegates are kept in the set until the
+// set reaches the 2/3 quorum of the delegates.
+func (s *Set) AggregateCommits(
+	height uint64,
+	round uint32,
+	delegates []string,
+	dkgPubkeys map[string][]byte,
+) (*Set, error) {
+	commits := map[string]*Endorsement{}
+	for _, en := range s.endorsements {
+		vote := en.ConsensusVote()
+		if vote.Topic != COMMIT || vote.Height != height || vote.Round != round {
+			continue
+		}
+		commits[en.Endorser()] = en
+	}
+	ids := make([][]uint8, 0, crypto.Degree+1)
+	sigs := make([][]byte, 0, crypto.Degree+1)
+	bitmap := make([]byte, (len(delegates)+7)/8)
+	for i, delegate := range delegates {
+		if len(ids) > crypto.Degree {
+			break
+		}
+		en, ok := commits[delegate]
+		if !ok || len(en.DKGSignature()) == 0 {
+			continue
+		}
+		if _, ok := dkgPubkeys[delegate]; !ok {
+			continue
+		}
+		ids = append(ids, iotxaddress.CreateID(delegate))
+		sigs = append(sigs, en.DKGSignature())
+		bitmap[i/8] |= 1 << uint(i%8)
+		delete(commits, delegate)
+	}
+	if len(ids) <= crypto.Degree {
+		return nil, errors.Wrapf(ErrNotEnoughDKGSignatures, "%d out of %d required", len(ids), crypto.Degree+1)
+	}
+	endorsements := []*Endorsement{}
+	for _, delegate := range delegates {
+		if len(ids)+len(endorsements) >= quorum(len(delegates)) {
+			break
+		}
+		if en, ok := commits[delegate]; ok {
+			endorsements = append(endorsements, en)
+		}
+	}
+	if len(ids)+len(endorsements) < quorum(len(delegates)) {
+		return nil, errors.Wrapf(
+			ErrNotEnoughDKGSignatures,
+			"%d commits out of %d required",
+			len(ids)+len(endorsements),
+			quorum(len(delegates)),
+		)
+	}
+	aggregatedSig, err := crypto.BLS.SignAggregate(ids, sigs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to aggregate commit endorsements")
+	}
+
+	return &Set{
+		blkHash:             s.blkHash,
+		round:               round,
+		endorsements:        endorsements,
+		aggregatedSignature: aggregatedSig,
+		signerBitmap:        bitmap,
+	}, nil
+}
+
+// VerifyAggregatedCommits verifies the aggregated signature against the COMMIT vote of the given height, where
+// dkgPubkeys maps the delegates to their DKG public keys, and that the aggregated signers together with the rest
+// COMMIT endorsements of the set reach the 2/3 quorum of the delegates
+func (s *Set) VerifyAggregatedCommits(height uint64, delegates []string, dkgPubkeys map[string][]byte) error {
+	if !s.IsAggregated() {
+		return errors.Wrap(ErrInvalidAggregatedSignature, "the endorsement set is not aggregated")
+	}
+	if len(s.signerBitmap) != (len(delegates)+7)/8 {
+		return errors.Wrapf(ErrInvalidAggregatedSignature, "invalid signer bitmap length %d", len(s.signerBitmap))
+	}
+	signers := make(map[string]bool)
+	ids := make([][]uint8, 0, crypto.Degree+1)
+	pubkeys := make([][]byte, 0, crypto.Degree+1)
+	for i := 0; i < len(s.signerBitmap)*8; i++ {
+		if s.signerBitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		if i >= len(delegates) {
+			return errors.Wrapf(ErrInvalidAggregatedSignature, "signer %d is not a delegate", i)
+		}
+		pubkey, ok := dkgPubkeys[delegates[i]]
+		if !ok {
+			return errors.Wrapf(ErrInvalidAggregatedSignature, "missing DKG public key of %s", delegates[i])
+		}
+		signers[delegates[i]] = true
+		ids = append(ids, iotxaddress.CreateID(delegates[i]))
+		pubkeys = append(pubkeys, pubkey)
+	}
+	if len(ids) != crypto.Degree+1 {
+		return errors.Wrapf(ErrInvalidAggregatedSignature, "%d signers while %d expected", len(ids), crypto.Degree+1)
+	}
+	hash := NewConsensusVote(s.blkHash, height, s.round, COMMIT).Hash()
+	if err := crypto.BLS.VerifyAggregate(ids, pubkeys, hash[:], s.aggregatedSignature); err != nil {
+		return errors.Wrap(ErrInvalidAggregatedSignature, err.Error())
+	}
+	delegateSet := make(map[string]bool)
+	for _, delegate := range delegates {
+		delegateSet[delegate] = true
+	}
+	for _, en := range s.endorsements {
+		vote := en.ConsensusVote()
+		if vote.Topic != COMMIT || vote.Height != height || vote.Round != s.round ||
+			!bytes.Equal(vote.BlkHash, s.blkHash) {
+			return errors.Wrapf(ErrInvalidAggregatedSignature, "endorsement of %s is not the commit", en.Endorser())
+		}
+		if !delegateSet[en.Endorser()] || signers[en.Endorser()] {
+			return errors.Wrapf(ErrInvalidAggregatedSignature, "unexpected commit of %s", en.Endorser())
+		}
+		if !en.VerifySignature() {
+			return errors.Wrapf(ErrInvalidEndorsement, "invalid commit of %s", en.Endorser())
+		}
+		signers[en.Endorser()] = true
+	}
+	if len(signers) < quorum(len(delegates)) {
+		return errors.Wrapf(
+			ErrInvalidAggregatedSignature,
+			"%d commits while %d required",
+			len(signers),
+			quorum(len(delegates)),
+		)
+	}
+
+	return nil
+}
+
+// quorum returns the minimum number of the delegates which is more than 2/3 of them
+func quorum(numDelegates int) int {
+	return numDelegates*2/3 + 1
+}
@@ -9,8 +9,12 @@ package endorsement
 import (
 	"testing"
 
-	"github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/crypto"
+	"github.com/iotexproject/iotex-core/iotxaddress"
+	"github.com/iotexproject/iotex-core/test/testaddress"
 )
 
 func TestAddEndorsement(t *testing.T) {
@@ -68,3 +72,114 @@ func TestAddEndorsement(t *testing.T) {
 		testaddress.IotxAddrinfo["alfa"].RawAddress,
 	}))
 }
+
+func TestAggregateCommits(t *testing.T) {
+	require := require.New(t)
+	numDelegates := 21
+	chainID := []byte{0x01, 0x02, 0x03, 0x04}
+	delegates := make([]*iotxaddress.Address, numDelegates)
+	delegateAddrs := make([]string, numDelegates)
+	idList := make([][]uint8, numDelegates)
+	for i := 0; i < numDelegates; i++ {
+		addr, err := iotxaddress.NewAddress(iotxaddress.IsTestnet, chainID)
+		require.NoError(err)
+		delegates[i] = addr
+		delegateAddrs[i] = addr.RawAddress
+		idList[i] = iotxaddress.CreateID(addr.RawAddress)
+	}
+
+	// Run DKG among the delegates
+	sharesList := make([][][]uint32, numDelegates)
+	witnessesList := make([][][]byte, numDelegates)
+	for i := 0; i < numDelegates; i++ {
+		var err error
+		_, sharesList[i], witnessesList[i], err = crypto.DKG.Init(crypto.DKG.SkGeneration(), idList)
+		require.NoError(err)
+	}
+	statusMatrix := make([][21]bool, numDelegates)
+	shares := make([][]uint32, numDelegates)
+	for i := 0; i < numDelegates; i++ {
+		for j := 0; j < numDelegates; j++ {
+			shares[j] = sharesList[j][i]
+		}
+		var err error
+		statusMatrix[i], err = crypto.DKG.SharesCollect(idList[i], shares, witnessesList)
+		require.NoError(err)
+	}
+	dkgPubkeys := make(map[string][]byte)
+	dkgPrikeys := make([][]uint32, numDelegates)
+	for i := 0; i < numDelegates; i++ {
+		for j := 0; j < numDelegates; j++ {
+			shares[j] = sharesList[j][i]
+		}
+		_, pk, sk, err := crypto.DKG.KeyPairGeneration(shares, statusMatrix)
+		require.NoError(err)
+		dkgPubkeys[delegateAddrs[i]] = pk
+		dkgPrikeys[i] = sk
+	}
+
+	blkHash := []byte{'2', '1'}
+	height := uint64(5)
+	round := uint32(1)
+	set := NewSet(blkHash)
+	// Not enough DKG signed commit endorsements
+	for i := 0; i < crypto.Degree; i++ {
+		en := NewEndorsement(NewConsensusVote(blkHash, height, round, COMMIT), delegates[i])
+		require.NoError(en.SignDKG(dkgPrikeys[i]))
+		require.NoError(set.AddEndorsement(en))
+	}
+	// Endorsement without DKG signature is skipped
+	en := NewEndorsement(NewConsensusVote(blkHash, height, round, COMMIT), delegates[crypto.Degree])
+	require.NoError(set.AddEndorsement(en))
+	_, err := set.AggregateCommits(height, round, delegateAddrs, dkgPubkeys)
+	require.Equal(ErrNotEnoughDKGSignatures, errors.Cause(err))
+
+	// Enough DKG signatures but not the 2/3 quorum of commits
+	for i := crypto.Degree + 1; i < crypto.Degree+3; i++ {
+		en := NewEndorsement(NewConsensusVote(blkHash, height, round, COMMIT), delegates[i])
+		require.NoError(en.SignDKG(dkgPrikeys[i]))
+		require.NoError(set.AddEndorsement(en))
+	}
+	_, err = set.AggregateCommits(height, round, delegateAddrs, dkgPubkeys)
+	require.Equal(ErrNotEnoughDKGSignatures, errors.Cause(err))
+
+	for i := crypto.Degree + 3; i < numDelegates; i++ {
+		en := NewEndorsement(NewConsensusVote(blkHash, height, round, COMMIT), delegates[i])
+		require.NoError(en.SignDKG(dkgPrikeys[i]))
+		require.NoError(set.AddEndorsement(en))
+	}
+	aggregated, err := set.AggregateCommits(height, round, delegateAddrs, dkgPubkeys)
+	require.NoError(err)
+	require.True(aggregated.IsAggregated())
+	// 11 aggregated signers and 4 more commits reach the quorum of 15
+	require.Equal(4, len(aggregated.endorsements))
+	require.Equal([]byte{0xff, 0x0b, 0x00}, aggregated.SignerBitmap())
+	require.NoError(aggregated.VerifyAggregatedCommits(height, delegateAddrs, dkgPubkeys))
+
+	// The aggregated set survives a proto round trip
+	decoded := &Set{}
+	require.NoError(decoded.FromProto(aggregated.ToProto()))
+	require.NoError(decoded.VerifyAggregatedCommits(height, delegateAddrs, dkgPubkeys))
+
+	// Wrong height
+	err = aggregated.VerifyAggregatedCommits(height+1, delegateAddrs, dkgPubkeys)
+	require.Equal(ErrInvalidAggregatedSignature, errors.Cause(err))
+	// Tampered bitmap
+	decoded.signerBitmap = []byte{0xff, 0xff, 0x00}
+	err = decoded.VerifyAggregatedCommits(height, delegateAddrs, dkgPubkeys)
+	require.Equal(ErrInvalidAggregatedSignature, errors.Cause(err))
+	// Commits below the quorum
+	decoded.signerBitmap = aggregated.signerBitmap
+	decoded.endorsements = decoded.endorsements[1:]
+	err = decoded.VerifyAggregatedCommits(height, delegateAddrs, dkgPubkeys)
+	require.Equal(ErrInvalidAggregatedSignature, errors.Cause(err))
+	// Missing DKG public key
+	delete(dkgPubkeys, delegateAddrs[0])
+	err = aggregated.VerifyAggregatedCommits(height, delegateAddrs, dkgPubkeys)
+	require.Equal(ErrInvalidAggregatedSignature, errors.Cause(err))
+	// The delegate whose DKG public key is unknown is not picked as a signer
+	aggregated, err = set.AggregateCommits(height, round, delegateAddrs, dkgPubkeys)
+	require.NoError(err)
+	require.Equal([]byte{0xfe, 0x1b, 0x00}, aggregated.SignerBitmap())
+	require.NoError(aggregated.VerifyAggregatedCommits(height, delegateAddrs, dkgPubkeys))
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package explorer
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/explorer/idl/explorer"
+)
+
+// actPoolEventBuffer keeps the latest actpool events for clients to long-poll
+type actPoolEventBuffer struct {
+	mutex  sync.RWMutex
+	size   int
+	events []explorer.ActPoolEvent
+	nextID int64
+	// notify is closed and renewed on every new event to wake up the waiting requests
+	notify chan struct{}
+}
+
+func newActPoolEventBuffer(size int) *actPoolEventBuffer {
+	return &actPoolEventBuffer{
+		size:   size,
+		events: make([]explorer.ActPoolEvent, 0, size),
+		notify: make(chan struct{}),
+	}
+}
+
+// HandleActPoolEvent records the actpool event
+func (b *actPoolEventBuffer) HandleActPoolEvent(evt actpool.Event) error {
+	hash := evt.Action.Hash()
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.events = append(b.events, explorer.ActPoolEvent{
+		Id:         b.nextID,
+		Type:       evt.Type.String(),
+		ActionHash: hex.EncodeToString(hash[:]),
+		Sender:     evt.Action.SrcAddr(),
+		Nonce:      int64(evt.Action.Nonce()),
+	})
+	b.nextID++
+	if len(b.events) > b.size {
+		b.events = b.events[len(b.events)-b.size:]
+	}
+	close(b.notify)
+	b.notify = make(chan struct{})
+	return nil
+}
+
+// since returns the events of the address from the cursor, the cursor to continue with, and the channel to be closed
+// on the next event
+func (b *actPoolEventBuffer) since(address string, cursor int64) ([]explorer.ActPoolEvent, int64, <-chan struct{}) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	events := make([]explorer.ActPoolEvent, 0)
+	for _, evt := range b.events {
+		if evt.Id >= cursor && (address == "" || evt.Sender == address) {
+			events = append(events, evt)
+		}
+	}
+	return events, b.nextID, b.notify
+}
+
+// wait returns the events of the address from the cursor, waiting up to the timeout if there is none yet
+func (b *actPoolEventBuffer) wait(address string, cursor int64, timeout time.Duration) explorer.ActPoolEventList {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		events, next, notify := b.since(address, cursor)
+		if len(events) > 0 {
+			return explorer.ActPoolEventList{Cursor: next, Events: events}
+		}
+		select {
+		case <-notify:
+			cursor = next
+		case <-timer.C:
+			return explorer.ActPoolEventList{Cursor: next, Events: events}
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package explorer
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestActPoolEventBuffer(t *testing.T) {
+	require := require.New(t)
+	a := testaddress.IotxAddrinfo["alfa"]
+	b := testaddress.IotxAddrinfo["bravo"]
+	tsf1, err := testutil.SignedTransfer(a, b, 1, big.NewInt(1), nil, uint64(10000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(b, a, 1, big.NewInt(1), nil, uint64(10000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(a, b, 2, big.NewInt(1), nil, uint64(10000), big.NewInt(0))
+	require.NoError(err)
+
+	buf := newActPoolEventBuffer(2)
+	// Time out without any event
+	res := buf.wait("", 0, 10*time.Millisecond)
+	require.Equal(int64(0), res.Cursor)
+	require.Empty(res.Events)
+
+	require.NoError(buf.HandleActPoolEvent(actpool.Event{Type: actpool.ActionAdded, Action: tsf1}))
+	require.NoError(buf.HandleActPoolEvent(actpool.Event{Type: actpool.ActionAdded, Action: tsf2}))
+	res = buf.wait(a.RawAddress, 0, time.Second)
+	require.Equal(int64(2), res.Cursor)
+	require.Equal(1, len(res.Events))
+	hash := tsf1.Hash()
+	require.Equal(int64(0), res.Events[0].Id)
+	require.Equal("added", res.Events[0].Type)
+	require.Equal(hex.EncodeToString(hash[:]), res.Events[0].ActionHash)
+	require.Equal(a.RawAddress, res.Events[0].Sender)
+	require.Equal(int64(1), res.Events[0].Nonce)
+
+	// Wake up on a new event of the address
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(buf.HandleActPoolEvent(actpool.Event{Type: actpool.ActionConfirmed, Action: tsf1}))
+		require.NoError(buf.HandleActPoolEvent(actpool.Event{Type: actpool.ActionAdded, Action: tsf3}))
+	}()
+	res = buf.wait(a.RawAddress, 2, 5*time.Second)
+	require.NotEmpty(res.Events)
+	require.Equal("confirmed", res.Events[0].Type)
+
+	// Only the latest events are kept
+	time.Sleep(50 * time.Millisecond)
+	res = buf.wait("", 0, time.Second)
+	require.Equal(int64(4), res.Cursor)
+	require.Equal(2, len(res.Events))
+	require.Equal(int64(2), res.Events[0].Id)
+	require.Equal(int64(3), res.Events[1].Id)
+}
+
+func TestExplorerGetActPoolEvents(t *testing.T) {
+	require := require.New(t)
+	cfg := config.Default.Explorer
+	cfg.MaxActPoolEventTimeout = 10 * time.Millisecond
+	svc := Service{cfg: cfg}
+	_, err := svc.GetActPoolEvents("", 0, 0)
+	require.Error(err)
+
+	svc.events = newActPoolEventBuffer(cfg.ActPoolEventBufferSize)
+	_, err = svc.GetActPoolEvents("", -1, 0)
+	require.Error(err)
+	// The timeout is capped
+	start := time.Now()
+	res, err := svc.GetActPoolEvents("", 0, 60000)
+	require.NoError(err)
+	require.Empty(res.Events)
+	require.True(time.Since(start) < 5*time.Second)
+}
@@ -10,6 +10,7 @@ import (
 	"encoding/hex"
 	"math/big"
 	"net"
+	"time"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
@@ -18,7 +19,10 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/multichain/mainchain"
+	"github.com/iotexproject/iotex-core/action/protocol/reward"
+	"github.com/iotexproject/iotex-core/action/protocol/timelock"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/blockchain"
@@ -31,6 +35,7 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/keypair"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/proto"
+	"github.com/iotexproject/iotex-core/state"
 )
 
 var (
@@ -83,6 +88,7 @@ type Service struct {
 	selfHandler      Self
 	cfg              config.Explorer
 	idx              *indexservice.Server
+	events           *actPoolEventBuffer
 	// TODO: the way to make explorer to access the data model managed by main-chain protocol is hack. We need to
 	// refactor the code later
 	mainChain *mainchain.Protocol
@@ -242,6 +248,33 @@ func (exp *Service) GetTransfersByAddress(address string, offset int64, limit in
 	return res, nil
 }
 
+// GetTransfersByMemoTag returns all transfers with a destination tag memo
+func (exp *Service) GetTransfersByMemoTag(tag string, offset int64, limit int64) ([]explorer.Transfer, error) {
+	var res []explorer.Transfer
+	transfers, err := exp.bc.GetTransfersByMemoTag(tag)
+	if err != nil {
+		return []explorer.Transfer{}, err
+	}
+	for i, transferHash := range transfers {
+		if int64(i) < offset {
+			continue
+		}
+
+		if int64(len(res)) >= limit {
+			break
+		}
+
+		explorerTransfer, err := getTransfer(exp.bc, exp.ap, transferHash, exp.idx, false)
+		if err != nil {
+			return []explorer.Transfer{}, err
+		}
+
+		res = append(res, explorerTransfer)
+	}
+
+	return res, nil
+}
+
 // GetUnconfirmedTransfersByAddress returns all unconfirmed transfers in actpool associated with an address
 func (exp *Service) GetUnconfirmedTransfersByAddress(address string, offset int64, limit int64) ([]explorer.Transfer, error) {
 	res := make([]explorer.Transfer, 0)
@@ -1000,6 +1033,10 @@ func (exp *Service) GetCandidateMetrics() (explorer.CandidateMetrics, error) {
 			LastUpdateHeight: int64(c.LastUpdateHeight),
 			IsDelegate:       false,
 			IsProducer:       false,
+			Name:             c.Name,
+			OperatorAddress:  c.OperatorAddress,
+			RewardAddress:    c.RewardAddress,
+			SelfStake:        selfStakeString(c),
 		}
 		if _, ok := delegateSet[c.Address]; ok {
 			candidates[i].IsDelegate = true
@@ -1039,6 +1076,10 @@ func (exp *Service) GetCandidateMetricsByHeight(h int64) (explorer.CandidateMetr
 			TotalVote:        c.Votes.String(),
 			CreationHeight:   int64(c.CreationHeight),
 			LastUpdateHeight: int64(c.LastUpdateHeight),
+			Name:             c.Name,
+			OperatorAddress:  c.OperatorAddress,
+			RewardAddress:    c.RewardAddress,
+			SelfStake:        selfStakeString(c),
 		})
 	}
 
@@ -1345,6 +1386,17 @@ func (exp *Service) GetBlockOrActionByHash(hashStr string) (explorer.GetBlkOrAct
 		return explorer.GetBlkOrActResponse{Execution: &exe}, nil
 	}
 
+	// Any other kind of action is returned in its generic form
+	if bytes, err := hex.DecodeString(hashStr); err == nil {
+		var actHash hash.Hash32B
+		copy(actHash[:], bytes)
+		if selp, err := exp.bc.GetActionByActionHash(actHash); err == nil {
+			if act, err := convertActionToExplorerAction(selp); err == nil {
+				return explorer.GetBlkOrActResponse{Action: &act}, nil
+			}
+		}
+	}
+
 	return explorer.GetBlkOrActResponse{}, nil
 }
 
@@ -1543,6 +1595,134 @@ func (exp *Service) GetStateRootHash(blockHeight int64) (string, error) {
 	return hex.EncodeToString(rootHash[:]), nil
 }
 
+// GetTimelocksByAddress returns the pending time-locked transfers to an address in the order of creation
+func (exp *Service) GetTimelocksByAddress(address string) ([]explorer.Timelock, error) {
+	locks, err := timelock.NewProtocol(exp.bc).Locks(address)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]explorer.Timelock, 0, len(locks))
+	for _, lock := range locks {
+		res = append(res, explorer.Timelock{
+			Hash:          hex.EncodeToString(lock.Hash[:]),
+			Sender:        lock.Sender,
+			Amount:        lock.Amount.String(),
+			ReleaseHeight: int64(lock.ReleaseHeight),
+			ReleaseTime:   lock.ReleaseTime,
+		})
+	}
+	return res, nil
+}
+
+// GetReplacementGasPrice returns the minimum gas price to replace a pending action of an address with the given nonce
+func (exp *Service) GetReplacementGasPrice(address string, nonce int64) (string, error) {
+	if nonce < 0 {
+		return "", errors.New("invalid nonce")
+	}
+	gasPrice, err := exp.ap.GetReplacementGasPrice(address, uint64(nonce))
+	if err != nil {
+		return "", err
+	}
+	return gasPrice.String(), nil
+}
+
+// GetActPoolEvents long-polls the actpool events of an address (all addresses if empty) from the cursor, waiting up
+// to the timeout in milliseconds if there is none yet
+func (exp *Service) GetActPoolEvents(address string, cursor int64, timeout int64) (explorer.ActPoolEventList, error) {
+	if exp.events == nil {
+		return explorer.ActPoolEventList{}, errors.New("actpool events are not available")
+	}
+	if cursor < 0 || timeout < 0 {
+		return explorer.ActPoolEventList{}, errors.New("invalid cursor or timeout")
+	}
+	wait := time.Duration(timeout) * time.Millisecond
+	if exp.cfg.MaxActPoolEventTimeout > 0 && wait > exp.cfg.MaxActPoolEventTimeout {
+		wait = exp.cfg.MaxActPoolEventTimeout
+	}
+	return exp.events.wait(address, cursor, wait), nil
+}
+
+// GetBlockLimits returns the gas limit and the size limit of the next block
+func (exp *Service) GetBlockLimits() (explorer.BlockLimits, error) {
+	limits, err := exp.bc.BlockLimits()
+	if err != nil {
+		return explorer.BlockLimits{}, err
+	}
+	return explorer.BlockLimits{
+		GasLimit:  int64(limits.GasLimit),
+		SizeLimit: int64(limits.SizeLimit),
+	}, nil
+}
+
+// GetRewardPool returns the address and the balance of the reward pool
+func (exp *Service) GetRewardPool() (explorer.RewardPool, error) {
+	pool := reward.PoolAddress(exp.bc.ChainID())
+	balance, err := exp.bc.Balance(pool)
+	if err != nil {
+		return explorer.RewardPool{}, err
+	}
+	return explorer.RewardPool{
+		Address: pool,
+		Balance: balance.String(),
+	}, nil
+}
+
+// GetUnclaimedReward returns the rewards granted to the address but not claimed yet
+func (exp *Service) GetUnclaimedReward(address string) (string, error) {
+	balance, err := reward.UnclaimedBalance(exp.bc.GetFactory(), address)
+	if err != nil {
+		return "", err
+	}
+	return balance.String(), nil
+}
+
+// GetLogs returns the logs within the heights (up to the tip height if toHeight is 0), emitted by any of the
+// addresses, and matching the topics by position, where an empty list matches any topic
+func (exp *Service) GetLogs(filter explorer.LogFilter) ([]explorer.Log, error) {
+	logFilter, err := convertExplorerLogFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+	if tipHeight := exp.bc.TipHeight(); logFilter.ToHeight == 0 || logFilter.ToHeight > tipHeight {
+		logFilter.ToHeight = tipHeight
+	}
+	if logFilter.FromHeight > logFilter.ToHeight {
+		return []explorer.Log{}, nil
+	}
+	if exp.cfg.MaxLogQueryRange > 0 && logFilter.ToHeight-logFilter.FromHeight >= exp.cfg.MaxLogQueryRange {
+		return nil, errors.Errorf("range of heights exceeds the limit %d", exp.cfg.MaxLogQueryRange)
+	}
+	logs, err := exp.bc.GetLogs(logFilter)
+	if err != nil {
+		return nil, err
+	}
+	res := []explorer.Log{}
+	for _, log := range logs {
+		res = append(res, convertLogToExplorerLog(log))
+	}
+	return res, nil
+}
+
+// TraceExecution re-executes an execution on top of the states before it, and traces its opcodes, call frames, storage
+// changes and revert reason
+func (exp *Service) TraceExecution(id string) (explorer.ExecutionTrace, error) {
+	bytes, err := hex.DecodeString(id)
+	if err != nil {
+		return explorer.ExecutionTrace{}, err
+	}
+	var executionHash hash.Hash32B
+	copy(executionHash[:], bytes)
+	receipt, trace, err := exp.bc.TraceExecution(executionHash)
+	if err != nil {
+		return explorer.ExecutionTrace{}, err
+	}
+	explorerReceipt, err := convertReceiptToExplorerReceipt(receipt)
+	if err != nil {
+		return explorer.ExecutionTrace{}, err
+	}
+	return convertTraceToExplorerTrace(explorerReceipt, trace), nil
+}
+
 // getTransfer takes in a blockchain and transferHash and returns an Explorer Transfer
 func getTransfer(bc blockchain.Blockchain, ap actpool.ActPool, transferHash hash.Hash32B, idx *indexservice.Server, useRDS bool) (explorer.Transfer, error) {
 	explorerTransfer := explorer.Transfer{}
@@ -1824,6 +2004,12 @@ func convertTsfToExplorerTsf(selp action.SealedEnvelope, isPending bool) (explor
 	if selp.GasPrice() != nil && len(selp.GasPrice().String()) > 0 {
 		explorerTransfer.GasPrice = selp.GasPrice().String()
 	}
+	if memo := transfer.Memo(); memo != nil {
+		explorerTransfer.Memo = &explorer.TransferMemo{
+			Type: int64(memo.Type),
+			Data: hex.EncodeToString(memo.Data),
+		}
+	}
 	return explorerTransfer, nil
 }
 
@@ -1877,25 +2063,43 @@ func convertExecutionToExplorerExecution(selp action.SealedEnvelope, isPending b
 	return explorerExecution, nil
 }
 
+func convertActionToExplorerAction(selp action.SealedEnvelope) (explorer.Action, error) {
+	kind, ok := action.KindOf(selp.Action())
+	if !ok {
+		return explorer.Action{}, errors.Wrapf(ErrAction, "action type %T is not registered", selp.Action())
+	}
+	actPb, err := selp.Proto()
+	if err != nil {
+		return explorer.Action{}, err
+	}
+	var marshaler jsonpb.Marshaler
+	payload, err := marshaler.MarshalToString(actPb)
+	if err != nil {
+		return explorer.Action{}, errors.Wrap(err, "failed to marshal action")
+	}
+	hash := selp.Hash()
+	explorerAction := explorer.Action{
+		ID:        hex.EncodeToString(hash[:]),
+		Kind:      kind,
+		Nonce:     int64(selp.Nonce()),
+		Sender:    selp.SrcAddr(),
+		Recipient: selp.DstAddr(),
+		GasLimit:  int64(selp.GasLimit()),
+		Payload:   payload,
+	}
+	if selp.GasPrice() != nil {
+		explorerAction.GasPrice = selp.GasPrice().String()
+	}
+	return explorerAction, nil
+}
+
 func convertReceiptToExplorerReceipt(receipt *action.Receipt) (explorer.Receipt, error) {
 	if receipt == nil {
 		return explorer.Receipt{}, errors.Wrap(ErrReceipt, "receipt cannot be nil")
 	}
 	logs := []explorer.Log{}
 	for _, log := range receipt.Logs {
-		topics := []string{}
-		for _, topic := range log.Topics {
-			topics = append(topics, hex.EncodeToString(topic[:]))
-		}
-		logs = append(logs, explorer.Log{
-			Address:     log.Address,
-			Topics:      topics,
-			Data:        hex.EncodeToString(log.Data),
-			BlockNumber: int64(log.BlockNumber),
-			TxnHash:     hex.EncodeToString(log.TxnHash[:]),
-			BlockHash:   hex.EncodeToString(log.BlockHash[:]),
-			Index:       int64(log.Index),
-		})
+		logs = append(logs, convertLogToExplorerLog(log))
 	}
 
 	return explorer.Receipt{
@@ -1908,6 +2112,98 @@ func convertReceiptToExplorerReceipt(receipt *action.Receipt) (explorer.Receipt,
 	}, nil
 }
 
+func convertExplorerLogFilter(filter explorer.LogFilter) (*blockchain.LogFilter, error) {
+	if filter.FromHeight < 0 || filter.ToHeight < 0 {
+		return nil, errors.New("invalid height")
+	}
+	logFilter := &blockchain.LogFilter{
+		FromHeight: uint64(filter.FromHeight),
+		ToHeight:   uint64(filter.ToHeight),
+		Addresses:  filter.Addresses,
+	}
+	for _, topicList := range filter.Topics {
+		var topics []hash.Hash32B
+		for _, topicStr := range topicList.Topics {
+			bytes, err := hex.DecodeString(topicStr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid topic %s", topicStr)
+			}
+			if len(bytes) != len(hash.ZeroHash32B) {
+				return nil, errors.Errorf("invalid topic %s", topicStr)
+			}
+			var topic hash.Hash32B
+			copy(topic[:], bytes)
+			topics = append(topics, topic)
+		}
+		logFilter.Topics = append(logFilter.Topics, topics)
+	}
+	return logFilter, nil
+}
+
+func convertLogToExplorerLog(log *action.Log) explorer.Log {
+	topics := []string{}
+	for _, topic := range log.Topics {
+		topics = append(topics, hex.EncodeToString(topic[:]))
+	}
+	return explorer.Log{
+		Address:     log.Address,
+		Topics:      topics,
+		Data:        hex.EncodeToString(log.Data),
+		BlockNumber: int64(log.BlockNumber),
+		TxnHash:     hex.EncodeToString(log.TxnHash[:]),
+		BlockHash:   hex.EncodeToString(log.BlockHash[:]),
+		Index:       int64(log.Index),
+	}
+}
+
+func convertTraceToExplorerTrace(receipt explorer.Receipt, trace *evm.Trace) explorer.ExecutionTrace {
+	res := explorer.ExecutionTrace{
+		Receipt:      receipt,
+		Steps:        []explorer.TraceStep{},
+		Calls:        []explorer.TraceCall{},
+		StorageDiffs: []explorer.StorageDiff{},
+		Output:       hex.EncodeToString(trace.Output),
+		GasUsed:      int64(trace.GasUsed),
+		Error:        trace.Error,
+		RevertReason: trace.RevertReason,
+	}
+	for _, step := range trace.Steps {
+		stack := []string{}
+		for _, v := range step.Stack {
+			stack = append(stack, hex.EncodeToString(v.Bytes()))
+		}
+		res.Steps = append(res.Steps, explorer.TraceStep{
+			Pc:      int64(step.Pc),
+			Op:      step.Op,
+			Gas:     int64(step.Gas),
+			GasCost: int64(step.GasCost),
+			Depth:   int64(step.Depth),
+			Stack:   stack,
+			Error:   step.Error,
+		})
+	}
+	for _, call := range trace.Calls {
+		res.Calls = append(res.Calls, explorer.TraceCall{
+			Type:  call.Type,
+			From:  call.From,
+			To:    call.To,
+			Input: hex.EncodeToString(call.Input),
+			Gas:   int64(call.Gas),
+			Value: call.Value.String(),
+			Depth: int64(call.Depth),
+		})
+	}
+	for _, diff := range trace.StorageDiffs {
+		res.StorageDiffs = append(res.StorageDiffs, explorer.StorageDiff{
+			Address:  diff.Address,
+			Key:      hex.EncodeToString(diff.Key[:]),
+			Original: hex.EncodeToString(diff.Original[:]),
+			Current:  hex.EncodeToString(diff.Current[:]),
+		})
+	}
+	return res
+}
+
 func convertExplorerExecutionToActionPb(execution *explorer.Execution) (*iproto.ActionPb, error) {
 	executorPubKey, err := keypair.StringToPubKeyBytes(execution.ExecutorPubKey)
 	if err != nil {
@@ -1997,3 +2293,11 @@ func convertExplorerTransferToActionPb(tsfJSON *explorer.SendTransferRequest,
 	}
 	return actPb, nil
 }
+
+// selfStakeString returns the self-stake of a candidate as a string, which is "0" for a self-nominated candidate
+func selfStakeString(c *state.Candidate) string {
+	if c.SelfStake == nil {
+		return "0"
+	}
+	return c.SelfStake.String()
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
 	"github.com/iotexproject/iotex-core/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/multichain/mainchain"
 	"github.com/iotexproject/iotex-core/action/protocol/vote"
 	"github.com/iotexproject/iotex-core/actpool"
@@ -201,7 +202,8 @@ func TestExplorerApi(t *testing.T) {
 
 	// create chain
 	ctx := context.Background()
-	bc := blockchain.NewBlockchain(cfg, blockchain.PrecreatedStateFactoryOption(sf), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(cfg, blockchain.PrecreatedStateFactoryOption(sf), blockchain.InMemDaoOption())
+	require.NoError(err)
 	require.NotNil(bc)
 	ap, err := actpool.NewActPool(bc, cfg.ActPool)
 	require.Nil(err)
@@ -441,6 +443,7 @@ func TestExplorerApi(t *testing.T) {
 	require.Nil(res.Transfer)
 	require.Nil(res.Vote)
 	require.Nil(res.Execution)
+	require.Nil(res.Action)
 
 	res, err = svc.GetBlockOrActionByHash(blks[0].ID)
 	require.NoError(err)
@@ -754,8 +757,10 @@ func TestServiceSendAction(t *testing.T) {
 	selp, err := action.Sign(elp, ta.IotxAddrinfo["producer"].RawAddress, ta.IotxAddrinfo["producer"].PrivateKey)
 	require.NoError(err)
 
+	actPb, err := selp.Proto()
+	require.NoError(err)
 	var marshaler jsonpb.Marshaler
-	payload, err := marshaler.MarshalToString(selp.Proto())
+	payload, err := marshaler.MarshalToString(actPb)
 	require.NoError(err)
 	request.Payload = payload
 	require.NoError(err)
@@ -878,7 +883,8 @@ func TestExplorerGetReceiptByExecutionID(t *testing.T) {
 
 	// create chain
 	ctx := context.Background()
-	bc := blockchain.NewBlockchain(cfg, blockchain.PrecreatedStateFactoryOption(sf), blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(cfg, blockchain.PrecreatedStateFactoryOption(sf), blockchain.InMemDaoOption())
+	require.NoError(err)
 	require.NoError(bc.Start(ctx))
 
 	sf.AddActionHandlers(execution.NewProtocol(bc))
@@ -1140,6 +1146,89 @@ func TestService_GetStateRootHash(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString(rootHash[:]), rootHashStr)
 }
 
+func TestService_GetLogs(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	topic := hash.Hash256b([]byte("topic"))
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().Return(uint64(100)).AnyTimes()
+	bc.EXPECT().GetLogs(&blockchain.LogFilter{
+		FromHeight: 50,
+		ToHeight:   100,
+		Addresses:  []string{"io1contract"},
+		Topics:     [][]hash.Hash32B{nil, {topic}},
+	}).Return([]*action.Log{{Address: "io1contract", Topics: []hash.Hash32B{topic}, BlockNumber: 20}}, nil).Times(1)
+
+	svc := Service{bc: bc, cfg: config.Explorer{MaxLogQueryRange: 60}}
+	logs, err := svc.GetLogs(explorer.LogFilter{
+		FromHeight: 50,
+		Addresses:  []string{"io1contract"},
+		Topics:     []explorer.TopicList{{}, {Topics: []string{hex.EncodeToString(topic[:])}}},
+	})
+	require.NoError(err)
+	require.Equal(1, len(logs))
+	require.Equal("io1contract", logs[0].Address)
+	require.Equal([]string{hex.EncodeToString(topic[:])}, logs[0].Topics)
+	require.Equal(int64(20), logs[0].BlockNumber)
+
+	// The range is beyond the tip height
+	logs, err = svc.GetLogs(explorer.LogFilter{FromHeight: 501})
+	require.NoError(err)
+	require.Equal(0, len(logs))
+	// The range exceeds the limit
+	_, err = svc.GetLogs(explorer.LogFilter{FromHeight: 1})
+	require.Error(err)
+	// The topic is not a hash
+	_, err = svc.GetLogs(explorer.LogFilter{
+		FromHeight: 50,
+		Topics:     []explorer.TopicList{{Topics: []string{"1234"}}},
+	})
+	require.Error(err)
+}
+
+func TestService_TraceExecution(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	executionHash := hash.Hash256b([]byte("execution"))
+	key := hash.Hash256b([]byte("key"))
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TraceExecution(executionHash).Return(
+		&action.Receipt{Hash: executionHash, GasConsumed: 100},
+		&evm.Trace{
+			Steps: []*evm.TraceStep{{Pc: 1, Op: "SSTORE", Gas: 1000, GasCost: 20000, Depth: 1,
+				Stack: []*big.Int{big.NewInt(42), big.NewInt(0)}}},
+			Calls:        []*evm.TraceCall{{Type: "CALL", From: "io1executor", To: "io1contract", Value: big.NewInt(0), Depth: 1}},
+			StorageDiffs: []*evm.StorageDiff{{Address: "io1contract", Key: key, Current: key}},
+			GasUsed:      100,
+			Error:        "evm: execution reverted",
+			RevertReason: "no",
+		},
+		nil,
+	).Times(1)
+
+	svc := Service{bc: bc}
+	trace, err := svc.TraceExecution(hex.EncodeToString(executionHash[:]))
+	require.NoError(err)
+	require.Equal(hex.EncodeToString(executionHash[:]), trace.Receipt.Hash)
+	require.Equal(1, len(trace.Steps))
+	require.Equal("SSTORE", trace.Steps[0].Op)
+	require.Equal([]string{"2a", ""}, trace.Steps[0].Stack)
+	require.Equal(1, len(trace.Calls))
+	require.Equal("io1contract", trace.Calls[0].To)
+	require.Equal("0", trace.Calls[0].Value)
+	require.Equal(1, len(trace.StorageDiffs))
+	require.Equal(hex.EncodeToString(key[:]), trace.StorageDiffs[0].Key)
+	require.Equal(hex.EncodeToString(hash.ZeroHash32B[:]), trace.StorageDiffs[0].Original)
+	require.Equal("no", trace.RevertReason)
+
+	_, err = svc.TraceExecution("invalid")
+	require.Error(err)
+}
+
 func addCreatorToFactory(sf factory.Factory) error {
 	ws, err := sf.NewWorkingSet()
 	if err != nil {
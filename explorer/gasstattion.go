@@ -24,9 +24,9 @@ type GasStation struct {
 	cfg config.Explorer
 }
 
-// SuggestGasPrice suggest gas price
+// SuggestGasPrice suggests gas price, which is the base fee of the next block plus a suggested tip
 func (gs *GasStation) suggestGasPrice() (int64, error) {
-	var smallestPrices []*big.Int
+	var smallestTips []*big.Int
 	tip := gs.bc.TipHeight()
 
 	endBlockHeight := uint64(0)
@@ -34,6 +34,10 @@ func (gs *GasStation) suggestGasPrice() (int64, error) {
 		endBlockHeight = tip - uint64(gs.cfg.GasStation.SuggestBlockWindow)
 	}
 
+	baseFee, err := gs.bc.NextBaseFee()
+	if err != nil {
+		return int64(gs.cfg.GasStation.DefaultGas), err
+	}
 	for height := tip; height > endBlockHeight; height-- {
 		blk, err := gs.bc.GetBlockByHeight(height)
 		if err != nil {
@@ -43,25 +47,28 @@ func (gs *GasStation) suggestGasPrice() (int64, error) {
 			continue
 		}
 
-		smallestPrice := blk.Actions[0].GasPrice()
+		var smallestTip *big.Int
 		for _, action := range blk.Actions {
-			if smallestPrice.Cmp(action.GasPrice()) == 1 {
-				smallestPrice = action.GasPrice()
+			// The tip is what the action pays on top of the base fee of the block
+			tip := big.NewInt(0).Sub(action.GasPrice(), blk.BaseFee())
+			if tip.Sign() < 0 {
+				tip.SetInt64(0)
+			}
+			if smallestTip == nil || smallestTip.Cmp(tip) == 1 {
+				smallestTip = tip
 			}
 		}
-		smallestPrices = append(smallestPrices, smallestPrice)
+		smallestTips = append(smallestTips, smallestTip)
 	}
 
-	if len(smallestPrices) == 0 {
-		// return default price
-		return int64(gs.cfg.GasStation.DefaultGas), nil
-	}
-	sort.Sort(bigIntArray(smallestPrices))
-	gasPrice := smallestPrices[(len(smallestPrices)-1)*gs.cfg.GasStation.Percentile/100].Int64()
-	if gasPrice < int64(gs.cfg.GasStation.DefaultGas) {
-		gasPrice = int64(gs.cfg.GasStation.DefaultGas)
+	gasTip := int64(gs.cfg.GasStation.DefaultGas)
+	if len(smallestTips) > 0 {
+		sort.Sort(bigIntArray(smallestTips))
+		if suggested := smallestTips[(len(smallestTips)-1)*gs.cfg.GasStation.Percentile/100].Int64(); suggested > gasTip {
+			gasTip = suggested
+		}
 	}
-	return gasPrice, nil
+	return baseFee.Int64() + gasTip, nil
 }
 
 // EstimateGasForTransfer estimate gas for transfer
@@ -8,8 +8,8 @@ import (
 )
 
 const BarristerVersion string = "0.1.6"
-const BarristerChecksum string = "930ad8ed4154e09b1263a8f435f6934d"
-const BarristerDateGenerated int64 = 1546625898440000000
+const BarristerChecksum string = "8ba5c7df3e6448852ea78aa2ee653275"
+const BarristerDateGenerated int64 = 1792351460172000000
 
 type CoinStatistic struct {
 	Height     int64  `json:"height"`
@@ -40,23 +40,29 @@ type Block struct {
 	StateRoot  string         `json:"stateRoot"`
 }
 
+type TransferMemo struct {
+	Type int64  `json:"type"`
+	Data string `json:"data"`
+}
+
 type Transfer struct {
-	Version      int64  `json:"version"`
-	ID           string `json:"ID"`
-	Nonce        int64  `json:"nonce"`
-	Sender       string `json:"sender"`
-	Recipient    string `json:"recipient"`
-	Amount       string `json:"amount"`
-	SenderPubKey string `json:"senderPubKey"`
-	Signature    string `json:"signature"`
-	Payload      string `json:"payload"`
-	GasLimit     int64  `json:"gasLimit"`
-	GasPrice     string `json:"gasPrice"`
-	IsCoinbase   bool   `json:"isCoinbase"`
-	Fee          string `json:"fee"`
-	Timestamp    int64  `json:"timestamp"`
-	BlockID      string `json:"blockID"`
-	IsPending    bool   `json:"isPending"`
+	Version      int64         `json:"version"`
+	ID           string        `json:"ID"`
+	Nonce        int64         `json:"nonce"`
+	Sender       string        `json:"sender"`
+	Recipient    string        `json:"recipient"`
+	Amount       string        `json:"amount"`
+	SenderPubKey string        `json:"senderPubKey"`
+	Signature    string        `json:"signature"`
+	Payload      string        `json:"payload"`
+	GasLimit     int64         `json:"gasLimit"`
+	GasPrice     string        `json:"gasPrice"`
+	IsCoinbase   bool          `json:"isCoinbase"`
+	Fee          string        `json:"fee"`
+	Timestamp    int64         `json:"timestamp"`
+	BlockID      string        `json:"blockID"`
+	IsPending    bool          `json:"isPending"`
+	Memo         *TransferMemo `json:"memo,omitempty"`
 }
 
 type Execution struct {
@@ -76,6 +82,17 @@ type Execution struct {
 	IsPending      bool   `json:"isPending"`
 }
 
+type Action struct {
+	ID        string `json:"ID"`
+	Kind      string `json:"kind"`
+	Nonce     int64  `json:"nonce"`
+	Sender    string `json:"sender"`
+	Recipient string `json:"recipient"`
+	GasLimit  int64  `json:"gasLimit"`
+	GasPrice  string `json:"gasPrice"`
+	Payload   string `json:"payload"`
+}
+
 type Log struct {
 	Address     string   `json:"address"`
 	Topics      []string `json:"topics"`
@@ -86,6 +103,17 @@ type Log struct {
 	Index       int64    `json:"index"`
 }
 
+type TopicList struct {
+	Topics []string `json:"topics"`
+}
+
+type LogFilter struct {
+	FromHeight int64       `json:"fromHeight"`
+	ToHeight   int64       `json:"toHeight"`
+	Addresses  []string    `json:"addresses"`
+	Topics     []TopicList `json:"topics"`
+}
+
 type Receipt struct {
 	ReturnValue     string `json:"returnValue"`
 	Status          int64  `json:"status"`
@@ -95,6 +123,44 @@ type Receipt struct {
 	Logs            []Log  `json:"logs"`
 }
 
+type TraceStep struct {
+	Pc      int64    `json:"pc"`
+	Op      string   `json:"op"`
+	Gas     int64    `json:"gas"`
+	GasCost int64    `json:"gasCost"`
+	Depth   int64    `json:"depth"`
+	Stack   []string `json:"stack"`
+	Error   string   `json:"error"`
+}
+
+type TraceCall struct {
+	Type  string `json:"type"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Input string `json:"input"`
+	Gas   int64  `json:"gas"`
+	Value string `json:"value"`
+	Depth int64  `json:"depth"`
+}
+
+type StorageDiff struct {
+	Address  string `json:"address"`
+	Key      string `json:"key"`
+	Original string `json:"original"`
+	Current  string `json:"current"`
+}
+
+type ExecutionTrace struct {
+	Receipt      Receipt       `json:"receipt"`
+	Steps        []TraceStep   `json:"steps"`
+	Calls        []TraceCall   `json:"calls"`
+	StorageDiffs []StorageDiff `json:"storageDiffs"`
+	Output       string        `json:"output"`
+	GasUsed      int64         `json:"gasUsed"`
+	Error        string        `json:"error"`
+	RevertReason string        `json:"revertReason"`
+}
+
 type SendExecutionResponse struct {
 	Receipt Receipt `json:"receipt"`
 }
@@ -130,6 +196,10 @@ type Candidate struct {
 	LastUpdateHeight int64  `json:"lastUpdateHeight"`
 	IsDelegate       bool   `json:"isDelegate"`
 	IsProducer       bool   `json:"isProducer"`
+	Name             string `json:"name"`
+	OperatorAddress  string `json:"operatorAddress"`
+	RewardAddress    string `json:"rewardAddress"`
+	SelfStake        string `json:"selfStake"`
 }
 
 type CandidateMetrics struct {
@@ -226,6 +296,7 @@ type GetBlkOrActResponse struct {
 	Transfer  *Transfer  `json:"transfer,omitempty"`
 	Vote      *Vote      `json:"vote,omitempty"`
 	Execution *Execution `json:"execution,omitempty"`
+	Action    *Action    `json:"action,omitempty"`
 }
 
 type CreateDepositRequest struct {
@@ -302,6 +373,37 @@ type SettleDeposit struct {
 	IsPending    bool   `json:"isPending"`
 }
 
+type Timelock struct {
+	Hash          string `json:"hash"`
+	Sender        string `json:"sender"`
+	Amount        string `json:"amount"`
+	ReleaseHeight int64  `json:"releaseHeight"`
+	ReleaseTime   int64  `json:"releaseTime"`
+}
+
+type ActPoolEvent struct {
+	Id         int64  `json:"id"`
+	Type       string `json:"type"`
+	ActionHash string `json:"actionHash"`
+	Sender     string `json:"sender"`
+	Nonce      int64  `json:"nonce"`
+}
+
+type ActPoolEventList struct {
+	Cursor int64          `json:"cursor"`
+	Events []ActPoolEvent `json:"events"`
+}
+
+type BlockLimits struct {
+	GasLimit  int64 `json:"gasLimit"`
+	SizeLimit int64 `json:"sizeLimit"`
+}
+
+type RewardPool struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
 type Explorer interface {
 	GetBlockchainHeight() (int64, error)
 	GetAddressBalance(address string) (string, error)
@@ -311,6 +413,7 @@ type Explorer interface {
 	GetTransfersByAddress(address string, offset int64, limit int64) ([]Transfer, error)
 	GetUnconfirmedTransfersByAddress(address string, offset int64, limit int64) ([]Transfer, error)
 	GetTransfersByBlockID(blkID string, offset int64, limit int64) ([]Transfer, error)
+	GetTransfersByMemoTag(tag string, offset int64, limit int64) ([]Transfer, error)
 	GetLastVotesByRange(startBlockHeight int64, offset int64, limit int64) ([]Vote, error)
 	GetVoteByID(voteID string) (Vote, error)
 	GetVotesByAddress(address string, offset int64, limit int64) ([]Vote, error)
@@ -349,6 +452,14 @@ type Explorer interface {
 	EstimateGasForVote() (int64, error)
 	EstimateGasForSmartContract(request Execution) (int64, error)
 	GetStateRootHash(blockHeight int64) (string, error)
+	GetTimelocksByAddress(address string) ([]Timelock, error)
+	GetReplacementGasPrice(address string, nonce int64) (string, error)
+	GetActPoolEvents(address string, cursor int64, timeout int64) (ActPoolEventList, error)
+	GetBlockLimits() (BlockLimits, error)
+	GetRewardPool() (RewardPool, error)
+	GetUnclaimedReward(address string) (string, error)
+	GetLogs(filter LogFilter) ([]Log, error)
+	TraceExecution(id string) (ExecutionTrace, error)
 }
 
 func NewExplorerProxy(c barrister.Client) Explorer {
@@ -504,6 +615,24 @@ func (_p ExplorerProxy) GetTransfersByBlockID(blkID string, offset int64, limit
 	return []Transfer{}, _err
 }
 
+func (_p ExplorerProxy) GetTransfersByMemoTag(tag string, offset int64, limit int64) ([]Transfer, error) {
+	_res, _err := _p.client.Call("Explorer.getTransfersByMemoTag", tag, offset, limit)
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.getTransfersByMemoTag").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf([]Transfer{}), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.([]Transfer)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.getTransfersByMemoTag returned invalid type: %v", _t)
+			return []Transfer{}, &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return []Transfer{}, _err
+}
+
 func (_p ExplorerProxy) GetLastVotesByRange(startBlockHeight int64, offset int64, limit int64) ([]Vote, error) {
 	_res, _err := _p.client.Call("Explorer.getLastVotesByRange", startBlockHeight, offset, limit)
 	if _err == nil {
@@ -1188,6 +1317,150 @@ func (_p ExplorerProxy) GetStateRootHash(blockHeight int64) (string, error) {
 	return "", _err
 }
 
+func (_p ExplorerProxy) GetTimelocksByAddress(address string) ([]Timelock, error) {
+	_res, _err := _p.client.Call("Explorer.getTimelocksByAddress", address)
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.getTimelocksByAddress").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf([]Timelock{}), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.([]Timelock)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.getTimelocksByAddress returned invalid type: %v", _t)
+			return []Timelock{}, &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return []Timelock{}, _err
+}
+
+func (_p ExplorerProxy) GetReplacementGasPrice(address string, nonce int64) (string, error) {
+	_res, _err := _p.client.Call("Explorer.getReplacementGasPrice", address, nonce)
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.getReplacementGasPrice").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf(""), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.(string)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.getReplacementGasPrice returned invalid type: %v", _t)
+			return "", &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return "", _err
+}
+
+func (_p ExplorerProxy) GetActPoolEvents(address string, cursor int64, timeout int64) (ActPoolEventList, error) {
+	_res, _err := _p.client.Call("Explorer.getActPoolEvents", address, cursor, timeout)
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.getActPoolEvents").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf(ActPoolEventList{}), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.(ActPoolEventList)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.getActPoolEvents returned invalid type: %v", _t)
+			return ActPoolEventList{}, &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return ActPoolEventList{}, _err
+}
+
+func (_p ExplorerProxy) GetBlockLimits() (BlockLimits, error) {
+	_res, _err := _p.client.Call("Explorer.getBlockLimits")
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.getBlockLimits").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf(BlockLimits{}), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.(BlockLimits)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.getBlockLimits returned invalid type: %v", _t)
+			return BlockLimits{}, &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return BlockLimits{}, _err
+}
+
+func (_p ExplorerProxy) GetRewardPool() (RewardPool, error) {
+	_res, _err := _p.client.Call("Explorer.getRewardPool")
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.getRewardPool").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf(RewardPool{}), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.(RewardPool)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.getRewardPool returned invalid type: %v", _t)
+			return RewardPool{}, &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return RewardPool{}, _err
+}
+
+func (_p ExplorerProxy) GetUnclaimedReward(address string) (string, error) {
+	_res, _err := _p.client.Call("Explorer.getUnclaimedReward", address)
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.getUnclaimedReward").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf(""), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.(string)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.getUnclaimedReward returned invalid type: %v", _t)
+			return "", &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return "", _err
+}
+
+func (_p ExplorerProxy) GetLogs(filter LogFilter) ([]Log, error) {
+	_res, _err := _p.client.Call("Explorer.getLogs", filter)
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.getLogs").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf([]Log{}), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.([]Log)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.getLogs returned invalid type: %v", _t)
+			return []Log{}, &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return []Log{}, _err
+}
+
+func (_p ExplorerProxy) TraceExecution(id string) (ExecutionTrace, error) {
+	_res, _err := _p.client.Call("Explorer.traceExecution", id)
+	if _err == nil {
+		_retType := _p.idl.Method("Explorer.traceExecution").Returns
+		_res, _err = barrister.Convert(_p.idl, &_retType, reflect.TypeOf(ExecutionTrace{}), _res, "")
+	}
+	if _err == nil {
+		_cast, _ok := _res.(ExecutionTrace)
+		if !_ok {
+			_t := reflect.TypeOf(_res)
+			_msg := fmt.Sprintf("Explorer.traceExecution returned invalid type: %v", _t)
+			return ExecutionTrace{}, &barrister.JsonRpcError{Code: -32000, Message: _msg}
+		}
+		return _cast, nil
+	}
+	return ExecutionTrace{}, _err
+}
+
 func NewJSONServer(idl *barrister.Idl, forceASCII bool, explorer Explorer) barrister.Server {
 	return NewServer(idl, &barrister.JsonSerializer{forceASCII}, explorer)
 }
@@ -1433,6 +1706,34 @@ var IdlJsonRaw = `[
         "date_generated": 0,
         "checksum": ""
     },
+    {
+        "type": "struct",
+        "name": "TransferMemo",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "type",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "data",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
     {
         "type": "struct",
         "name": "Transfer",
@@ -1551,6 +1852,13 @@ var IdlJsonRaw = `[
                 "optional": false,
                 "is_array": false,
                 "comment": ""
+            },
+            {
+                "name": "memo",
+                "type": "TransferMemo",
+                "optional": true,
+                "is_array": false,
+                "comment": ""
             }
         ],
         "values": null,
@@ -1673,56 +1981,63 @@ var IdlJsonRaw = `[
     },
     {
         "type": "struct",
-        "name": "Log",
+        "name": "Action",
         "comment": "",
         "value": "",
         "extends": "",
         "fields": [
             {
-                "name": "address",
+                "name": "ID",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "topics",
+                "name": "kind",
                 "type": "string",
                 "optional": false,
-                "is_array": true,
+                "is_array": false,
                 "comment": ""
             },
             {
-                "name": "data",
-                "type": "string",
+                "name": "nonce",
+                "type": "int",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "blockNumber",
-                "type": "int",
+                "name": "sender",
+                "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "txnHash",
+                "name": "recipient",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "blockHash",
+                "name": "gasLimit",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "gasPrice",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "index",
-                "type": "int",
+                "name": "payload",
+                "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
@@ -1736,51 +2051,58 @@ var IdlJsonRaw = `[
     },
     {
         "type": "struct",
-        "name": "Receipt",
+        "name": "Log",
         "comment": "",
         "value": "",
         "extends": "",
         "fields": [
             {
-                "name": "returnValue",
+                "name": "address",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "status",
-                "type": "int",
+                "name": "topics",
+                "type": "string",
                 "optional": false,
-                "is_array": false,
+                "is_array": true,
                 "comment": ""
             },
             {
-                "name": "hash",
+                "name": "data",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "gasConsumed",
+                "name": "blockNumber",
                 "type": "int",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "contractAddress",
+                "name": "txnHash",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "logs",
-                "type": "Log",
+                "name": "blockHash",
+                "type": "string",
                 "optional": false,
-                "is_array": true,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "index",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
                 "comment": ""
             }
         ],
@@ -1792,16 +2114,16 @@ var IdlJsonRaw = `[
     },
     {
         "type": "struct",
-        "name": "SendExecutionResponse",
+        "name": "TopicList",
         "comment": "",
         "value": "",
         "extends": "",
         "fields": [
             {
-                "name": "receipt",
-                "type": "Receipt",
+                "name": "topics",
+                "type": "string",
                 "optional": false,
-                "is_array": false,
+                "is_array": true,
                 "comment": ""
             }
         ],
@@ -1813,37 +2135,394 @@ var IdlJsonRaw = `[
     },
     {
         "type": "struct",
-        "name": "Vote",
+        "name": "LogFilter",
         "comment": "",
         "value": "",
         "extends": "",
         "fields": [
             {
-                "name": "version",
+                "name": "fromHeight",
                 "type": "int",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "ID",
-                "type": "string",
+                "name": "toHeight",
+                "type": "int",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "nonce",
-                "type": "int",
+                "name": "addresses",
+                "type": "string",
                 "optional": false,
-                "is_array": false,
+                "is_array": true,
                 "comment": ""
             },
             {
-                "name": "timestamp",
-                "type": "int",
+                "name": "topics",
+                "type": "TopicList",
                 "optional": false,
-                "is_array": false,
+                "is_array": true,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "Receipt",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "returnValue",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "status",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "hash",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "gasConsumed",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "contractAddress",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "logs",
+                "type": "Log",
+                "optional": false,
+                "is_array": true,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "TraceStep",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "pc",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "op",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "gas",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "gasCost",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "depth",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "stack",
+                "type": "string",
+                "optional": false,
+                "is_array": true,
+                "comment": ""
+            },
+            {
+                "name": "error",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "TraceCall",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "type",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "from",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "to",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "input",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "gas",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "value",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "depth",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "StorageDiff",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "address",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "key",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "original",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "current",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "ExecutionTrace",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "receipt",
+                "type": "Receipt",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "steps",
+                "type": "TraceStep",
+                "optional": false,
+                "is_array": true,
+                "comment": ""
+            },
+            {
+                "name": "calls",
+                "type": "TraceCall",
+                "optional": false,
+                "is_array": true,
+                "comment": ""
+            },
+            {
+                "name": "storageDiffs",
+                "type": "StorageDiff",
+                "optional": false,
+                "is_array": true,
+                "comment": ""
+            },
+            {
+                "name": "output",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "gasUsed",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "error",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "revertReason",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "SendExecutionResponse",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "receipt",
+                "type": "Receipt",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "Vote",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "version",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "ID",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "nonce",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "timestamp",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
                 "comment": ""
             },
             {
@@ -2013,6 +2692,34 @@ var IdlJsonRaw = `[
                 "optional": false,
                 "is_array": false,
                 "comment": ""
+            },
+            {
+                "name": "name",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "operatorAddress",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "rewardAddress",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "selfStake",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
             }
         ],
         "values": null,
@@ -2580,6 +3287,13 @@ var IdlJsonRaw = `[
                 "optional": true,
                 "is_array": false,
                 "comment": ""
+            },
+            {
+                "name": "action",
+                "type": "Action",
+                "optional": true,
+                "is_array": false,
+                "comment": ""
             }
         ],
         "values": null,
@@ -2960,54 +3674,236 @@ var IdlJsonRaw = `[
                 "comment": ""
             },
             {
-                "name": "nonce",
+                "name": "nonce",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "sender",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "recipient",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "amount",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "index",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "senderPubKey",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "signature",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "gasLimit",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "gasPrice",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "fee",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "timestamp",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "blockID",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "isPending",
+                "type": "bool",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "Timelock",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "hash",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "sender",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "amount",
+                "type": "string",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "releaseHeight",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            },
+            {
+                "name": "releaseTime",
+                "type": "int",
+                "optional": false,
+                "is_array": false,
+                "comment": ""
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "ActPoolEvent",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
+            {
+                "name": "id",
                 "type": "int",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "sender",
+                "name": "type",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "recipient",
+                "name": "actionHash",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "amount",
+                "name": "sender",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "index",
+                "name": "nonce",
                 "type": "int",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
-            },
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "ActPoolEventList",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
             {
-                "name": "senderPubKey",
-                "type": "string",
+                "name": "cursor",
+                "type": "int",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "signature",
-                "type": "string",
+                "name": "events",
+                "type": "ActPoolEvent",
                 "optional": false,
-                "is_array": false,
+                "is_array": true,
                 "comment": ""
-            },
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "BlockLimits",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
             {
                 "name": "gasLimit",
                 "type": "int",
@@ -3016,36 +3912,36 @@ var IdlJsonRaw = `[
                 "comment": ""
             },
             {
-                "name": "gasPrice",
-                "type": "string",
-                "optional": false,
-                "is_array": false,
-                "comment": ""
-            },
-            {
-                "name": "fee",
-                "type": "string",
-                "optional": false,
-                "is_array": false,
-                "comment": ""
-            },
-            {
-                "name": "timestamp",
+                "name": "sizeLimit",
                 "type": "int",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
-            },
+            }
+        ],
+        "values": null,
+        "functions": null,
+        "barrister_version": "",
+        "date_generated": 0,
+        "checksum": ""
+    },
+    {
+        "type": "struct",
+        "name": "RewardPool",
+        "comment": "",
+        "value": "",
+        "extends": "",
+        "fields": [
             {
-                "name": "blockID",
+                "name": "address",
                 "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
             },
             {
-                "name": "isPending",
-                "type": "bool",
+                "name": "balance",
+                "type": "string",
                 "optional": false,
                 "is_array": false,
                 "comment": ""
@@ -3281,6 +4177,40 @@ var IdlJsonRaw = `[
                     "comment": ""
                 }
             },
+            {
+                "name": "getTransfersByMemoTag",
+                "comment": "get list of transfers with a destination tag memo",
+                "params": [
+                    {
+                        "name": "tag",
+                        "type": "string",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    },
+                    {
+                        "name": "offset",
+                        "type": "int",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    },
+                    {
+                        "name": "limit",
+                        "type": "int",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    }
+                ],
+                "returns": {
+                    "name": "",
+                    "type": "Transfer",
+                    "optional": false,
+                    "is_array": true,
+                    "comment": ""
+                }
+            },
             {
                 "name": "getLastVotesByRange",
                 "comment": "get list of votes by start block height, vote offset and limit",
@@ -4153,6 +5083,171 @@ var IdlJsonRaw = `[
                     "is_array": false,
                     "comment": ""
                 }
+            },
+            {
+                "name": "getTimelocksByAddress",
+                "comment": "get list of pending time-locked transfers to an address",
+                "params": [
+                    {
+                        "name": "address",
+                        "type": "string",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    }
+                ],
+                "returns": {
+                    "name": "",
+                    "type": "Timelock",
+                    "optional": false,
+                    "is_array": true,
+                    "comment": ""
+                }
+            },
+            {
+                "name": "getReplacementGasPrice",
+                "comment": "get the minimum gas price to replace a pending action of an address with the given nonce",
+                "params": [
+                    {
+                        "name": "address",
+                        "type": "string",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    },
+                    {
+                        "name": "nonce",
+                        "type": "int",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    }
+                ],
+                "returns": {
+                    "name": "",
+                    "type": "string",
+                    "optional": false,
+                    "is_array": false,
+                    "comment": ""
+                }
+            },
+            {
+                "name": "getActPoolEvents",
+                "comment": "long-poll the actpool events of an address (all addresses if empty) from the cursor, waiting up to the timeout\nin milliseconds if there is none yet",
+                "params": [
+                    {
+                        "name": "address",
+                        "type": "string",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    },
+                    {
+                        "name": "cursor",
+                        "type": "int",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    },
+                    {
+                        "name": "timeout",
+                        "type": "int",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    }
+                ],
+                "returns": {
+                    "name": "",
+                    "type": "ActPoolEventList",
+                    "optional": false,
+                    "is_array": false,
+                    "comment": ""
+                }
+            },
+            {
+                "name": "getBlockLimits",
+                "comment": "get the gas limit and the size limit of the next block",
+                "params": [],
+                "returns": {
+                    "name": "",
+                    "type": "BlockLimits",
+                    "optional": false,
+                    "is_array": false,
+                    "comment": ""
+                }
+            },
+            {
+                "name": "getRewardPool",
+                "comment": "get the address and the balance of the reward pool",
+                "params": [],
+                "returns": {
+                    "name": "",
+                    "type": "RewardPool",
+                    "optional": false,
+                    "is_array": false,
+                    "comment": ""
+                }
+            },
+            {
+                "name": "getUnclaimedReward",
+                "comment": "get the rewards granted to the address but not claimed yet",
+                "params": [
+                    {
+                        "name": "address",
+                        "type": "string",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    }
+                ],
+                "returns": {
+                    "name": "",
+                    "type": "string",
+                    "optional": false,
+                    "is_array": false,
+                    "comment": ""
+                }
+            },
+            {
+                "name": "getLogs",
+                "comment": "get the logs within the heights (up to the tip height if toHeight is 0), emitted by any of the addresses, and\nmatching the topics by position, where an empty list matches any topic",
+                "params": [
+                    {
+                        "name": "filter",
+                        "type": "LogFilter",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    }
+                ],
+                "returns": {
+                    "name": "",
+                    "type": "Log",
+                    "optional": false,
+                    "is_array": true,
+                    "comment": ""
+                }
+            },
+            {
+                "name": "traceExecution",
+                "comment": "re-execute an execution on top of the states before it, and trace its opcodes, call frames, storage changes and\nrevert reason",
+                "params": [
+                    {
+                        "name": "id",
+                        "type": "string",
+                        "optional": false,
+                        "is_array": false,
+                        "comment": ""
+                    }
+                ],
+                "returns": {
+                    "name": "",
+                    "type": "ExecutionTrace",
+                    "optional": false,
+                    "is_array": false,
+                    "comment": ""
+                }
             }
         ],
         "barrister_version": "",
@@ -4169,7 +5264,7 @@ var IdlJsonRaw = `[
         "values": null,
         "functions": null,
         "barrister_version": "0.1.6",
-        "date_generated": 1546625898440,
-        "checksum": "930ad8ed4154e09b1263a8f435f6934d"
+        "date_generated": 1792351460172,
+        "checksum": "8ba5c7df3e6448852ea78aa2ee653275"
     }
 ]`
@@ -0,0 +1,306 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package explorer
+
+import (
+	"encoding/hex"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/explorer/idl/explorer"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// logStreamWindow is the number of the latest blocks kept, so that the removal notices of their logs are sent if they
+// are reverted, and the new subscriptions replay them without waiting for their receipts to be stored
+const logStreamWindow = 16
+
+// logNotice is a log streamed to a subscriber, which is removed if the block emitting it is reverted
+type logNotice struct {
+	explorer.Log
+	Removed bool `json:"removed"`
+}
+
+// logSubscription is a filter registered by a client, and the notices of the matching logs pending to be sent to it
+type logSubscription struct {
+	filter  *blockchain.LogFilter
+	notices chan logNotice
+}
+
+// logStream matches the logs of the committed blocks against the filters of the subscriptions, and streams the
+// matching ones to the clients over websocket
+type logStream struct {
+	mutex    sync.Mutex
+	bc       blockchain.Blockchain
+	cfg      config.Explorer
+	upgrader websocket.Upgrader
+	subs     map[*logSubscription]bool
+	// next is the height of the next block to match, and the blocks above it are pending until it arrives, because
+	// the blocks are handed over concurrently
+	next    uint64
+	pending map[uint64]*block.Block
+	recent  []*block.Block
+}
+
+func newLogStream(bc blockchain.Blockchain, cfg config.Explorer) *logStream {
+	return &logStream{
+		bc:  bc,
+		cfg: cfg,
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(*http.Request) bool { return true },
+		},
+		subs:    make(map[*logSubscription]bool),
+		pending: make(map[uint64]*block.Block),
+	}
+}
+
+// reset makes the stream match the blocks since the height
+func (s *logStream) reset(height uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.next = height
+	s.pending = make(map[uint64]*block.Block)
+	s.recent = nil
+}
+
+// HandleBlock matches the logs of the committed block. A block not higher than the ones matched before reverts them,
+// which sends the removal notices of their logs.
+func (s *logStream) HandleBlock(blk *block.Block) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if blk.Height() < s.next {
+		for len(s.recent) > 0 && s.recent[len(s.recent)-1].Height() >= blk.Height() {
+			s.emit(s.recent[len(s.recent)-1], true)
+			s.recent = s.recent[:len(s.recent)-1]
+		}
+		s.next = blk.Height()
+		s.pending = make(map[uint64]*block.Block)
+	}
+	s.pending[blk.Height()] = blk
+	if len(s.pending) > logStreamWindow {
+		// The next block is missing for too long, so that the stream skips to the lowest pending one
+		s.next = blk.Height()
+		for height := range s.pending {
+			if height < s.next {
+				s.next = height
+			}
+		}
+	}
+	for {
+		b, ok := s.pending[s.next]
+		if !ok {
+			break
+		}
+		delete(s.pending, s.next)
+		s.emit(b, false)
+		s.recent = append(s.recent, b)
+		if len(s.recent) > logStreamWindow {
+			s.recent = s.recent[len(s.recent)-logStreamWindow:]
+		}
+		s.next++
+	}
+	return nil
+}
+
+// emit sends the notices of the logs of the block to the matching subscriptions. A subscription whose client doesn't
+// keep up with the notices is dropped rather than blocking the others.
+func (s *logStream) emit(blk *block.Block, removed bool) {
+	blkHash := blk.HashBlock()
+	blkHashStr := hex.EncodeToString(blkHash[:])
+	for sub := range s.subs {
+		for _, notice := range logNotices(blk, blkHashStr, sub.filter, removed) {
+			select {
+			case sub.notices <- notice:
+			default:
+				s.drop(sub)
+			}
+			if !s.subs[sub] {
+				break
+			}
+		}
+	}
+}
+
+// subscribe registers the filter, and returns the subscription, the height up to which the logs are stored, and the
+// latest blocks above it
+func (s *logStream) subscribe(filter *blockchain.LogFilter) (*logSubscription, uint64, []*block.Block) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	sub := &logSubscription{
+		filter:  filter,
+		notices: make(chan logNotice, s.cfg.LogStreamBufferSize),
+	}
+	s.subs[sub] = true
+	recent := make([]*block.Block, len(s.recent))
+	copy(recent, s.recent)
+	var storedHeight uint64
+	if s.next > 0 {
+		storedHeight = s.next - 1
+	}
+	if len(recent) > 0 {
+		storedHeight = recent[0].Height() - 1
+	}
+	return sub, storedHeight, recent
+}
+
+// unsubscribe removes the subscription
+func (s *logStream) unsubscribe(sub *logSubscription) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.drop(sub)
+}
+
+func (s *logStream) drop(sub *logSubscription) {
+	if !s.subs[sub] {
+		return
+	}
+	delete(s.subs, sub)
+	close(sub.notices)
+}
+
+// replay returns the notices of the logs matching the filter since its from height, which are stored up to the height
+// and then in the latest blocks
+func (s *logStream) replay(filter *blockchain.LogFilter, storedHeight uint64, recent []*block.Block) ([]logNotice, error) {
+	if filter.FromHeight == 0 {
+		return nil, nil
+	}
+	var notices []logNotice
+	if filter.FromHeight <= storedHeight {
+		if s.cfg.MaxLogQueryRange > 0 && storedHeight-filter.FromHeight >= s.cfg.MaxLogQueryRange {
+			return nil, errors.Errorf("range of heights exceeds the limit %d", s.cfg.MaxLogQueryRange)
+		}
+		storedFilter := *filter
+		storedFilter.ToHeight = storedHeight
+		logs, err := s.bc.GetLogs(&storedFilter)
+		if err != nil {
+			return nil, err
+		}
+		blkHashes := make(map[uint64]string)
+		for _, l := range logs {
+			blkHashStr, ok := blkHashes[l.BlockNumber]
+			if !ok {
+				blkHash, err := s.bc.GetHashByHeight(l.BlockNumber)
+				if err != nil {
+					return nil, err
+				}
+				blkHashStr = hex.EncodeToString(blkHash[:])
+				blkHashes[l.BlockNumber] = blkHashStr
+			}
+			notice := logNotice{Log: convertLogToExplorerLog(l)}
+			notice.BlockHash = blkHashStr
+			notices = append(notices, notice)
+		}
+	}
+	for _, blk := range recent {
+		if blk.Height() < filter.FromHeight {
+			continue
+		}
+		blkHash := blk.HashBlock()
+		notices = append(notices, logNotices(blk, hex.EncodeToString(blkHash[:]), filter, false)...)
+	}
+	return notices, nil
+}
+
+// ServeHTTP upgrades the request to websocket, and reads the filter of the subscription from the first message. Then
+// it streams the logs matching the filter since its from height, or the ones of the next blocks if it's 0, along with
+// the removal notices of the logs of the reverted blocks.
+func (s *logStream) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.L().Debug("Error when upgrading to websocket.", zap.Error(err))
+		return
+	}
+	defer conn.Close()
+
+	var req explorer.LogFilter
+	if err := conn.ReadJSON(&req); err != nil {
+		closeLogStream(conn, websocket.CloseUnsupportedData, err)
+		return
+	}
+	filter, err := convertExplorerLogFilter(req)
+	if err != nil {
+		closeLogStream(conn, websocket.CloseUnsupportedData, err)
+		return
+	}
+	sub, storedHeight, recent := s.subscribe(filter)
+	defer s.unsubscribe(sub)
+	notices, err := s.replay(filter, storedHeight, recent)
+	if err != nil {
+		closeLogStream(conn, websocket.ClosePolicyViolation, err)
+		return
+	}
+
+	// The client isn't expected to send anything else, but the connection is read to handle the close message
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for _, notice := range notices {
+		if err := conn.WriteJSON(notice); err != nil {
+			return
+		}
+	}
+	for {
+		select {
+		case notice, ok := <-sub.notices:
+			if !ok {
+				closeLogStream(conn, websocket.CloseTryAgainLater, errors.New("subscription falls behind"))
+				return
+			}
+			if err := conn.WriteJSON(notice); err != nil {
+				return
+			}
+		case <-closed:
+			return
+		}
+	}
+}
+
+// logNotices returns the notices of the logs of the block matching the filter, in the order they are emitted
+func logNotices(blk *block.Block, blkHash string, filter *blockchain.LogFilter, removed bool) []logNotice {
+	var notices []logNotice
+	for _, selp := range blk.Actions {
+		receipt, ok := blk.Receipts[selp.Hash()]
+		if !ok {
+			continue
+		}
+		for _, l := range receipt.Logs {
+			if !filter.Match(l) {
+				continue
+			}
+			notice := logNotice{Log: convertLogToExplorerLog(l), Removed: removed}
+			notice.BlockNumber = int64(blk.Height())
+			notice.BlockHash = blkHash
+			notices = append(notices, notice)
+		}
+	}
+	return notices
+}
+
+func closeLogStream(conn *websocket.Conn, code int, err error) {
+	msg := websocket.FormatCloseMessage(code, err.Error())
+	if err := conn.WriteMessage(websocket.CloseMessage, msg); err != nil {
+		log.L().Debug("Error when closing the log stream.", zap.Error(err))
+	}
+}
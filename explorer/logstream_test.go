@@ -0,0 +1,167 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package explorer
+
+import (
+	"encoding/hex"
+	"math/big"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/explorer/idl/explorer"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
+	ta "github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func newLogStreamTestBlock(t *testing.T, height uint64, nonce uint64, logs ...*action.Log) *block.Block {
+	require := require.New(t)
+	tsf, err := action.NewTransfer(
+		nonce,
+		big.NewInt(1),
+		ta.IotxAddrinfo["alfa"].RawAddress,
+		ta.IotxAddrinfo["bravo"].RawAddress,
+		nil,
+		testutil.TestGasLimit,
+		big.NewInt(0),
+	)
+	require.NoError(err)
+	bd := &action.EnvelopeBuilder{}
+	elp := bd.SetNonce(nonce).
+		SetDestinationAddress(ta.IotxAddrinfo["bravo"].RawAddress).
+		SetGasLimit(testutil.TestGasLimit).
+		SetAction(tsf).Build()
+	selp, err := action.Sign(elp, ta.IotxAddrinfo["alfa"].RawAddress, ta.IotxAddrinfo["alfa"].PrivateKey)
+	require.NoError(err)
+	blk, err := block.NewTestingBuilder().
+		SetHeight(height).
+		AddActions(selp).
+		SignAndBuild(ta.IotxAddrinfo["producer"])
+	require.NoError(err)
+	blk.Receipts = map[hash.Hash32B]*action.Receipt{
+		selp.Hash(): {Hash: selp.Hash(), Logs: logs},
+	}
+	return &blk
+}
+
+func TestLogStream_HandleBlock(t *testing.T) {
+	require := require.New(t)
+
+	blk1 := newLogStreamTestBlock(t, 1, 1, &action.Log{Address: "io1a", BlockNumber: 1})
+	blk2 := newLogStreamTestBlock(t, 2, 2, &action.Log{Address: "io1b", BlockNumber: 2})
+	blk3 := newLogStreamTestBlock(t, 3, 3, &action.Log{Address: "io1a", BlockNumber: 3})
+	forkBlk3 := newLogStreamTestBlock(t, 3, 4, &action.Log{Address: "io1a", BlockNumber: 3})
+	blkHash3 := blk3.HashBlock()
+	forkBlkHash3 := forkBlk3.HashBlock()
+
+	s := newLogStream(nil, config.Default.Explorer)
+	s.reset(1)
+	sub, storedHeight, recent := s.subscribe(&blockchain.LogFilter{Addresses: []string{"io1a"}})
+	require.Equal(uint64(0), storedHeight)
+	require.Empty(recent)
+
+	// The blocks handed over out of order are matched in order
+	require.NoError(s.HandleBlock(blk2))
+	require.Empty(sub.notices)
+	require.NoError(s.HandleBlock(blk1))
+	require.NoError(s.HandleBlock(blk3))
+	notice := <-sub.notices
+	require.Equal(int64(1), notice.BlockNumber)
+	require.False(notice.Removed)
+	notice = <-sub.notices
+	require.Equal(hex.EncodeToString(blkHash3[:]), notice.BlockHash)
+	require.False(notice.Removed)
+
+	// The block at the same height reverts the one matched before
+	require.NoError(s.HandleBlock(forkBlk3))
+	notice = <-sub.notices
+	require.Equal(hex.EncodeToString(blkHash3[:]), notice.BlockHash)
+	require.True(notice.Removed)
+	notice = <-sub.notices
+	require.Equal(hex.EncodeToString(forkBlkHash3[:]), notice.BlockHash)
+	require.False(notice.Removed)
+	require.Empty(sub.notices)
+
+	// A new subscription replays the latest blocks since its from height
+	filter := &blockchain.LogFilter{FromHeight: 2}
+	_, storedHeight, recent = s.subscribe(filter)
+	require.Equal(uint64(0), storedHeight)
+	notices, err := s.replay(filter, storedHeight, recent)
+	require.NoError(err)
+	require.Equal(2, len(notices))
+	require.Equal("io1b", notices[0].Address)
+	require.Equal(hex.EncodeToString(forkBlkHash3[:]), notices[1].BlockHash)
+
+	// A subscription falling behind is dropped
+	cfg := config.Default.Explorer
+	cfg.LogStreamBufferSize = 1
+	s = newLogStream(nil, cfg)
+	s.reset(1)
+	sub, _, _ = s.subscribe(&blockchain.LogFilter{})
+	require.NoError(s.HandleBlock(blk1))
+	require.NoError(s.HandleBlock(blk2))
+	_, ok := <-sub.notices
+	require.True(ok)
+	_, ok = <-sub.notices
+	require.False(ok)
+	require.Empty(s.subs)
+}
+
+func TestLogStream_Websocket(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	blkHash1 := hash.Hash256b([]byte("block1"))
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().GetLogs(&blockchain.LogFilter{
+		FromHeight: 1,
+		ToHeight:   2,
+		Addresses:  []string{"io1a"},
+	}).Return([]*action.Log{{Address: "io1a", BlockNumber: 1}}, nil).Times(1)
+	bc.EXPECT().GetHashByHeight(uint64(1)).Return(blkHash1, nil).Times(1)
+
+	s := newLogStream(bc, config.Default.Explorer)
+	s.reset(3)
+	svr := httptest.NewServer(s)
+	defer svr.Close()
+	url := "ws" + strings.TrimPrefix(svr.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(err)
+	defer conn.Close()
+	require.NoError(conn.WriteJSON(explorer.LogFilter{FromHeight: 1, Addresses: []string{"io1a"}}))
+	var notice logNotice
+	require.NoError(conn.ReadJSON(&notice))
+	require.Equal(int64(1), notice.BlockNumber)
+	require.Equal(hex.EncodeToString(blkHash1[:]), notice.BlockHash)
+
+	// The logs of the next blocks are streamed after the stored ones
+	blk3 := newLogStreamTestBlock(t, 3, 3, &action.Log{Address: "io1a", BlockNumber: 3})
+	require.NoError(s.HandleBlock(blk3))
+	require.NoError(conn.ReadJSON(&notice))
+	require.Equal(int64(3), notice.BlockNumber)
+	require.False(notice.Removed)
+
+	// An invalid filter closes the connection
+	badConn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(err)
+	defer badConn.Close()
+	require.NoError(badConn.WriteJSON(explorer.LogFilter{Topics: []explorer.TopicList{{Topics: []string{"1234"}}}}))
+	_, _, err = badConn.ReadMessage()
+	require.True(websocket.IsCloseError(err, websocket.CloseUnsupportedData))
+}
@@ -66,6 +66,7 @@ type Server struct {
 	cfg     config.Explorer
 	exp     explorer.Explorer
 	jrpcSvr barrister.Server
+	logs    *logStream
 	httpSvr http.Server
 	port    int
 }
@@ -99,7 +100,9 @@ func NewServer(
 			cfg:              cfg,
 			idx:              idx,
 			gs:               GasStation{bc: chain, cfg: cfg},
+			events:           newActPoolEventBuffer(cfg.ActPoolEventBufferSize),
 		},
+		logs: newLogStream(chain, cfg),
 	}, nil
 }
 
@@ -114,13 +117,27 @@ func (s *Server) SetMainChainProtocol(p *mainchain.Protocol) {
 
 // Start starts the explorer server
 func (s *Server) Start(_ context.Context) error {
+	if svr, ok := s.exp.(*Service); ok && svr.ap != nil {
+		if err := svr.ap.AddSubscriber(svr.events); err != nil {
+			return errors.Wrap(err, "error when subscribing actpool events")
+		}
+	}
+	if s.logs.bc != nil {
+		s.logs.reset(s.logs.bc.TipHeight() + 1)
+		if err := s.logs.bc.AddSubscriber(s.logs); err != nil {
+			return errors.Wrap(err, "error when subscribing blocks")
+		}
+	}
 	portStr := strconv.Itoa(s.cfg.Port)
 	started := make(chan bool)
 	go func(started chan bool) {
 		idl := barrister.MustParseIdlJson([]byte(explorer.IdlJsonRaw))
 		s.jrpcSvr = explorer.NewJSONServer(idl, true, s.exp)
 		s.jrpcSvr.AddFilter(logFilter{})
-		s.httpSvr = http.Server{Handler: &corsAdaptor{expSvr: s.jrpcSvr}}
+		mux := http.NewServeMux()
+		mux.Handle("/logs", s.logs)
+		mux.Handle("/", &corsAdaptor{expSvr: s.jrpcSvr})
+		s.httpSvr = http.Server{Handler: mux}
 		listener, err := net.Listen("tcp", ":"+portStr)
 		if err != nil {
 			log.L().Panic("Error when creating network listener", zap.Error(err))
@@ -147,6 +164,16 @@ func (s *Server) Start(_ context.Context) error {
 
 // Stop stops the explorer server
 func (s *Server) Stop(ctx context.Context) error {
+	if svr, ok := s.exp.(*Service); ok && svr.ap != nil {
+		if err := svr.ap.RemoveSubscriber(svr.events); err != nil {
+			return errors.Wrap(err, "error when unsubscribing actpool events")
+		}
+	}
+	if s.logs.bc != nil {
+		if err := s.logs.bc.RemoveSubscriber(s.logs); err != nil {
+			return errors.Wrap(err, "error when unsubscribing blocks")
+		}
+	}
 	if err := s.httpSvr.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "error when shutting down explorer http server")
 	}
@@ -19,10 +19,11 @@ func TestServer(t *testing.T) {
 	require := require.New(t)
 
 	// create chain
-	bc := blockchain.NewBlockchain(config.Default, blockchain.InMemDaoOption())
+	bc, err := blockchain.NewBlockchain(config.Default, blockchain.InMemDaoOption())
+	require.NoError(err)
 
 	svr := NewServer(config.Default, bc)
-	err := svr.Start(nil)
+	err = svr.Start(nil)
 	require.Nil(err)
 
 	db := svr.idx.rds.GetDB()
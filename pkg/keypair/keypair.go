@@ -17,6 +17,41 @@ import (
 const (
 	pubKeyLength  = 72
 	privKeyLength = 36
+
+	// keyTypeMarker is set in the last byte of keys that are not EC283 keys. EC283 keys are serialized as
+	// little-endian 283-bit integers, so the 5 most significant bits of their last byte are always zero.
+	keyTypeMarker = 0xf8
+)
+
+// KeyType indicates the signature scheme a key belongs to
+type KeyType uint8
+
+const (
+	// EC283 indicates a key on the sect283k1 curve, signed via the cgo library
+	EC283 KeyType = iota
+	// Secp256k1 indicates a key on the secp256k1 curve, signed in pure Go
+	Secp256k1
+	// UnknownKeyType indicates a key carrying an unrecognized type tag
+	UnknownKeyType
+)
+
+// String returns the name of the key type
+func (t KeyType) String() string {
+	switch t {
+	case EC283:
+		return "ec283"
+	case Secp256k1:
+		return "secp256k1"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	// rawPubKeyLength is the length of the raw public key embedded in a tagged PublicKey
+	rawPubKeyLength = map[KeyType]int{Secp256k1: 33}
+	// rawPrivKeyLength is the length of the raw private key embedded in a tagged PrivateKey
+	rawPrivKeyLength = map[KeyType]int{Secp256k1: 32}
 )
 
 var (
@@ -131,3 +166,77 @@ func HashPubKey(pubKey PublicKey) hash.PKHash {
 	copy(pkHash[:], hash.Hash160b(pubKey[:]))
 	return pkHash
 }
+
+// PublicKeyType returns the type of the public key
+func PublicKeyType(pubKey PublicKey) KeyType {
+	return keyType(pubKey[pubKeyLength-1])
+}
+
+// PrivateKeyType returns the type of the private key
+func PrivateKeyType(priKey PrivateKey) KeyType {
+	return keyType(priKey[privKeyLength-1])
+}
+
+// PackPublicKey embeds a raw public key of the given type into a PublicKey
+func PackPublicKey(t KeyType, raw []byte) (PublicKey, error) {
+	if t == EC283 {
+		return BytesToPublicKey(raw)
+	}
+	if l, ok := rawPubKeyLength[t]; !ok || len(raw) != l {
+		return ZeroPublicKey, errors.Wrapf(ErrPublicKey, "invalid %s public key length", t)
+	}
+	var publicKey PublicKey
+	copy(publicKey[:], raw)
+	publicKey[pubKeyLength-1] = keyTypeMarker | uint8(t)
+	return publicKey, nil
+}
+
+// UnpackPublicKey returns the raw public key embedded in a PublicKey of the given type
+func UnpackPublicKey(t KeyType, pubKey PublicKey) ([]byte, error) {
+	if PublicKeyType(pubKey) != t {
+		return nil, errors.Wrapf(ErrPublicKey, "public key is not a %s key", t)
+	}
+	if t == EC283 {
+		return pubKey[:], nil
+	}
+	return pubKey[:rawPubKeyLength[t]], nil
+}
+
+// PackPrivateKey embeds a raw private key of the given type into a PrivateKey
+func PackPrivateKey(t KeyType, raw []byte) (PrivateKey, error) {
+	if t == EC283 {
+		return BytesToPrivateKey(raw)
+	}
+	if l, ok := rawPrivKeyLength[t]; !ok || len(raw) != l {
+		return ZeroPrivateKey, errors.Wrapf(ErrPrivateKey, "invalid %s private key length", t)
+	}
+	var privateKey PrivateKey
+	copy(privateKey[:], raw)
+	privateKey[privKeyLength-1] = keyTypeMarker | uint8(t)
+	return privateKey, nil
+}
+
+// UnpackPrivateKey returns the raw private key embedded in a PrivateKey of the given type
+func UnpackPrivateKey(t KeyType, priKey PrivateKey) ([]byte, error) {
+	if PrivateKeyType(priKey) != t {
+		return nil, errors.Wrapf(ErrPrivateKey, "private key is not a %s key", t)
+	}
+	if t == EC283 {
+		return priKey[:], nil
+	}
+	return priKey[:rawPrivKeyLength[t]], nil
+}
+
+func keyType(tag byte) KeyType {
+	if tag&keyTypeMarker == 0 {
+		return EC283
+	}
+	if tag&keyTypeMarker != keyTypeMarker {
+		return UnknownKeyType
+	}
+	t := KeyType(tag &^ keyTypeMarker)
+	if _, ok := rawPubKeyLength[t]; !ok {
+		return UnknownKeyType
+	}
+	return t
+}
@@ -51,3 +51,39 @@ func TestKeypair(t *testing.T) {
 	require.Equal(publicKey, pubKeyString)
 	require.Equal(privateKey, priKeyString)
 }
+
+func TestKeyTypeTag(t *testing.T) {
+	require := require.New(t)
+
+	ec283PubKey, err := DecodePublicKey(publicKey)
+	require.NoError(err)
+	require.Equal(EC283, PublicKeyType(ec283PubKey))
+	raw, err := UnpackPublicKey(EC283, ec283PubKey)
+	require.NoError(err)
+	require.Equal(ec283PubKey[:], raw)
+
+	rawPubKey := make([]byte, 33)
+	rawPubKey[0] = 0x02
+	pubKey, err := PackPublicKey(Secp256k1, rawPubKey)
+	require.NoError(err)
+	require.Equal(Secp256k1, PublicKeyType(pubKey))
+	raw, err = UnpackPublicKey(Secp256k1, pubKey)
+	require.NoError(err)
+	require.Equal(rawPubKey, raw)
+	_, err = UnpackPublicKey(EC283, pubKey)
+	require.Equal(ErrPublicKey, errors.Cause(err))
+	_, err = PackPublicKey(Secp256k1, rawPubKey[1:])
+	require.Equal(ErrPublicKey, errors.Cause(err))
+
+	rawPriKey := make([]byte, 32)
+	rawPriKey[31] = 0x01
+	priKey, err := PackPrivateKey(Secp256k1, rawPriKey)
+	require.NoError(err)
+	require.Equal(Secp256k1, PrivateKeyType(priKey))
+	raw, err = UnpackPrivateKey(Secp256k1, priKey)
+	require.NoError(err)
+	require.Equal(rawPriKey, raw)
+
+	pubKey[pubKeyLength-1] = 0xff
+	require.Equal(UnknownKeyType, PublicKeyType(pubKey))
+}
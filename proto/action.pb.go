@@ -19,12 +19,39 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type MemoPb_MemoType int32
+
+const (
+	MemoPb_NOTE           MemoPb_MemoType = 0
+	MemoPb_TAG            MemoPb_MemoType = 1
+	MemoPb_ENCRYPTED_NOTE MemoPb_MemoType = 2
+)
+
+var MemoPb_MemoType_name = map[int32]string{
+	0: "NOTE",
+	1: "TAG",
+	2: "ENCRYPTED_NOTE",
+}
+var MemoPb_MemoType_value = map[string]int32{
+	"NOTE":           0,
+	"TAG":            1,
+	"ENCRYPTED_NOTE": 2,
+}
+
+func (x MemoPb_MemoType) String() string {
+	return proto.EnumName(MemoPb_MemoType_name, int32(x))
+}
+func (MemoPb_MemoType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{1, 0}
+}
+
 type TransferPb struct {
 	// used by state-based model
 	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	Recipient            string   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 	IsCoinbase           bool     `protobuf:"varint,4,opt,name=isCoinbase,proto3" json:"isCoinbase,omitempty"`
+	Memo                 *MemoPb  `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -82,6 +109,60 @@ func (m *TransferPb) GetIsCoinbase() bool {
 	return false
 }
 
+func (m *TransferPb) GetMemo() *MemoPb {
+	if m != nil {
+		return m.Memo
+	}
+	return nil
+}
+
+// typed memo attached to a transfer
+type MemoPb struct {
+	Type                 MemoPb_MemoType `protobuf:"varint,1,opt,name=type,proto3,enum=iproto.MemoPb_MemoType" json:"type,omitempty"`
+	Data                 []byte          `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MemoPb) Reset()         { *m = MemoPb{} }
+func (m *MemoPb) String() string { return proto.CompactTextString(m) }
+func (*MemoPb) ProtoMessage()    {}
+func (*MemoPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{1}
+}
+func (m *MemoPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemoPb.Unmarshal(m, b)
+}
+func (m *MemoPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemoPb.Marshal(b, m, deterministic)
+}
+func (dst *MemoPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemoPb.Merge(dst, src)
+}
+func (m *MemoPb) XXX_Size() int {
+	return xxx_messageInfo_MemoPb.Size(m)
+}
+func (m *MemoPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemoPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemoPb proto.InternalMessageInfo
+
+func (m *MemoPb) GetType() MemoPb_MemoType {
+	if m != nil {
+		return m.Type
+	}
+	return MemoPb_NOTE
+}
+
+func (m *MemoPb) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type VotePb struct {
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	VoteeAddress         string               `protobuf:"bytes,2,opt,name=voteeAddress,proto3" json:"voteeAddress,omitempty"`
@@ -94,7 +175,7 @@ func (m *VotePb) Reset()         { *m = VotePb{} }
 func (m *VotePb) String() string { return proto.CompactTextString(m) }
 func (*VotePb) ProtoMessage()    {}
 func (*VotePb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{1}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{2}
 }
 func (m *VotePb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VotePb.Unmarshal(m, b)
@@ -141,7 +222,7 @@ func (m *ExecutionPb) Reset()         { *m = ExecutionPb{} }
 func (m *ExecutionPb) String() string { return proto.CompactTextString(m) }
 func (*ExecutionPb) ProtoMessage()    {}
 func (*ExecutionPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{2}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{3}
 }
 func (m *ExecutionPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ExecutionPb.Unmarshal(m, b)
@@ -194,7 +275,7 @@ func (m *SecretProposalPb) Reset()         { *m = SecretProposalPb{} }
 func (m *SecretProposalPb) String() string { return proto.CompactTextString(m) }
 func (*SecretProposalPb) ProtoMessage()    {}
 func (*SecretProposalPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{3}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{4}
 }
 func (m *SecretProposalPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SecretProposalPb.Unmarshal(m, b)
@@ -239,7 +320,7 @@ func (m *SecretWitnessPb) Reset()         { *m = SecretWitnessPb{} }
 func (m *SecretWitnessPb) String() string { return proto.CompactTextString(m) }
 func (*SecretWitnessPb) ProtoMessage()    {}
 func (*SecretWitnessPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{4}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{5}
 }
 func (m *SecretWitnessPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SecretWitnessPb.Unmarshal(m, b)
@@ -282,7 +363,7 @@ func (m *StartSubChainPb) Reset()         { *m = StartSubChainPb{} }
 func (m *StartSubChainPb) String() string { return proto.CompactTextString(m) }
 func (*StartSubChainPb) ProtoMessage()    {}
 func (*StartSubChainPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{5}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{6}
 }
 func (m *StartSubChainPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StartSubChainPb.Unmarshal(m, b)
@@ -350,7 +431,7 @@ func (m *StopSubChainPb) Reset()         { *m = StopSubChainPb{} }
 func (m *StopSubChainPb) String() string { return proto.CompactTextString(m) }
 func (*StopSubChainPb) ProtoMessage()    {}
 func (*StopSubChainPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{6}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{7}
 }
 func (m *StopSubChainPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StopSubChainPb.Unmarshal(m, b)
@@ -403,7 +484,7 @@ func (m *MerkleRoot) Reset()         { *m = MerkleRoot{} }
 func (m *MerkleRoot) String() string { return proto.CompactTextString(m) }
 func (*MerkleRoot) ProtoMessage()    {}
 func (*MerkleRoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{7}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{8}
 }
 func (m *MerkleRoot) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MerkleRoot.Unmarshal(m, b)
@@ -450,7 +531,7 @@ func (m *PutBlockPb) Reset()         { *m = PutBlockPb{} }
 func (m *PutBlockPb) String() string { return proto.CompactTextString(m) }
 func (*PutBlockPb) ProtoMessage()    {}
 func (*PutBlockPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{8}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{9}
 }
 func (m *PutBlockPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutBlockPb.Unmarshal(m, b)
@@ -503,7 +584,7 @@ func (m *CreateDepositPb) Reset()         { *m = CreateDepositPb{} }
 func (m *CreateDepositPb) String() string { return proto.CompactTextString(m) }
 func (*CreateDepositPb) ProtoMessage()    {}
 func (*CreateDepositPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{9}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{10}
 }
 func (m *CreateDepositPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateDepositPb.Unmarshal(m, b)
@@ -550,7 +631,7 @@ func (m *SettleDepositPb) Reset()         { *m = SettleDepositPb{} }
 func (m *SettleDepositPb) String() string { return proto.CompactTextString(m) }
 func (*SettleDepositPb) ProtoMessage()    {}
 func (*SettleDepositPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{10}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{11}
 }
 func (m *SettleDepositPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SettleDepositPb.Unmarshal(m, b)
@@ -602,7 +683,7 @@ func (m *CreatePlumChainPb) Reset()         { *m = CreatePlumChainPb{} }
 func (m *CreatePlumChainPb) String() string { return proto.CompactTextString(m) }
 func (*CreatePlumChainPb) ProtoMessage()    {}
 func (*CreatePlumChainPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{11}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{12}
 }
 func (m *CreatePlumChainPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreatePlumChainPb.Unmarshal(m, b)
@@ -633,7 +714,7 @@ func (m *TerminatePlumChainPb) Reset()         { *m = TerminatePlumChainPb{} }
 func (m *TerminatePlumChainPb) String() string { return proto.CompactTextString(m) }
 func (*TerminatePlumChainPb) ProtoMessage()    {}
 func (*TerminatePlumChainPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{12}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{13}
 }
 func (m *TerminatePlumChainPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TerminatePlumChainPb.Unmarshal(m, b)
@@ -673,7 +754,7 @@ func (m *PlumPutBlockPb) Reset()         { *m = PlumPutBlockPb{} }
 func (m *PlumPutBlockPb) String() string { return proto.CompactTextString(m) }
 func (*PlumPutBlockPb) ProtoMessage()    {}
 func (*PlumPutBlockPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{13}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{14}
 }
 func (m *PlumPutBlockPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlumPutBlockPb.Unmarshal(m, b)
@@ -727,7 +808,7 @@ func (m *PlumCreateDepositPb) Reset()         { *m = PlumCreateDepositPb{} }
 func (m *PlumCreateDepositPb) String() string { return proto.CompactTextString(m) }
 func (*PlumCreateDepositPb) ProtoMessage()    {}
 func (*PlumCreateDepositPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{14}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{15}
 }
 func (m *PlumCreateDepositPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlumCreateDepositPb.Unmarshal(m, b)
@@ -785,7 +866,7 @@ func (m *PlumStartExitPb) Reset()         { *m = PlumStartExitPb{} }
 func (m *PlumStartExitPb) String() string { return proto.CompactTextString(m) }
 func (*PlumStartExitPb) ProtoMessage()    {}
 func (*PlumStartExitPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{15}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{16}
 }
 func (m *PlumStartExitPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlumStartExitPb.Unmarshal(m, b)
@@ -869,7 +950,7 @@ func (m *PlumChallengeExit) Reset()         { *m = PlumChallengeExit{} }
 func (m *PlumChallengeExit) String() string { return proto.CompactTextString(m) }
 func (*PlumChallengeExit) ProtoMessage()    {}
 func (*PlumChallengeExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{16}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{17}
 }
 func (m *PlumChallengeExit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlumChallengeExit.Unmarshal(m, b)
@@ -940,7 +1021,7 @@ func (m *PlumResponseChallengeExit) Reset()         { *m = PlumResponseChallenge
 func (m *PlumResponseChallengeExit) String() string { return proto.CompactTextString(m) }
 func (*PlumResponseChallengeExit) ProtoMessage()    {}
 func (*PlumResponseChallengeExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{17}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{18}
 }
 func (m *PlumResponseChallengeExit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlumResponseChallengeExit.Unmarshal(m, b)
@@ -1014,7 +1095,7 @@ func (m *PlumFinalizeExit) Reset()         { *m = PlumFinalizeExit{} }
 func (m *PlumFinalizeExit) String() string { return proto.CompactTextString(m) }
 func (*PlumFinalizeExit) ProtoMessage()    {}
 func (*PlumFinalizeExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{18}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{19}
 }
 func (m *PlumFinalizeExit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlumFinalizeExit.Unmarshal(m, b)
@@ -1060,7 +1141,7 @@ func (m *PlumSettleDepositPb) Reset()         { *m = PlumSettleDepositPb{} }
 func (m *PlumSettleDepositPb) String() string { return proto.CompactTextString(m) }
 func (*PlumSettleDepositPb) ProtoMessage()    {}
 func (*PlumSettleDepositPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{19}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{20}
 }
 func (m *PlumSettleDepositPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlumSettleDepositPb.Unmarshal(m, b)
@@ -1101,7 +1182,7 @@ func (m *PlumTransferPb) Reset()         { *m = PlumTransferPb{} }
 func (m *PlumTransferPb) String() string { return proto.CompactTextString(m) }
 func (*PlumTransferPb) ProtoMessage()    {}
 func (*PlumTransferPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{20}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{21}
 }
 func (m *PlumTransferPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PlumTransferPb.Unmarshal(m, b)
@@ -1149,203 +1230,939 @@ func (m *PlumTransferPb) GetRecipient() string {
 	return ""
 }
 
-type ActionPb struct {
-	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// TODO: we should remove sender address later
-	Sender       string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	SenderPubKey []byte `protobuf:"bytes,3,opt,name=senderPubKey,proto3" json:"senderPubKey,omitempty"`
-	Nonce        uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	GasLimit     uint64 `protobuf:"varint,5,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
-	GasPrice     []byte `protobuf:"bytes,6,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
-	Signature    []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
-	// Types that are valid to be assigned to Action:
-	//	*ActionPb_Transfer
-	//	*ActionPb_Vote
-	//	*ActionPb_Execution
-	//	*ActionPb_SecretProposal
-	//	*ActionPb_SecretWitness
-	//	*ActionPb_StartSubChain
-	//	*ActionPb_StopSubChain
-	//	*ActionPb_PutBlock
-	//	*ActionPb_CreateDeposit
-	//	*ActionPb_SettleDeposit
-	//	*ActionPb_CreatePlumChain
-	//	*ActionPb_TerminatePlumChain
-	//	*ActionPb_PlumPutBlock
-	//	*ActionPb_PlumCreateDeposit
-	//	*ActionPb_PlumStartExit
-	//	*ActionPb_PlumChallengeExit
-	//	*ActionPb_PlumResponseChallengeExit
-	//	*ActionPb_PlumFinalizeExit
-	//	*ActionPb_PlumSettleDeposit
-	//	*ActionPb_PlumTransfer
-	Action               isActionPb_Action `protobuf_oneof:"action"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// action of a kind registered by a protocol outside the core
+type GenericActionPb struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Recipient            string   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ActionPb) Reset()         { *m = ActionPb{} }
-func (m *ActionPb) String() string { return proto.CompactTextString(m) }
-func (*ActionPb) ProtoMessage()    {}
-func (*ActionPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{21}
+func (m *GenericActionPb) Reset()         { *m = GenericActionPb{} }
+func (m *GenericActionPb) String() string { return proto.CompactTextString(m) }
+func (*GenericActionPb) ProtoMessage()    {}
+func (*GenericActionPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{22}
 }
-func (m *ActionPb) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ActionPb.Unmarshal(m, b)
+func (m *GenericActionPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GenericActionPb.Unmarshal(m, b)
 }
-func (m *ActionPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ActionPb.Marshal(b, m, deterministic)
+func (m *GenericActionPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GenericActionPb.Marshal(b, m, deterministic)
 }
-func (dst *ActionPb) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActionPb.Merge(dst, src)
+func (dst *GenericActionPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenericActionPb.Merge(dst, src)
 }
-func (m *ActionPb) XXX_Size() int {
-	return xxx_messageInfo_ActionPb.Size(m)
+func (m *GenericActionPb) XXX_Size() int {
+	return xxx_messageInfo_GenericActionPb.Size(m)
 }
-func (m *ActionPb) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActionPb.DiscardUnknown(m)
+func (m *GenericActionPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenericActionPb.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActionPb proto.InternalMessageInfo
+var xxx_messageInfo_GenericActionPb proto.InternalMessageInfo
 
-type isActionPb_Action interface {
-	isActionPb_Action()
+func (m *GenericActionPb) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
 }
 
-type ActionPb_Transfer struct {
-	Transfer *TransferPb `protobuf:"bytes,10,opt,name=transfer,proto3,oneof"`
-}
-type ActionPb_Vote struct {
-	Vote *VotePb `protobuf:"bytes,11,opt,name=vote,proto3,oneof"`
-}
-type ActionPb_Execution struct {
-	Execution *ExecutionPb `protobuf:"bytes,12,opt,name=execution,proto3,oneof"`
-}
-type ActionPb_SecretProposal struct {
-	SecretProposal *SecretProposalPb `protobuf:"bytes,13,opt,name=secretProposal,proto3,oneof"`
+func (m *GenericActionPb) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
-type ActionPb_SecretWitness struct {
-	SecretWitness *SecretWitnessPb `protobuf:"bytes,14,opt,name=secretWitness,proto3,oneof"`
+
+func (m *GenericActionPb) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
 }
-type ActionPb_StartSubChain struct {
-	StartSubChain *StartSubChainPb `protobuf:"bytes,15,opt,name=startSubChain,proto3,oneof"`
+
+// several action payloads executed atomically under one envelope
+type BatchPb struct {
+	Actions              []*ActionPb `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-type ActionPb_StopSubChain struct {
-	StopSubChain *StopSubChainPb `protobuf:"bytes,16,opt,name=stopSubChain,proto3,oneof"`
+
+func (m *BatchPb) Reset()         { *m = BatchPb{} }
+func (m *BatchPb) String() string { return proto.CompactTextString(m) }
+func (*BatchPb) ProtoMessage()    {}
+func (*BatchPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{23}
 }
-type ActionPb_PutBlock struct {
-	PutBlock *PutBlockPb `protobuf:"bytes,17,opt,name=putBlock,proto3,oneof"`
+func (m *BatchPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchPb.Unmarshal(m, b)
 }
-type ActionPb_CreateDeposit struct {
-	CreateDeposit *CreateDepositPb `protobuf:"bytes,18,opt,name=createDeposit,proto3,oneof"`
+func (m *BatchPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchPb.Marshal(b, m, deterministic)
 }
-type ActionPb_SettleDeposit struct {
-	SettleDeposit *SettleDepositPb `protobuf:"bytes,19,opt,name=settleDeposit,proto3,oneof"`
+func (dst *BatchPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPb.Merge(dst, src)
 }
-type ActionPb_CreatePlumChain struct {
-	CreatePlumChain *CreatePlumChainPb `protobuf:"bytes,20,opt,name=createPlumChain,proto3,oneof"`
+func (m *BatchPb) XXX_Size() int {
+	return xxx_messageInfo_BatchPb.Size(m)
 }
-type ActionPb_TerminatePlumChain struct {
-	TerminatePlumChain *TerminatePlumChainPb `protobuf:"bytes,21,opt,name=terminatePlumChain,proto3,oneof"`
+func (m *BatchPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPb.DiscardUnknown(m)
 }
-type ActionPb_PlumPutBlock struct {
-	PlumPutBlock *PlumPutBlockPb `protobuf:"bytes,22,opt,name=plumPutBlock,proto3,oneof"`
+
+var xxx_messageInfo_BatchPb proto.InternalMessageInfo
+
+func (m *BatchPb) GetActions() []*ActionPb {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
 }
-type ActionPb_PlumCreateDeposit struct {
-	PlumCreateDeposit *PlumCreateDepositPb `protobuf:"bytes,23,opt,name=plumCreateDeposit,proto3,oneof"`
+
+// transfer whose amount is only released to the recipient at or after the given height and/or time
+type TimelockTransferPb struct {
+	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Recipient            string   `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	ReleaseHeight        uint64   `protobuf:"varint,3,opt,name=releaseHeight,proto3" json:"releaseHeight,omitempty"`
+	ReleaseTime          int64    `protobuf:"varint,4,opt,name=releaseTime,proto3" json:"releaseTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-type ActionPb_PlumStartExit struct {
-	PlumStartExit *PlumStartExitPb `protobuf:"bytes,24,opt,name=plumStartExit,proto3,oneof"`
+
+func (m *TimelockTransferPb) Reset()         { *m = TimelockTransferPb{} }
+func (m *TimelockTransferPb) String() string { return proto.CompactTextString(m) }
+func (*TimelockTransferPb) ProtoMessage()    {}
+func (*TimelockTransferPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{24}
 }
-type ActionPb_PlumChallengeExit struct {
-	PlumChallengeExit *PlumChallengeExit `protobuf:"bytes,25,opt,name=plumChallengeExit,proto3,oneof"`
+func (m *TimelockTransferPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TimelockTransferPb.Unmarshal(m, b)
 }
-type ActionPb_PlumResponseChallengeExit struct {
-	PlumResponseChallengeExit *PlumResponseChallengeExit `protobuf:"bytes,26,opt,name=plumResponseChallengeExit,proto3,oneof"`
+func (m *TimelockTransferPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TimelockTransferPb.Marshal(b, m, deterministic)
 }
-type ActionPb_PlumFinalizeExit struct {
-	PlumFinalizeExit *PlumFinalizeExit `protobuf:"bytes,27,opt,name=plumFinalizeExit,proto3,oneof"`
+func (dst *TimelockTransferPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimelockTransferPb.Merge(dst, src)
 }
-type ActionPb_PlumSettleDeposit struct {
-	PlumSettleDeposit *PlumSettleDepositPb `protobuf:"bytes,28,opt,name=plumSettleDeposit,proto3,oneof"`
+func (m *TimelockTransferPb) XXX_Size() int {
+	return xxx_messageInfo_TimelockTransferPb.Size(m)
 }
-type ActionPb_PlumTransfer struct {
-	PlumTransfer *PlumTransferPb `protobuf:"bytes,29,opt,name=plumTransfer,proto3,oneof"`
+func (m *TimelockTransferPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimelockTransferPb.DiscardUnknown(m)
 }
 
-func (*ActionPb_Transfer) isActionPb_Action()                  {}
-func (*ActionPb_Vote) isActionPb_Action()                      {}
-func (*ActionPb_Execution) isActionPb_Action()                 {}
-func (*ActionPb_SecretProposal) isActionPb_Action()            {}
-func (*ActionPb_SecretWitness) isActionPb_Action()             {}
-func (*ActionPb_StartSubChain) isActionPb_Action()             {}
-func (*ActionPb_StopSubChain) isActionPb_Action()              {}
-func (*ActionPb_PutBlock) isActionPb_Action()                  {}
-func (*ActionPb_CreateDeposit) isActionPb_Action()             {}
-func (*ActionPb_SettleDeposit) isActionPb_Action()             {}
-func (*ActionPb_CreatePlumChain) isActionPb_Action()           {}
-func (*ActionPb_TerminatePlumChain) isActionPb_Action()        {}
-func (*ActionPb_PlumPutBlock) isActionPb_Action()              {}
-func (*ActionPb_PlumCreateDeposit) isActionPb_Action()         {}
-func (*ActionPb_PlumStartExit) isActionPb_Action()             {}
-func (*ActionPb_PlumChallengeExit) isActionPb_Action()         {}
-func (*ActionPb_PlumResponseChallengeExit) isActionPb_Action() {}
-func (*ActionPb_PlumFinalizeExit) isActionPb_Action()          {}
-func (*ActionPb_PlumSettleDeposit) isActionPb_Action()         {}
-func (*ActionPb_PlumTransfer) isActionPb_Action()              {}
+var xxx_messageInfo_TimelockTransferPb proto.InternalMessageInfo
 
-func (m *ActionPb) GetAction() isActionPb_Action {
+func (m *TimelockTransferPb) GetAmount() []byte {
 	if m != nil {
-		return m.Action
+		return m.Amount
 	}
 	return nil
 }
 
-func (m *ActionPb) GetVersion() uint32 {
-	if m != nil {
-		return m.Version
-	}
-	return 0
-}
-
-func (m *ActionPb) GetSender() string {
+func (m *TimelockTransferPb) GetRecipient() string {
 	if m != nil {
-		return m.Sender
+		return m.Recipient
 	}
 	return ""
 }
 
-func (m *ActionPb) GetSenderPubKey() []byte {
-	if m != nil {
-		return m.SenderPubKey
-	}
-	return nil
-}
-
-func (m *ActionPb) GetNonce() uint64 {
+func (m *TimelockTransferPb) GetReleaseHeight() uint64 {
 	if m != nil {
-		return m.Nonce
+		return m.ReleaseHeight
 	}
 	return 0
 }
 
-func (m *ActionPb) GetGasLimit() uint64 {
+func (m *TimelockTransferPb) GetReleaseTime() int64 {
 	if m != nil {
-		return m.GasLimit
+		return m.ReleaseTime
 	}
 	return 0
 }
 
-func (m *ActionPb) GetGasPrice() []byte {
-	if m != nil {
-		return m.GasPrice
-	}
-	return nil
+// claims all the released time-locked transfers of the sender
+type ClaimTimelockPb struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ActionPb) GetSignature() []byte {
-	if m != nil {
+func (m *ClaimTimelockPb) Reset()         { *m = ClaimTimelockPb{} }
+func (m *ClaimTimelockPb) String() string { return proto.CompactTextString(m) }
+func (*ClaimTimelockPb) ProtoMessage()    {}
+func (*ClaimTimelockPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{25}
+}
+func (m *ClaimTimelockPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimTimelockPb.Unmarshal(m, b)
+}
+func (m *ClaimTimelockPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimTimelockPb.Marshal(b, m, deterministic)
+}
+func (dst *ClaimTimelockPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimTimelockPb.Merge(dst, src)
+}
+func (m *ClaimTimelockPb) XXX_Size() int {
+	return xxx_messageInfo_ClaimTimelockPb.Size(m)
+}
+func (m *ClaimTimelockPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimTimelockPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimTimelockPb proto.InternalMessageInfo
+
+// adjusts the gas limit and the size limit of the following blocks
+type SetBlockLimitsPb struct {
+	GasLimit             uint64   `protobuf:"varint,1,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	SizeLimit            uint64   `protobuf:"varint,2,opt,name=sizeLimit,proto3" json:"sizeLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBlockLimitsPb) Reset()         { *m = SetBlockLimitsPb{} }
+func (m *SetBlockLimitsPb) String() string { return proto.CompactTextString(m) }
+func (*SetBlockLimitsPb) ProtoMessage()    {}
+func (*SetBlockLimitsPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{26}
+}
+func (m *SetBlockLimitsPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBlockLimitsPb.Unmarshal(m, b)
+}
+func (m *SetBlockLimitsPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBlockLimitsPb.Marshal(b, m, deterministic)
+}
+func (dst *SetBlockLimitsPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBlockLimitsPb.Merge(dst, src)
+}
+func (m *SetBlockLimitsPb) XXX_Size() int {
+	return xxx_messageInfo_SetBlockLimitsPb.Size(m)
+}
+func (m *SetBlockLimitsPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBlockLimitsPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBlockLimitsPb proto.InternalMessageInfo
+
+func (m *SetBlockLimitsPb) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *SetBlockLimitsPb) GetSizeLimit() uint64 {
+	if m != nil {
+		return m.SizeLimit
+	}
+	return 0
+}
+
+// claims the amount from the unclaimed rewards of the sender
+type ClaimRewardPb struct {
+	Amount               []byte   `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimRewardPb) Reset()         { *m = ClaimRewardPb{} }
+func (m *ClaimRewardPb) String() string { return proto.CompactTextString(m) }
+func (*ClaimRewardPb) ProtoMessage()    {}
+func (*ClaimRewardPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{27}
+}
+func (m *ClaimRewardPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimRewardPb.Unmarshal(m, b)
+}
+func (m *ClaimRewardPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimRewardPb.Marshal(b, m, deterministic)
+}
+func (dst *ClaimRewardPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimRewardPb.Merge(dst, src)
+}
+func (m *ClaimRewardPb) XXX_Size() int {
+	return xxx_messageInfo_ClaimRewardPb.Size(m)
+}
+func (m *ClaimRewardPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimRewardPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimRewardPb proto.InternalMessageInfo
+
+func (m *ClaimRewardPb) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// stakes the amount of the sender for the candidate, locked for the duration in days
+type CreateStakePb struct {
+	Candidate            string   `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Amount               []byte   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Duration             uint32   `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateStakePb) Reset()         { *m = CreateStakePb{} }
+func (m *CreateStakePb) String() string { return proto.CompactTextString(m) }
+func (*CreateStakePb) ProtoMessage()    {}
+func (*CreateStakePb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{28}
+}
+func (m *CreateStakePb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateStakePb.Unmarshal(m, b)
+}
+func (m *CreateStakePb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateStakePb.Marshal(b, m, deterministic)
+}
+func (dst *CreateStakePb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateStakePb.Merge(dst, src)
+}
+func (m *CreateStakePb) XXX_Size() int {
+	return xxx_messageInfo_CreateStakePb.Size(m)
+}
+func (m *CreateStakePb) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateStakePb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateStakePb proto.InternalMessageInfo
+
+func (m *CreateStakePb) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *CreateStakePb) GetAmount() []byte {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *CreateStakePb) GetDuration() uint32 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// starts unstaking the bucket of the sender after its lock duration
+type UnstakePb struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnstakePb) Reset()         { *m = UnstakePb{} }
+func (m *UnstakePb) String() string { return proto.CompactTextString(m) }
+func (*UnstakePb) ProtoMessage()    {}
+func (*UnstakePb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{29}
+}
+func (m *UnstakePb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnstakePb.Unmarshal(m, b)
+}
+func (m *UnstakePb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnstakePb.Marshal(b, m, deterministic)
+}
+func (dst *UnstakePb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnstakePb.Merge(dst, src)
+}
+func (m *UnstakePb) XXX_Size() int {
+	return xxx_messageInfo_UnstakePb.Size(m)
+}
+func (m *UnstakePb) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnstakePb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnstakePb proto.InternalMessageInfo
+
+func (m *UnstakePb) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// withdraws the amount of the unstaked bucket of the sender after the withdrawal waiting period
+type WithdrawPb struct {
+	Index                uint64   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawPb) Reset()         { *m = WithdrawPb{} }
+func (m *WithdrawPb) String() string { return proto.CompactTextString(m) }
+func (*WithdrawPb) ProtoMessage()    {}
+func (*WithdrawPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{30}
+}
+func (m *WithdrawPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawPb.Unmarshal(m, b)
+}
+func (m *WithdrawPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawPb.Marshal(b, m, deterministic)
+}
+func (dst *WithdrawPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawPb.Merge(dst, src)
+}
+func (m *WithdrawPb) XXX_Size() int {
+	return xxx_messageInfo_WithdrawPb.Size(m)
+}
+func (m *WithdrawPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawPb proto.InternalMessageInfo
+
+func (m *WithdrawPb) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// registers the sender as a candidate with the metadata, locking the self-stake from its balance
+type RegisterCandidatePb struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OperatorAddress      string   `protobuf:"bytes,2,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	RewardAddress        string   `protobuf:"bytes,3,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,4,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	SelfStake            []byte   `protobuf:"bytes,5,opt,name=selfStake,proto3" json:"selfStake,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterCandidatePb) Reset()         { *m = RegisterCandidatePb{} }
+func (m *RegisterCandidatePb) String() string { return proto.CompactTextString(m) }
+func (*RegisterCandidatePb) ProtoMessage()    {}
+func (*RegisterCandidatePb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{31}
+}
+func (m *RegisterCandidatePb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterCandidatePb.Unmarshal(m, b)
+}
+func (m *RegisterCandidatePb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterCandidatePb.Marshal(b, m, deterministic)
+}
+func (dst *RegisterCandidatePb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCandidatePb.Merge(dst, src)
+}
+func (m *RegisterCandidatePb) XXX_Size() int {
+	return xxx_messageInfo_RegisterCandidatePb.Size(m)
+}
+func (m *RegisterCandidatePb) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCandidatePb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterCandidatePb proto.InternalMessageInfo
+
+func (m *RegisterCandidatePb) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RegisterCandidatePb) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *RegisterCandidatePb) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *RegisterCandidatePb) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *RegisterCandidatePb) GetSelfStake() []byte {
+	if m != nil {
+		return m.SelfStake
+	}
+	return nil
+}
+
+// updates the metadata of the candidate registered by the sender
+type UpdateCandidatePb struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	OperatorAddress      string   `protobuf:"bytes,2,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	RewardAddress        string   `protobuf:"bytes,3,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	PubKey               []byte   `protobuf:"bytes,4,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCandidatePb) Reset()         { *m = UpdateCandidatePb{} }
+func (m *UpdateCandidatePb) String() string { return proto.CompactTextString(m) }
+func (*UpdateCandidatePb) ProtoMessage()    {}
+func (*UpdateCandidatePb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{32}
+}
+func (m *UpdateCandidatePb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCandidatePb.Unmarshal(m, b)
+}
+func (m *UpdateCandidatePb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCandidatePb.Marshal(b, m, deterministic)
+}
+func (dst *UpdateCandidatePb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCandidatePb.Merge(dst, src)
+}
+func (m *UpdateCandidatePb) XXX_Size() int {
+	return xxx_messageInfo_UpdateCandidatePb.Size(m)
+}
+func (m *UpdateCandidatePb) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCandidatePb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCandidatePb proto.InternalMessageInfo
+
+func (m *UpdateCandidatePb) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateCandidatePb) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *UpdateCandidatePb) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *UpdateCandidatePb) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// unregisters the candidate registered by the sender, and unlocks its self-stake
+type UnregisterCandidatePb struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnregisterCandidatePb) Reset()         { *m = UnregisterCandidatePb{} }
+func (m *UnregisterCandidatePb) String() string { return proto.CompactTextString(m) }
+func (*UnregisterCandidatePb) ProtoMessage()    {}
+func (*UnregisterCandidatePb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{33}
+}
+func (m *UnregisterCandidatePb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnregisterCandidatePb.Unmarshal(m, b)
+}
+func (m *UnregisterCandidatePb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnregisterCandidatePb.Marshal(b, m, deterministic)
+}
+func (dst *UnregisterCandidatePb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterCandidatePb.Merge(dst, src)
+}
+func (m *UnregisterCandidatePb) XXX_Size() int {
+	return xxx_messageInfo_UnregisterCandidatePb.Size(m)
+}
+func (m *UnregisterCandidatePb) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterCandidatePb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnregisterCandidatePb proto.InternalMessageInfo
+
+// submits a proposal to change the chain parameter to the value, which is voted on by the candidates
+type SubmitProposalPb struct {
+	Parameter            string   `protobuf:"bytes,1,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Value                uint64   `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitProposalPb) Reset()         { *m = SubmitProposalPb{} }
+func (m *SubmitProposalPb) String() string { return proto.CompactTextString(m) }
+func (*SubmitProposalPb) ProtoMessage()    {}
+func (*SubmitProposalPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{34}
+}
+func (m *SubmitProposalPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitProposalPb.Unmarshal(m, b)
+}
+func (m *SubmitProposalPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitProposalPb.Marshal(b, m, deterministic)
+}
+func (dst *SubmitProposalPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitProposalPb.Merge(dst, src)
+}
+func (m *SubmitProposalPb) XXX_Size() int {
+	return xxx_messageInfo_SubmitProposalPb.Size(m)
+}
+func (m *SubmitProposalPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitProposalPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitProposalPb proto.InternalMessageInfo
+
+func (m *SubmitProposalPb) GetParameter() string {
+	if m != nil {
+		return m.Parameter
+	}
+	return ""
+}
+
+func (m *SubmitProposalPb) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+// approves or rejects the proposal with the id
+type VoteProposalPb struct {
+	Id                   uint64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve              bool     `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VoteProposalPb) Reset()         { *m = VoteProposalPb{} }
+func (m *VoteProposalPb) String() string { return proto.CompactTextString(m) }
+func (*VoteProposalPb) ProtoMessage()    {}
+func (*VoteProposalPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{35}
+}
+func (m *VoteProposalPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VoteProposalPb.Unmarshal(m, b)
+}
+func (m *VoteProposalPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VoteProposalPb.Marshal(b, m, deterministic)
+}
+func (dst *VoteProposalPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteProposalPb.Merge(dst, src)
+}
+func (m *VoteProposalPb) XXX_Size() int {
+	return xxx_messageInfo_VoteProposalPb.Size(m)
+}
+func (m *VoteProposalPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteProposalPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoteProposalPb proto.InternalMessageInfo
+
+func (m *VoteProposalPb) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *VoteProposalPb) GetApprove() bool {
+	if m != nil {
+		return m.Approve
+	}
+	return false
+}
+
+type ActionPb struct {
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// TODO: we should remove sender address later
+	Sender       string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	SenderPubKey []byte `protobuf:"bytes,3,opt,name=senderPubKey,proto3" json:"senderPubKey,omitempty"`
+	Nonce        uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	GasLimit     uint64 `protobuf:"varint,5,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	GasPrice     []byte `protobuf:"bytes,6,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	Signature    []byte `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Types that are valid to be assigned to Action:
+	//	*ActionPb_Transfer
+	//	*ActionPb_Vote
+	//	*ActionPb_Execution
+	//	*ActionPb_SecretProposal
+	//	*ActionPb_SecretWitness
+	//	*ActionPb_StartSubChain
+	//	*ActionPb_StopSubChain
+	//	*ActionPb_PutBlock
+	//	*ActionPb_CreateDeposit
+	//	*ActionPb_SettleDeposit
+	//	*ActionPb_CreatePlumChain
+	//	*ActionPb_TerminatePlumChain
+	//	*ActionPb_PlumPutBlock
+	//	*ActionPb_PlumCreateDeposit
+	//	*ActionPb_PlumStartExit
+	//	*ActionPb_PlumChallengeExit
+	//	*ActionPb_PlumResponseChallengeExit
+	//	*ActionPb_PlumFinalizeExit
+	//	*ActionPb_PlumSettleDeposit
+	//	*ActionPb_PlumTransfer
+	//	*ActionPb_Generic
+	//	*ActionPb_Batch
+	//	*ActionPb_TimelockTransfer
+	//	*ActionPb_ClaimTimelock
+	//	*ActionPb_SetBlockLimits
+	//	*ActionPb_ClaimReward
+	//	*ActionPb_CreateStake
+	//	*ActionPb_Unstake
+	//	*ActionPb_Withdraw
+	//	*ActionPb_RegisterCandidate
+	//	*ActionPb_UpdateCandidate
+	//	*ActionPb_UnregisterCandidate
+	//	*ActionPb_SubmitProposal
+	//	*ActionPb_VoteProposal
+	Action               isActionPb_Action `protobuf_oneof:"action"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ActionPb) Reset()         { *m = ActionPb{} }
+func (m *ActionPb) String() string { return proto.CompactTextString(m) }
+func (*ActionPb) ProtoMessage()    {}
+func (*ActionPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_action_4d44dc477bd91efd, []int{36}
+}
+func (m *ActionPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActionPb.Unmarshal(m, b)
+}
+func (m *ActionPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActionPb.Marshal(b, m, deterministic)
+}
+func (dst *ActionPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionPb.Merge(dst, src)
+}
+func (m *ActionPb) XXX_Size() int {
+	return xxx_messageInfo_ActionPb.Size(m)
+}
+func (m *ActionPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionPb proto.InternalMessageInfo
+
+type isActionPb_Action interface {
+	isActionPb_Action()
+}
+
+type ActionPb_Transfer struct {
+	Transfer *TransferPb `protobuf:"bytes,10,opt,name=transfer,proto3,oneof"`
+}
+type ActionPb_Vote struct {
+	Vote *VotePb `protobuf:"bytes,11,opt,name=vote,proto3,oneof"`
+}
+type ActionPb_Execution struct {
+	Execution *ExecutionPb `protobuf:"bytes,12,opt,name=execution,proto3,oneof"`
+}
+type ActionPb_SecretProposal struct {
+	SecretProposal *SecretProposalPb `protobuf:"bytes,13,opt,name=secretProposal,proto3,oneof"`
+}
+type ActionPb_SecretWitness struct {
+	SecretWitness *SecretWitnessPb `protobuf:"bytes,14,opt,name=secretWitness,proto3,oneof"`
+}
+type ActionPb_StartSubChain struct {
+	StartSubChain *StartSubChainPb `protobuf:"bytes,15,opt,name=startSubChain,proto3,oneof"`
+}
+type ActionPb_StopSubChain struct {
+	StopSubChain *StopSubChainPb `protobuf:"bytes,16,opt,name=stopSubChain,proto3,oneof"`
+}
+type ActionPb_PutBlock struct {
+	PutBlock *PutBlockPb `protobuf:"bytes,17,opt,name=putBlock,proto3,oneof"`
+}
+type ActionPb_CreateDeposit struct {
+	CreateDeposit *CreateDepositPb `protobuf:"bytes,18,opt,name=createDeposit,proto3,oneof"`
+}
+type ActionPb_SettleDeposit struct {
+	SettleDeposit *SettleDepositPb `protobuf:"bytes,19,opt,name=settleDeposit,proto3,oneof"`
+}
+type ActionPb_CreatePlumChain struct {
+	CreatePlumChain *CreatePlumChainPb `protobuf:"bytes,20,opt,name=createPlumChain,proto3,oneof"`
+}
+type ActionPb_TerminatePlumChain struct {
+	TerminatePlumChain *TerminatePlumChainPb `protobuf:"bytes,21,opt,name=terminatePlumChain,proto3,oneof"`
+}
+type ActionPb_PlumPutBlock struct {
+	PlumPutBlock *PlumPutBlockPb `protobuf:"bytes,22,opt,name=plumPutBlock,proto3,oneof"`
+}
+type ActionPb_PlumCreateDeposit struct {
+	PlumCreateDeposit *PlumCreateDepositPb `protobuf:"bytes,23,opt,name=plumCreateDeposit,proto3,oneof"`
+}
+type ActionPb_PlumStartExit struct {
+	PlumStartExit *PlumStartExitPb `protobuf:"bytes,24,opt,name=plumStartExit,proto3,oneof"`
+}
+type ActionPb_PlumChallengeExit struct {
+	PlumChallengeExit *PlumChallengeExit `protobuf:"bytes,25,opt,name=plumChallengeExit,proto3,oneof"`
+}
+type ActionPb_PlumResponseChallengeExit struct {
+	PlumResponseChallengeExit *PlumResponseChallengeExit `protobuf:"bytes,26,opt,name=plumResponseChallengeExit,proto3,oneof"`
+}
+type ActionPb_PlumFinalizeExit struct {
+	PlumFinalizeExit *PlumFinalizeExit `protobuf:"bytes,27,opt,name=plumFinalizeExit,proto3,oneof"`
+}
+type ActionPb_PlumSettleDeposit struct {
+	PlumSettleDeposit *PlumSettleDepositPb `protobuf:"bytes,28,opt,name=plumSettleDeposit,proto3,oneof"`
+}
+type ActionPb_PlumTransfer struct {
+	PlumTransfer *PlumTransferPb `protobuf:"bytes,29,opt,name=plumTransfer,proto3,oneof"`
+}
+type ActionPb_Generic struct {
+	Generic *GenericActionPb `protobuf:"bytes,30,opt,name=generic,proto3,oneof"`
+}
+type ActionPb_Batch struct {
+	Batch *BatchPb `protobuf:"bytes,31,opt,name=batch,proto3,oneof"`
+}
+type ActionPb_TimelockTransfer struct {
+	TimelockTransfer *TimelockTransferPb `protobuf:"bytes,32,opt,name=timelockTransfer,proto3,oneof"`
+}
+type ActionPb_ClaimTimelock struct {
+	ClaimTimelock *ClaimTimelockPb `protobuf:"bytes,33,opt,name=claimTimelock,proto3,oneof"`
+}
+type ActionPb_SetBlockLimits struct {
+	SetBlockLimits *SetBlockLimitsPb `protobuf:"bytes,34,opt,name=setBlockLimits,proto3,oneof"`
+}
+type ActionPb_ClaimReward struct {
+	ClaimReward *ClaimRewardPb `protobuf:"bytes,35,opt,name=claimReward,proto3,oneof"`
+}
+type ActionPb_CreateStake struct {
+	CreateStake *CreateStakePb `protobuf:"bytes,36,opt,name=createStake,proto3,oneof"`
+}
+type ActionPb_Unstake struct {
+	Unstake *UnstakePb `protobuf:"bytes,37,opt,name=unstake,proto3,oneof"`
+}
+type ActionPb_Withdraw struct {
+	Withdraw *WithdrawPb `protobuf:"bytes,38,opt,name=withdraw,proto3,oneof"`
+}
+type ActionPb_RegisterCandidate struct {
+	RegisterCandidate *RegisterCandidatePb `protobuf:"bytes,39,opt,name=registerCandidate,proto3,oneof"`
+}
+type ActionPb_UpdateCandidate struct {
+	UpdateCandidate *UpdateCandidatePb `protobuf:"bytes,40,opt,name=updateCandidate,proto3,oneof"`
+}
+type ActionPb_UnregisterCandidate struct {
+	UnregisterCandidate *UnregisterCandidatePb `protobuf:"bytes,41,opt,name=unregisterCandidate,proto3,oneof"`
+}
+type ActionPb_SubmitProposal struct {
+	SubmitProposal *SubmitProposalPb `protobuf:"bytes,42,opt,name=submitProposal,proto3,oneof"`
+}
+type ActionPb_VoteProposal struct {
+	VoteProposal *VoteProposalPb `protobuf:"bytes,43,opt,name=voteProposal,proto3,oneof"`
+}
+
+func (*ActionPb_Transfer) isActionPb_Action()                  {}
+func (*ActionPb_Vote) isActionPb_Action()                      {}
+func (*ActionPb_Execution) isActionPb_Action()                 {}
+func (*ActionPb_SecretProposal) isActionPb_Action()            {}
+func (*ActionPb_SecretWitness) isActionPb_Action()             {}
+func (*ActionPb_StartSubChain) isActionPb_Action()             {}
+func (*ActionPb_StopSubChain) isActionPb_Action()              {}
+func (*ActionPb_PutBlock) isActionPb_Action()                  {}
+func (*ActionPb_CreateDeposit) isActionPb_Action()             {}
+func (*ActionPb_SettleDeposit) isActionPb_Action()             {}
+func (*ActionPb_CreatePlumChain) isActionPb_Action()           {}
+func (*ActionPb_TerminatePlumChain) isActionPb_Action()        {}
+func (*ActionPb_PlumPutBlock) isActionPb_Action()              {}
+func (*ActionPb_PlumCreateDeposit) isActionPb_Action()         {}
+func (*ActionPb_PlumStartExit) isActionPb_Action()             {}
+func (*ActionPb_PlumChallengeExit) isActionPb_Action()         {}
+func (*ActionPb_PlumResponseChallengeExit) isActionPb_Action() {}
+func (*ActionPb_PlumFinalizeExit) isActionPb_Action()          {}
+func (*ActionPb_PlumSettleDeposit) isActionPb_Action()         {}
+func (*ActionPb_PlumTransfer) isActionPb_Action()              {}
+func (*ActionPb_Generic) isActionPb_Action()                   {}
+func (*ActionPb_Batch) isActionPb_Action()                     {}
+func (*ActionPb_TimelockTransfer) isActionPb_Action()          {}
+func (*ActionPb_ClaimTimelock) isActionPb_Action()             {}
+func (*ActionPb_SetBlockLimits) isActionPb_Action()            {}
+func (*ActionPb_ClaimReward) isActionPb_Action()               {}
+func (*ActionPb_CreateStake) isActionPb_Action()               {}
+func (*ActionPb_Unstake) isActionPb_Action()                   {}
+func (*ActionPb_Withdraw) isActionPb_Action()                  {}
+func (*ActionPb_RegisterCandidate) isActionPb_Action()         {}
+func (*ActionPb_UpdateCandidate) isActionPb_Action()           {}
+func (*ActionPb_UnregisterCandidate) isActionPb_Action()       {}
+func (*ActionPb_SubmitProposal) isActionPb_Action()            {}
+func (*ActionPb_VoteProposal) isActionPb_Action()              {}
+
+func (m *ActionPb) GetAction() isActionPb_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *ActionPb) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ActionPb) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *ActionPb) GetSenderPubKey() []byte {
+	if m != nil {
+		return m.SenderPubKey
+	}
+	return nil
+}
+
+func (m *ActionPb) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *ActionPb) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *ActionPb) GetGasPrice() []byte {
+	if m != nil {
+		return m.GasPrice
+	}
+	return nil
+}
+
+func (m *ActionPb) GetSignature() []byte {
+	if m != nil {
 		return m.Signature
 	}
 	return nil
@@ -1491,6 +2308,104 @@ func (m *ActionPb) GetPlumTransfer() *PlumTransferPb {
 	return nil
 }
 
+func (m *ActionPb) GetGeneric() *GenericActionPb {
+	if x, ok := m.GetAction().(*ActionPb_Generic); ok {
+		return x.Generic
+	}
+	return nil
+}
+
+func (m *ActionPb) GetBatch() *BatchPb {
+	if x, ok := m.GetAction().(*ActionPb_Batch); ok {
+		return x.Batch
+	}
+	return nil
+}
+
+func (m *ActionPb) GetTimelockTransfer() *TimelockTransferPb {
+	if x, ok := m.GetAction().(*ActionPb_TimelockTransfer); ok {
+		return x.TimelockTransfer
+	}
+	return nil
+}
+
+func (m *ActionPb) GetClaimTimelock() *ClaimTimelockPb {
+	if x, ok := m.GetAction().(*ActionPb_ClaimTimelock); ok {
+		return x.ClaimTimelock
+	}
+	return nil
+}
+
+func (m *ActionPb) GetSetBlockLimits() *SetBlockLimitsPb {
+	if x, ok := m.GetAction().(*ActionPb_SetBlockLimits); ok {
+		return x.SetBlockLimits
+	}
+	return nil
+}
+
+func (m *ActionPb) GetClaimReward() *ClaimRewardPb {
+	if x, ok := m.GetAction().(*ActionPb_ClaimReward); ok {
+		return x.ClaimReward
+	}
+	return nil
+}
+
+func (m *ActionPb) GetCreateStake() *CreateStakePb {
+	if x, ok := m.GetAction().(*ActionPb_CreateStake); ok {
+		return x.CreateStake
+	}
+	return nil
+}
+
+func (m *ActionPb) GetUnstake() *UnstakePb {
+	if x, ok := m.GetAction().(*ActionPb_Unstake); ok {
+		return x.Unstake
+	}
+	return nil
+}
+
+func (m *ActionPb) GetWithdraw() *WithdrawPb {
+	if x, ok := m.GetAction().(*ActionPb_Withdraw); ok {
+		return x.Withdraw
+	}
+	return nil
+}
+
+func (m *ActionPb) GetRegisterCandidate() *RegisterCandidatePb {
+	if x, ok := m.GetAction().(*ActionPb_RegisterCandidate); ok {
+		return x.RegisterCandidate
+	}
+	return nil
+}
+
+func (m *ActionPb) GetUpdateCandidate() *UpdateCandidatePb {
+	if x, ok := m.GetAction().(*ActionPb_UpdateCandidate); ok {
+		return x.UpdateCandidate
+	}
+	return nil
+}
+
+func (m *ActionPb) GetUnregisterCandidate() *UnregisterCandidatePb {
+	if x, ok := m.GetAction().(*ActionPb_UnregisterCandidate); ok {
+		return x.UnregisterCandidate
+	}
+	return nil
+}
+
+func (m *ActionPb) GetSubmitProposal() *SubmitProposalPb {
+	if x, ok := m.GetAction().(*ActionPb_SubmitProposal); ok {
+		return x.SubmitProposal
+	}
+	return nil
+}
+
+func (m *ActionPb) GetVoteProposal() *VoteProposalPb {
+	if x, ok := m.GetAction().(*ActionPb_VoteProposal); ok {
+		return x.VoteProposal
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ActionPb) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ActionPb_OneofMarshaler, _ActionPb_OneofUnmarshaler, _ActionPb_OneofSizer, []interface{}{
@@ -1514,6 +2429,20 @@ func (*ActionPb) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*ActionPb_PlumFinalizeExit)(nil),
 		(*ActionPb_PlumSettleDeposit)(nil),
 		(*ActionPb_PlumTransfer)(nil),
+		(*ActionPb_Generic)(nil),
+		(*ActionPb_Batch)(nil),
+		(*ActionPb_TimelockTransfer)(nil),
+		(*ActionPb_ClaimTimelock)(nil),
+		(*ActionPb_SetBlockLimits)(nil),
+		(*ActionPb_ClaimReward)(nil),
+		(*ActionPb_CreateStake)(nil),
+		(*ActionPb_Unstake)(nil),
+		(*ActionPb_Withdraw)(nil),
+		(*ActionPb_RegisterCandidate)(nil),
+		(*ActionPb_UpdateCandidate)(nil),
+		(*ActionPb_UnregisterCandidate)(nil),
+		(*ActionPb_SubmitProposal)(nil),
+		(*ActionPb_VoteProposal)(nil),
 	}
 }
 
@@ -1621,6 +2550,76 @@ func _ActionPb_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.PlumTransfer); err != nil {
 			return err
 		}
+	case *ActionPb_Generic:
+		b.EncodeVarint(30<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Generic); err != nil {
+			return err
+		}
+	case *ActionPb_Batch:
+		b.EncodeVarint(31<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Batch); err != nil {
+			return err
+		}
+	case *ActionPb_TimelockTransfer:
+		b.EncodeVarint(32<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.TimelockTransfer); err != nil {
+			return err
+		}
+	case *ActionPb_ClaimTimelock:
+		b.EncodeVarint(33<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClaimTimelock); err != nil {
+			return err
+		}
+	case *ActionPb_SetBlockLimits:
+		b.EncodeVarint(34<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SetBlockLimits); err != nil {
+			return err
+		}
+	case *ActionPb_ClaimReward:
+		b.EncodeVarint(35<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClaimReward); err != nil {
+			return err
+		}
+	case *ActionPb_CreateStake:
+		b.EncodeVarint(36<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CreateStake); err != nil {
+			return err
+		}
+	case *ActionPb_Unstake:
+		b.EncodeVarint(37<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Unstake); err != nil {
+			return err
+		}
+	case *ActionPb_Withdraw:
+		b.EncodeVarint(38<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Withdraw); err != nil {
+			return err
+		}
+	case *ActionPb_RegisterCandidate:
+		b.EncodeVarint(39<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RegisterCandidate); err != nil {
+			return err
+		}
+	case *ActionPb_UpdateCandidate:
+		b.EncodeVarint(40<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UpdateCandidate); err != nil {
+			return err
+		}
+	case *ActionPb_UnregisterCandidate:
+		b.EncodeVarint(41<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.UnregisterCandidate); err != nil {
+			return err
+		}
+	case *ActionPb_SubmitProposal:
+		b.EncodeVarint(42<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SubmitProposal); err != nil {
+			return err
+		}
+	case *ActionPb_VoteProposal:
+		b.EncodeVarint(43<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.VoteProposal); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ActionPb.Action has unexpected type %T", x)
@@ -1791,6 +2790,118 @@ func _ActionPb_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Action = &ActionPb_PlumTransfer{msg}
 		return true, err
+	case 30: // action.generic
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(GenericActionPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_Generic{msg}
+		return true, err
+	case 31: // action.batch
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(BatchPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_Batch{msg}
+		return true, err
+	case 32: // action.timelockTransfer
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(TimelockTransferPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_TimelockTransfer{msg}
+		return true, err
+	case 33: // action.claimTimelock
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClaimTimelockPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_ClaimTimelock{msg}
+		return true, err
+	case 34: // action.setBlockLimits
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SetBlockLimitsPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_SetBlockLimits{msg}
+		return true, err
+	case 35: // action.claimReward
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ClaimRewardPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_ClaimReward{msg}
+		return true, err
+	case 36: // action.createStake
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(CreateStakePb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_CreateStake{msg}
+		return true, err
+	case 37: // action.unstake
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnstakePb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_Unstake{msg}
+		return true, err
+	case 38: // action.withdraw
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(WithdrawPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_Withdraw{msg}
+		return true, err
+	case 39: // action.registerCandidate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(RegisterCandidatePb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_RegisterCandidate{msg}
+		return true, err
+	case 40: // action.updateCandidate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UpdateCandidatePb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_UpdateCandidate{msg}
+		return true, err
+	case 41: // action.unregisterCandidate
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(UnregisterCandidatePb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_UnregisterCandidate{msg}
+		return true, err
+	case 42: // action.submitProposal
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(SubmitProposalPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_SubmitProposal{msg}
+		return true, err
+	case 43: // action.voteProposal
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(VoteProposalPb)
+		err := b.DecodeMessage(msg)
+		m.Action = &ActionPb_VoteProposal{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -1900,6 +3011,76 @@ func _ActionPb_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ActionPb_Generic:
+		s := proto.Size(x.Generic)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_Batch:
+		s := proto.Size(x.Batch)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_TimelockTransfer:
+		s := proto.Size(x.TimelockTransfer)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_ClaimTimelock:
+		s := proto.Size(x.ClaimTimelock)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_SetBlockLimits:
+		s := proto.Size(x.SetBlockLimits)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_ClaimReward:
+		s := proto.Size(x.ClaimReward)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_CreateStake:
+		s := proto.Size(x.CreateStake)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_Unstake:
+		s := proto.Size(x.Unstake)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_Withdraw:
+		s := proto.Size(x.Withdraw)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_RegisterCandidate:
+		s := proto.Size(x.RegisterCandidate)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_UpdateCandidate:
+		s := proto.Size(x.UpdateCandidate)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_UnregisterCandidate:
+		s := proto.Size(x.UnregisterCandidate)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_SubmitProposal:
+		s := proto.Size(x.SubmitProposal)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ActionPb_VoteProposal:
+		s := proto.Size(x.VoteProposal)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1923,7 +3104,7 @@ func (m *ReceiptPb) Reset()         { *m = ReceiptPb{} }
 func (m *ReceiptPb) String() string { return proto.CompactTextString(m) }
 func (*ReceiptPb) ProtoMessage()    {}
 func (*ReceiptPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{22}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{37}
 }
 func (m *ReceiptPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ReceiptPb.Unmarshal(m, b)
@@ -2002,7 +3183,7 @@ func (m *LogPb) Reset()         { *m = LogPb{} }
 func (m *LogPb) String() string { return proto.CompactTextString(m) }
 func (*LogPb) ProtoMessage()    {}
 func (*LogPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_action_4d44dc477bd91efd, []int{23}
+	return fileDescriptor_action_4d44dc477bd91efd, []int{38}
 }
 func (m *LogPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogPb.Unmarshal(m, b)
@@ -2073,6 +3254,7 @@ func (m *LogPb) GetIndex() uint32 {
 
 func init() {
 	proto.RegisterType((*TransferPb)(nil), "iproto.TransferPb")
+	proto.RegisterType((*MemoPb)(nil), "iproto.MemoPb")
 	proto.RegisterType((*VotePb)(nil), "iproto.VotePb")
 	proto.RegisterType((*ExecutionPb)(nil), "iproto.ExecutionPb")
 	proto.RegisterType((*SecretProposalPb)(nil), "iproto.SecretProposalPb")
@@ -2094,107 +3276,163 @@ func init() {
 	proto.RegisterType((*PlumFinalizeExit)(nil), "iproto.PlumFinalizeExit")
 	proto.RegisterType((*PlumSettleDepositPb)(nil), "iproto.PlumSettleDepositPb")
 	proto.RegisterType((*PlumTransferPb)(nil), "iproto.PlumTransferPb")
+	proto.RegisterType((*GenericActionPb)(nil), "iproto.GenericActionPb")
+	proto.RegisterType((*BatchPb)(nil), "iproto.BatchPb")
+	proto.RegisterType((*TimelockTransferPb)(nil), "iproto.TimelockTransferPb")
+	proto.RegisterType((*ClaimTimelockPb)(nil), "iproto.ClaimTimelockPb")
+	proto.RegisterType((*SetBlockLimitsPb)(nil), "iproto.SetBlockLimitsPb")
+	proto.RegisterType((*ClaimRewardPb)(nil), "iproto.ClaimRewardPb")
+	proto.RegisterType((*CreateStakePb)(nil), "iproto.CreateStakePb")
+	proto.RegisterType((*UnstakePb)(nil), "iproto.UnstakePb")
+	proto.RegisterType((*WithdrawPb)(nil), "iproto.WithdrawPb")
+	proto.RegisterType((*RegisterCandidatePb)(nil), "iproto.RegisterCandidatePb")
+	proto.RegisterType((*UpdateCandidatePb)(nil), "iproto.UpdateCandidatePb")
+	proto.RegisterType((*UnregisterCandidatePb)(nil), "iproto.UnregisterCandidatePb")
+	proto.RegisterType((*SubmitProposalPb)(nil), "iproto.SubmitProposalPb")
+	proto.RegisterType((*VoteProposalPb)(nil), "iproto.VoteProposalPb")
 	proto.RegisterType((*ActionPb)(nil), "iproto.ActionPb")
 	proto.RegisterType((*ReceiptPb)(nil), "iproto.ReceiptPb")
 	proto.RegisterType((*LogPb)(nil), "iproto.LogPb")
+	proto.RegisterEnum("iproto.MemoPb_MemoType", MemoPb_MemoType_name, MemoPb_MemoType_value)
 }
 
 func init() { proto.RegisterFile("action.proto", fileDescriptor_action_4d44dc477bd91efd) }
 
 var fileDescriptor_action_4d44dc477bd91efd = []byte{
-	// 1504 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x6f, 0xdb, 0xc6,
-	0x12, 0xd7, 0x3f, 0xcb, 0xd6, 0x48, 0xb6, 0xe4, 0x75, 0x9e, 0xb3, 0x76, 0xfc, 0xf2, 0x14, 0xe2,
-	0x1d, 0x84, 0xbc, 0x57, 0xa5, 0x48, 0x80, 0xd4, 0x28, 0x8a, 0x36, 0xb1, 0xe3, 0x54, 0x41, 0xd2,
-	0x54, 0x58, 0xbb, 0xe9, 0xa9, 0x07, 0x8a, 0x5a, 0xcb, 0x44, 0x24, 0x2e, 0xc1, 0x5d, 0x3a, 0x76,
-	0xd1, 0x43, 0xaf, 0xfd, 0x48, 0x3d, 0xf4, 0x58, 0xa0, 0xf7, 0x7e, 0xa0, 0x16, 0xfb, 0x4f, 0xe2,
-	0x92, 0xb2, 0x93, 0xb4, 0x01, 0x7a, 0x12, 0x67, 0x76, 0x76, 0x76, 0xf6, 0x37, 0xc3, 0x99, 0x1f,
-	0x05, 0x2d, 0x3f, 0x10, 0x21, 0x8b, 0xfa, 0x71, 0xc2, 0x04, 0x43, 0xf5, 0x50, 0xfd, 0xee, 0xfe,
-	0x67, 0xc2, 0xd8, 0x64, 0x4a, 0xef, 0x29, 0x69, 0x94, 0x9e, 0xde, 0x13, 0xe1, 0x8c, 0x72, 0xe1,
-	0xcf, 0x62, 0x6d, 0xe8, 0xfd, 0x00, 0x70, 0x92, 0xf8, 0x11, 0x3f, 0xa5, 0xc9, 0x70, 0x84, 0xb6,
-	0xa1, 0xee, 0xcf, 0x58, 0x1a, 0x09, 0x5c, 0xee, 0x96, 0x7b, 0x2d, 0x62, 0x24, 0xb4, 0x07, 0x8d,
-	0x84, 0x06, 0x61, 0x1c, 0xd2, 0x48, 0xe0, 0x4a, 0xb7, 0xdc, 0x6b, 0x90, 0x85, 0x02, 0x61, 0x58,
-	0x8d, 0xfd, 0xcb, 0x29, 0xf3, 0xc7, 0xb8, 0xaa, 0xb6, 0x59, 0x11, 0xdd, 0x06, 0x08, 0xf9, 0x21,
-	0x0b, 0xa3, 0x91, 0xcf, 0x29, 0xae, 0x75, 0xcb, 0xbd, 0x35, 0x92, 0xd1, 0x78, 0xa7, 0x50, 0x7f,
-	0xc5, 0x04, 0x1d, 0x8e, 0xd0, 0x3e, 0x34, 0xe6, 0xa1, 0xa9, 0xc3, 0x9b, 0xf7, 0x77, 0xfb, 0x3a,
-	0xf8, 0xbe, 0x0d, 0xbe, 0x7f, 0x62, 0x2d, 0xc8, 0xc2, 0x18, 0x79, 0xd0, 0x3a, 0x67, 0x82, 0xd2,
-	0xc7, 0xe3, 0x71, 0x42, 0x39, 0x37, 0xe1, 0x39, 0x3a, 0xef, 0x1b, 0x68, 0x1e, 0x5d, 0xd0, 0x20,
-	0x95, 0x08, 0x5d, 0x73, 0xcd, 0x5d, 0x58, 0x0b, 0x58, 0x24, 0x12, 0x3f, 0xb0, 0xb7, 0x9c, 0xcb,
-	0x08, 0x41, 0x6d, 0xec, 0x0b, 0xdf, 0xdc, 0x50, 0x3d, 0x7b, 0x03, 0xe8, 0x1c, 0xd3, 0x20, 0xa1,
-	0x62, 0x98, 0xb0, 0x98, 0x71, 0x7f, 0x3a, 0x1c, 0xb9, 0x50, 0x95, 0xf3, 0x50, 0x6d, 0x43, 0x9d,
-	0xab, 0x1d, 0xb8, 0xd2, 0xad, 0xf6, 0xd6, 0x89, 0x91, 0xbc, 0xff, 0x41, 0x5b, 0x7b, 0xfa, 0x36,
-	0x14, 0x11, 0xe5, 0x7c, 0x38, 0x92, 0xa8, 0xbe, 0xd1, 0x02, 0x2e, 0x77, 0xab, 0x12, 0x55, 0x23,
-	0x7a, 0xbf, 0x97, 0xa1, 0x7d, 0x2c, 0xfc, 0x44, 0x1c, 0xa7, 0xa3, 0xc3, 0x33, 0x3f, 0x8c, 0xb4,
-	0x75, 0x20, 0x1f, 0x9f, 0x3d, 0x51, 0x87, 0xae, 0x13, 0x2b, 0xa2, 0x1e, 0xb4, 0x39, 0x0d, 0xd2,
-	0x24, 0x14, 0x97, 0x4f, 0x68, 0xcc, 0x78, 0xa8, 0xef, 0xd6, 0x22, 0x79, 0x35, 0xba, 0x0b, 0x1d,
-	0x16, 0xd3, 0xc4, 0x97, 0x28, 0x59, 0x53, 0x7d, 0xdd, 0x82, 0x1e, 0x75, 0xa1, 0xc9, 0x65, 0x08,
-	0x03, 0x1a, 0x4e, 0xce, 0x84, 0x4a, 0x6d, 0x8d, 0x64, 0x55, 0xa8, 0x0f, 0x28, 0xf6, 0x13, 0x1a,
-	0x19, 0xf9, 0xeb, 0xd3, 0x53, 0x4e, 0x05, 0x5e, 0x51, 0x86, 0x4b, 0x56, 0x3c, 0x01, 0x1b, 0xc7,
-	0x82, 0xc5, 0xef, 0x74, 0xa7, 0xdb, 0x00, 0x5c, 0xb0, 0xd8, 0x1c, 0x5e, 0x51, 0x3e, 0x33, 0x1a,
-	0x75, 0x67, 0xe3, 0xc7, 0x96, 0x45, 0x55, 0xa5, 0x22, 0xaf, 0xf6, 0x1e, 0x02, 0x7c, 0x45, 0x93,
-	0xd7, 0x53, 0x4a, 0x18, 0x53, 0x49, 0x8e, 0xfc, 0x19, 0x35, 0x79, 0x53, 0xcf, 0xe8, 0x06, 0xac,
-	0x9c, 0xfb, 0xd3, 0x94, 0x1a, 0xd4, 0xb4, 0xe0, 0x5d, 0x00, 0x0c, 0x53, 0x71, 0x30, 0x65, 0xc1,
-	0xeb, 0xe1, 0x68, 0xd9, 0x79, 0xe5, 0xa5, 0xe7, 0xc9, 0x02, 0x38, 0xcb, 0x46, 0x6d, 0x24, 0xd4,
-	0x83, 0x95, 0x84, 0x31, 0x21, 0xe3, 0xac, 0xf6, 0x9a, 0xf7, 0x51, 0x5f, 0xbf, 0xc0, 0xfd, 0x45,
-	0x70, 0x44, 0x1b, 0x78, 0x5f, 0x42, 0xfb, 0x30, 0xa1, 0xbe, 0xa0, 0x26, 0x15, 0x7f, 0xf5, 0xb5,
-	0xf5, 0xbe, 0x93, 0x35, 0x27, 0xc4, 0xf4, 0xef, 0x3a, 0x92, 0x08, 0x85, 0xd1, 0x98, 0x5e, 0x28,
-	0x8c, 0x6b, 0x44, 0x0b, 0xde, 0x16, 0x6c, 0xea, 0x38, 0x87, 0xd3, 0x74, 0x66, 0x52, 0xea, 0x3d,
-	0x82, 0x1b, 0x27, 0x34, 0x99, 0x85, 0x91, 0xab, 0x7f, 0x77, 0x00, 0xbd, 0x5f, 0xcb, 0xb0, 0x21,
-	0x77, 0x7e, 0x50, 0xf4, 0x3f, 0x71, 0xd1, 0xbf, 0x63, 0xd1, 0x77, 0x0f, 0xea, 0xcb, 0x34, 0xf0,
-	0xa3, 0x48, 0x24, 0x97, 0x26, 0x19, 0xbb, 0xfb, 0x00, 0x0b, 0x25, 0xea, 0x40, 0xf5, 0x35, 0xbd,
-	0x34, 0x87, 0xcb, 0xc7, 0xe5, 0xc5, 0xf3, 0x69, 0x65, 0xbf, 0xec, 0xa5, 0xb0, 0xa5, 0x00, 0xc8,
-	0xa5, 0xf2, 0xbd, 0xee, 0x62, 0x72, 0x55, 0xb9, 0x3a, 0x57, 0xd5, 0x7c, 0xd2, 0xff, 0xa8, 0x40,
-	0x5b, 0x9e, 0xab, 0xfa, 0xc7, 0xd1, 0xc5, 0x7b, 0x9e, 0x79, 0x17, 0x3a, 0x71, 0x42, 0xcf, 0x43,
-	0x96, 0x72, 0x3b, 0x35, 0xcc, 0xe9, 0x05, 0x3d, 0xfa, 0x1c, 0x76, 0xf3, 0x3a, 0x8d, 0x63, 0xc2,
-	0xd8, 0xa9, 0xe9, 0x2b, 0xd7, 0x58, 0xa0, 0x47, 0x70, 0x6b, 0xe9, 0xaa, 0xd3, 0x71, 0xae, 0x33,
-	0x91, 0x93, 0x81, 0x5e, 0x84, 0x62, 0x1e, 0xe9, 0x8a, 0x3a, 0xd3, 0xd1, 0xa1, 0x87, 0xb0, 0x9d,
-	0x95, 0x33, 0x11, 0xd6, 0x95, 0xf5, 0x15, 0xab, 0x68, 0x1f, 0x6e, 0x16, 0x56, 0x4c, 0x64, 0xab,
-	0x2a, 0xb2, 0xab, 0x96, 0xbd, 0x9f, 0x2a, 0xb0, 0x69, 0x4a, 0x7f, 0x3a, 0xa5, 0xd1, 0x84, 0xca,
-	0x2c, 0xbc, 0x5f, 0xde, 0x03, 0xa6, 0x9a, 0xa2, 0xa9, 0x61, 0x2d, 0xa1, 0xff, 0xc3, 0x66, 0x60,
-	0x5d, 0xce, 0xaf, 0xac, 0x61, 0x2e, 0x2e, 0x48, 0x74, 0x0b, 0xca, 0xcc, 0xe5, 0x6b, 0x6a, 0xdf,
-	0x75, 0x26, 0xe8, 0x00, 0xf6, 0x96, 0x2f, 0x1b, 0x18, 0x74, 0xa7, 0xbf, 0xd6, 0xc6, 0xfb, 0xb9,
-	0x02, 0x3b, 0x12, 0x0b, 0x42, 0x79, 0xcc, 0x22, 0x4e, 0xff, 0x59, 0x4c, 0xee, 0x42, 0x27, 0x31,
-	0x81, 0xcc, 0x8d, 0x35, 0x10, 0x05, 0xbd, 0xac, 0xee, 0xbc, 0x2e, 0x03, 0x9f, 0xae, 0xb4, 0x6b,
-	0x2c, 0xde, 0x56, 0xdd, 0xf5, 0xb7, 0x56, 0xb7, 0x77, 0x02, 0x1d, 0x09, 0xdd, 0xd3, 0x30, 0xf2,
-	0xa7, 0xe1, 0xf7, 0x1f, 0x08, 0x31, 0xef, 0x23, 0xdd, 0x96, 0x96, 0x0c, 0x06, 0x63, 0x5e, 0x76,
-	0xcc, 0x7f, 0x34, 0xdd, 0xd8, 0xe5, 0x90, 0xcb, 0x4c, 0xe5, 0xdb, 0x38, 0xa6, 0x11, 0x53, 0xbd,
-	0x3f, 0x64, 0x91, 0xe9, 0x1b, 0x8e, 0x4e, 0xb6, 0x4b, 0xf6, 0x26, 0x32, 0x39, 0x6a, 0x10, 0x2d,
-	0xb8, 0x1d, 0xad, 0x56, 0xe8, 0x68, 0x4d, 0x58, 0x7b, 0x1c, 0x18, 0x66, 0x87, 0x61, 0xf5, 0x9c,
-	0x26, 0x5c, 0xfa, 0x37, 0x94, 0xc1, 0x88, 0x9a, 0x79, 0x45, 0x63, 0xd3, 0xb0, 0x1a, 0xc4, 0x48,
-	0x32, 0x2c, 0xfd, 0x34, 0x4c, 0x47, 0xcf, 0xe9, 0xa5, 0xa9, 0x0e, 0x47, 0x27, 0xc3, 0x8a, 0x58,
-	0x14, 0x50, 0xd3, 0x74, 0xb4, 0x20, 0xd9, 0xe2, 0xc4, 0xe7, 0x2f, 0xc2, 0x59, 0x68, 0x8b, 0x7d,
-	0x2e, 0x9b, 0xb5, 0x61, 0x12, 0x06, 0xd4, 0x34, 0x92, 0xb9, 0x2c, 0xaf, 0xc3, 0xc3, 0x49, 0xe4,
-	0x8b, 0x34, 0xa1, 0xaa, 0x59, 0xb4, 0xc8, 0x42, 0x81, 0x3e, 0x86, 0x35, 0x61, 0x8b, 0x0f, 0x14,
-	0x0f, 0x9e, 0x73, 0x81, 0x05, 0xc8, 0x83, 0x12, 0x99, 0x5b, 0xa1, 0xff, 0x42, 0x4d, 0x92, 0x5d,
-	0xdc, 0x54, 0xd6, 0x1b, 0xd6, 0x5a, 0x13, 0xeb, 0x41, 0x89, 0xa8, 0x55, 0xf4, 0x00, 0x1a, 0xd4,
-	0x52, 0x60, 0xdc, 0x52, 0xa6, 0x5b, 0xd6, 0x34, 0xc3, 0x8d, 0x07, 0x25, 0xb2, 0xb0, 0x43, 0x07,
-	0xb0, 0xc1, 0x1d, 0x82, 0x8b, 0xd7, 0xd5, 0x4e, 0x6c, 0x77, 0xe6, 0xe9, 0xef, 0xa0, 0x44, 0x72,
-	0x3b, 0xd0, 0x17, 0xb0, 0xce, 0xb3, 0xd4, 0x16, 0x6f, 0x28, 0x17, 0x37, 0x5d, 0x17, 0x73, 0xde,
-	0x3b, 0x28, 0x11, 0xd7, 0x5e, 0x39, 0xc8, 0xb2, 0x5d, 0xdc, 0xce, 0x39, 0x70, 0xa9, 0xb0, 0x72,
-	0x90, 0x55, 0xa1, 0xcf, 0xa0, 0xc5, 0x33, 0xcc, 0x12, 0x77, 0xd4, 0xfe, 0xed, 0xc5, 0xfe, 0x2c,
-	0xeb, 0x1c, 0x94, 0x88, 0x63, 0x2d, 0x13, 0x12, 0x1b, 0x0a, 0x80, 0x37, 0xdd, 0x84, 0x2c, 0xa8,
-	0x81, 0x4c, 0x88, 0xb5, 0x92, 0x01, 0x07, 0xd9, 0xb1, 0x8e, 0x91, 0x1b, 0x70, 0x6e, 0xe6, 0xcb,
-	0x80, 0x1d, 0x7b, 0x0d, 0x59, 0xe6, 0x05, 0xc4, 0x5b, 0x79, 0xc8, 0x9c, 0xb7, 0x53, 0x43, 0x96,
-	0x51, 0xa1, 0x23, 0x68, 0x07, 0x2e, 0xf7, 0xc2, 0x37, 0x94, 0x8b, 0x1d, 0x37, 0x86, 0x0c, 0x05,
-	0x1b, 0x94, 0x48, 0x7e, 0x0f, 0x7a, 0x09, 0x48, 0x14, 0xd8, 0x1a, 0xfe, 0x97, 0xf2, 0xb4, 0x37,
-	0xaf, 0xca, 0x25, 0x7c, 0x6e, 0x50, 0x22, 0x4b, 0x76, 0xca, 0x44, 0xc4, 0x19, 0x46, 0x85, 0xb7,
-	0xdd, 0x44, 0xb8, 0x6c, 0x4b, 0x26, 0x22, 0x6b, 0x8d, 0x9e, 0xc3, 0x66, 0x9c, 0x67, 0x4c, 0xf8,
-	0xa6, 0x72, 0x71, 0x2b, 0xeb, 0xa2, 0x08, 0x6f, 0x71, 0x9f, 0x84, 0x38, 0xce, 0xd2, 0x20, 0x8c,
-	0x5d, 0x88, 0x73, 0x1c, 0x49, 0x42, 0xec, 0xd8, 0xa3, 0x67, 0x26, 0x9a, 0xec, 0xc4, 0xc2, 0x3b,
-	0x2e, 0xc8, 0x85, 0x31, 0x3f, 0x8f, 0xc5, 0x99, 0x73, 0x3e, 0xec, 0xc4, 0x57, 0x0d, 0x41, 0xbc,
-	0xab, 0x5c, 0x3a, 0x8c, 0x74, 0xa9, 0xe1, 0xa0, 0x44, 0xae, 0xf6, 0x82, 0x9e, 0x42, 0x27, 0xce,
-	0x0d, 0x0b, 0x7c, 0xcb, 0x7d, 0x95, 0xf3, 0xc3, 0x64, 0x50, 0x22, 0x85, 0x3d, 0x36, 0x07, 0x4e,
-	0x01, 0xe2, 0xbd, 0x62, 0x0e, 0x8a, 0x15, 0x5a, 0xdc, 0x67, 0xcb, 0x61, 0x3e, 0x6b, 0xff, 0x5d,
-	0x2c, 0x07, 0xa7, 0xe5, 0x39, 0xd6, 0x07, 0x6b, 0x50, 0xd7, 0x7f, 0x79, 0x78, 0xbf, 0x95, 0xa1,
-	0x41, 0x68, 0x40, 0xc3, 0x58, 0x8e, 0xaa, 0x2e, 0x34, 0x13, 0x2a, 0xd2, 0x24, 0x7a, 0xa5, 0x88,
-	0xb7, 0xfe, 0x90, 0xc9, 0xaa, 0xd4, 0x28, 0x10, 0xbe, 0x48, 0xb9, 0x9d, 0x7d, 0x5a, 0x92, 0x5f,
-	0x7f, 0x67, 0x3e, 0x3f, 0xb3, 0x9f, 0xf8, 0xf2, 0x59, 0x7a, 0x9b, 0xf8, 0xfc, 0x90, 0x45, 0x3c,
-	0x9d, 0xd1, 0xb1, 0xfd, 0xce, 0xcd, 0xa8, 0xe4, 0xcc, 0xb5, 0x7f, 0x12, 0xd8, 0x99, 0xbb, 0xa2,
-	0x67, 0x6e, 0x4e, 0x8d, 0xee, 0x40, 0x6d, 0xca, 0x26, 0x1c, 0xd7, 0xd5, 0x47, 0xc6, 0xba, 0xbd,
-	0xe7, 0x0b, 0x36, 0x19, 0x8e, 0x88, 0x5a, 0xf2, 0x7e, 0x29, 0xc3, 0x8a, 0x92, 0xe5, 0x24, 0xf3,
-	0x9d, 0x11, 0x6e, 0x45, 0x19, 0xbe, 0x60, 0x71, 0x18, 0x70, 0xf5, 0x1f, 0x42, 0x8b, 0x18, 0x69,
-	0xd9, 0x3f, 0x14, 0x32, 0xfc, 0x91, 0x7c, 0x79, 0x5e, 0xa6, 0xb3, 0x91, 0x61, 0x33, 0x35, 0x92,
-	0x55, 0xc9, 0x73, 0xc4, 0x45, 0x34, 0x90, 0xf7, 0xd6, 0xac, 0xc5, 0x8a, 0x72, 0x4e, 0x29, 0x43,
-	0xb5, 0xa6, 0x87, 0xd8, 0x42, 0xb1, 0xf8, 0xe8, 0x5b, 0x55, 0x73, 0x56, 0x0b, 0xa3, 0xba, 0xba,
-	0xd2, 0x83, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x94, 0xed, 0x2b, 0x8e, 0x12, 0x00, 0x00,
+	// 2147 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcd, 0x59, 0xcd, 0x6e, 0x1c, 0xc7,
+	0x11, 0xf6, 0x72, 0x97, 0x7f, 0x45, 0x72, 0x49, 0x36, 0x25, 0xb1, 0x45, 0xc9, 0xb6, 0x34, 0x71,
+	0x2c, 0x45, 0x8a, 0x69, 0x5b, 0x46, 0x1c, 0xc5, 0x08, 0x12, 0x4b, 0x14, 0x2d, 0x1a, 0x96, 0x65,
+	0x7a, 0x44, 0x39, 0xc8, 0x21, 0x08, 0x66, 0x67, 0x9b, 0xcb, 0x01, 0x77, 0x67, 0x06, 0xf3, 0xc3,
+	0x9f, 0x20, 0x87, 0x5c, 0x73, 0x0a, 0x90, 0x27, 0xc8, 0x1b, 0xe4, 0x9c, 0x43, 0x8e, 0x01, 0x72,
+	0xcf, 0x6b, 0xe4, 0x1d, 0x92, 0xea, 0xea, 0xee, 0x99, 0xee, 0x99, 0x25, 0x6d, 0xd9, 0x06, 0x92,
+	0x13, 0xa7, 0xaa, 0xab, 0xaa, 0xab, 0xab, 0xaa, 0xab, 0xbf, 0x5a, 0xc2, 0x72, 0x10, 0x16, 0x51,
+	0x12, 0x6f, 0xa7, 0x59, 0x52, 0x24, 0x6c, 0x2e, 0xa2, 0xbf, 0x5b, 0x6f, 0x8e, 0x92, 0x64, 0x34,
+	0x16, 0xef, 0x12, 0x35, 0x28, 0x0f, 0xdf, 0x2d, 0xa2, 0x89, 0xc8, 0x8b, 0x60, 0x92, 0x2a, 0x41,
+	0xef, 0x2f, 0x1d, 0x80, 0x83, 0x2c, 0x88, 0xf3, 0x43, 0x91, 0xed, 0x0f, 0xd8, 0x35, 0x98, 0x0b,
+	0x26, 0x49, 0x19, 0x17, 0xbc, 0x73, 0xab, 0x73, 0x77, 0xd9, 0xd7, 0x14, 0xbb, 0x09, 0x8b, 0x99,
+	0x08, 0xa3, 0x34, 0x12, 0xb8, 0x34, 0x83, 0x4b, 0x8b, 0x7e, 0xcd, 0x60, 0x1c, 0xe6, 0xd3, 0xe0,
+	0x7c, 0x9c, 0x04, 0x43, 0xde, 0x25, 0x35, 0x43, 0xb2, 0x37, 0x00, 0xa2, 0x7c, 0x27, 0x89, 0xe2,
+	0x41, 0x90, 0x0b, 0xde, 0xc3, 0xc5, 0x05, 0xdf, 0xe2, 0x30, 0x0f, 0x7a, 0x13, 0x31, 0x49, 0xf8,
+	0x2c, 0xae, 0x2c, 0x3d, 0xe8, 0x6f, 0x2b, 0xb7, 0xb7, 0x3f, 0x47, 0xde, 0xfe, 0xc0, 0xa7, 0x35,
+	0xef, 0xf7, 0x30, 0xa7, 0x68, 0x76, 0x1f, 0x7a, 0xc5, 0x79, 0x2a, 0xc8, 0xb7, 0xfe, 0x83, 0x4d,
+	0x57, 0x9a, 0xfe, 0x1c, 0xe0, 0xb2, 0x4f, 0x42, 0x8c, 0x41, 0x6f, 0x18, 0x14, 0x01, 0x79, 0xbb,
+	0xec, 0xd3, 0xb7, 0xf7, 0x3e, 0x2c, 0x18, 0x29, 0xb6, 0x00, 0xbd, 0xe7, 0x5f, 0x1c, 0xec, 0xae,
+	0xbd, 0xc6, 0xe6, 0xa1, 0x7b, 0xf0, 0xe8, 0xe9, 0x5a, 0x07, 0x55, 0xfa, 0xbb, 0xcf, 0x77, 0xfc,
+	0x5f, 0xef, 0x1f, 0xec, 0x3e, 0xf9, 0x2d, 0x2d, 0xce, 0x78, 0x87, 0x30, 0xf7, 0x55, 0x52, 0x08,
+	0xdc, 0xfd, 0x21, 0x2c, 0x56, 0xd1, 0x23, 0x17, 0x96, 0x1e, 0x6c, 0x6d, 0xab, 0xf8, 0x6e, 0x9b,
+	0xf8, 0x6e, 0x1f, 0x18, 0x09, 0xbf, 0x16, 0xc6, 0x53, 0x2e, 0x9f, 0xa0, 0x0d, 0xf1, 0x68, 0x38,
+	0xcc, 0x44, 0x9e, 0xeb, 0x00, 0x3a, 0x3c, 0xef, 0x25, 0x2c, 0xed, 0x9e, 0x89, 0xb0, 0x94, 0x49,
+	0xbc, 0x24, 0x11, 0x5b, 0xb0, 0x10, 0x26, 0x71, 0x91, 0x61, 0xb6, 0xb5, 0x99, 0x8a, 0xae, 0x4e,
+	0xdc, 0xb5, 0x4e, 0xbc, 0x07, 0x6b, 0x2f, 0x44, 0x98, 0x89, 0x62, 0x3f, 0x4b, 0xd2, 0x24, 0x0f,
+	0xc6, 0x68, 0xdb, 0x49, 0x66, 0xa7, 0x99, 0x4c, 0xdc, 0x39, 0x27, 0x0d, 0xb4, 0xdf, 0xbd, 0xbb,
+	0xe2, 0x6b, 0xca, 0xbb, 0x0f, 0xab, 0xca, 0xd2, 0xaf, 0xa2, 0x22, 0x46, 0x8f, 0xd1, 0x10, 0xe6,
+	0xfd, 0x54, 0x11, 0x68, 0xa6, 0x2b, 0xf3, 0xae, 0x49, 0xef, 0x5f, 0x1d, 0x94, 0x2e, 0x82, 0xac,
+	0x78, 0x51, 0x0e, 0x76, 0x8e, 0x82, 0x28, 0x56, 0xd2, 0xa1, 0xfc, 0xfc, 0xf4, 0x09, 0x6d, 0xba,
+	0xe2, 0x1b, 0x92, 0xdd, 0x85, 0x55, 0xdc, 0xa4, 0xcc, 0xa2, 0xe2, 0xfc, 0x89, 0x40, 0x2f, 0xa3,
+	0x42, 0x67, 0xad, 0xc9, 0x66, 0xf7, 0x60, 0x2d, 0x49, 0x45, 0x16, 0xc8, 0x28, 0x19, 0x51, 0x75,
+	0xdc, 0x16, 0x9f, 0xdd, 0x82, 0xa5, 0x5c, 0xba, 0xb0, 0x27, 0xa2, 0xd1, 0x51, 0x41, 0xc5, 0xd7,
+	0xf3, 0x6d, 0x16, 0xdb, 0x06, 0x96, 0x06, 0x19, 0x1e, 0x5a, 0xd1, 0x5f, 0x1c, 0x1e, 0xe6, 0x78,
+	0xec, 0x59, 0x12, 0x9c, 0xb2, 0xe2, 0x15, 0xd0, 0x7f, 0x51, 0x24, 0xe9, 0x37, 0x3a, 0x13, 0x56,
+	0x7e, 0x8e, 0xb2, 0x7a, 0xf3, 0x19, 0xb2, 0x69, 0x71, 0xe8, 0xcc, 0xda, 0x8e, 0x29, 0x8b, 0x2e,
+	0xa5, 0xa2, 0xc9, 0xf6, 0x3e, 0x04, 0xf8, 0x5c, 0x64, 0xc7, 0x63, 0xe1, 0x27, 0x09, 0x25, 0x39,
+	0x0e, 0x26, 0x42, 0xe7, 0x8d, 0xbe, 0xd9, 0x15, 0x98, 0x3d, 0x09, 0xc6, 0xa5, 0xd0, 0x51, 0x53,
+	0x84, 0x77, 0x06, 0xb0, 0x5f, 0x16, 0x8f, 0xc7, 0x49, 0x78, 0x8c, 0x9e, 0x4e, 0xd9, 0xaf, 0x33,
+	0x75, 0x3f, 0x59, 0x00, 0x47, 0xb6, 0xd7, 0x9a, 0x42, 0x0b, 0xb3, 0x19, 0x7a, 0x20, 0xfd, 0xec,
+	0x62, 0xed, 0xb3, 0xfa, 0xfa, 0x19, 0xe7, 0x7c, 0x25, 0xe0, 0x3d, 0x85, 0xd5, 0x9d, 0x4c, 0x04,
+	0x85, 0xd0, 0xa9, 0xf8, 0xb6, 0x8d, 0xc5, 0xfb, 0x8d, 0xac, 0xb9, 0xa2, 0x18, 0x7f, 0x57, 0x43,
+	0x32, 0x42, 0x51, 0x3c, 0x14, 0x67, 0x14, 0xe3, 0x9e, 0xaf, 0x08, 0x6f, 0x03, 0xd6, 0x95, 0x9f,
+	0xfb, 0xe3, 0x72, 0xa2, 0x53, 0xea, 0x7d, 0x0c, 0x57, 0x0e, 0x44, 0x36, 0x89, 0x62, 0x97, 0xff,
+	0xcd, 0x03, 0xe8, 0xfd, 0xa3, 0x03, 0x7d, 0xa9, 0xf9, 0xbd, 0x46, 0xff, 0xa7, 0x6e, 0xf4, 0x6f,
+	0x9b, 0xe8, 0xbb, 0x1b, 0x6d, 0xcb, 0x34, 0xe4, 0xbb, 0xd8, 0x10, 0xce, 0x75, 0x32, 0xb6, 0x1e,
+	0x02, 0xd4, 0x4c, 0xb6, 0x06, 0xdd, 0x63, 0x71, 0xae, 0x37, 0x97, 0x9f, 0xd3, 0x8b, 0xe7, 0xa3,
+	0x99, 0x87, 0x1d, 0xaf, 0x84, 0x0d, 0x0a, 0x40, 0x23, 0x95, 0xaf, 0x74, 0x16, 0x9d, 0xab, 0x99,
+	0x8b, 0x73, 0xd5, 0x6d, 0x26, 0xfd, 0x3f, 0x33, 0xb0, 0x2a, 0xf7, 0xa5, 0xfe, 0xb1, 0x7b, 0xf6,
+	0x8a, 0x7b, 0x62, 0x87, 0x48, 0x33, 0x71, 0x12, 0x25, 0x65, 0x6e, 0xde, 0x35, 0xbd, 0x7b, 0x8b,
+	0xcf, 0x7e, 0x01, 0x5b, 0x4d, 0x9e, 0x8a, 0x23, 0x46, 0xee, 0x50, 0xf7, 0x95, 0x4b, 0x24, 0xd8,
+	0xc7, 0x70, 0x63, 0xea, 0xaa, 0xd3, 0x71, 0x2e, 0x13, 0x91, 0x2f, 0x83, 0xc0, 0x13, 0x56, 0x9e,
+	0xce, 0xd2, 0x9e, 0x0e, 0x8f, 0x7d, 0x08, 0xd7, 0x6c, 0xda, 0xf2, 0x70, 0x8e, 0xa4, 0x2f, 0x58,
+	0xc5, 0xf7, 0x6a, 0xb3, 0xb5, 0xa2, 0x3d, 0x9b, 0x27, 0xcf, 0x2e, 0x5a, 0xf6, 0xfe, 0x38, 0x03,
+	0xeb, 0xba, 0xf4, 0xc7, 0x63, 0x11, 0x8f, 0x84, 0xcc, 0xc2, 0xab, 0xe5, 0x3d, 0x4c, 0xa8, 0x29,
+	0xea, 0x1a, 0x56, 0x14, 0xfb, 0x31, 0xac, 0x87, 0xc6, 0x64, 0x75, 0x64, 0x15, 0xe6, 0xf6, 0x82,
+	0x8c, 0x6e, 0x8b, 0x69, 0x1d, 0xbe, 0x47, 0x7a, 0x97, 0x89, 0xb0, 0xc7, 0x70, 0x73, 0xfa, 0xb2,
+	0x0e, 0x83, 0xea, 0xf4, 0x97, 0xca, 0x78, 0x7f, 0x9b, 0x81, 0xeb, 0x32, 0x16, 0xbe, 0xc8, 0xd3,
+	0x24, 0xce, 0xc5, 0xff, 0x36, 0x26, 0x58, 0xdd, 0x99, 0x76, 0xa4, 0x12, 0x56, 0x81, 0x68, 0xf1,
+	0x65, 0x75, 0x37, 0x79, 0x56, 0xf8, 0x54, 0xa5, 0x5d, 0x22, 0xf1, 0x75, 0xd5, 0x3d, 0xf7, 0xb5,
+	0xd5, 0xed, 0x1d, 0xc0, 0x9a, 0x0c, 0xdd, 0x27, 0xd8, 0x4b, 0xc7, 0xd1, 0xef, 0xbe, 0xa7, 0x88,
+	0x79, 0xef, 0xa8, 0xb6, 0x34, 0xe5, 0x61, 0xd0, 0xe2, 0x1d, 0x47, 0xfc, 0x0f, 0xba, 0x1b, 0xbb,
+	0x28, 0x77, 0x9a, 0xa8, 0xbc, 0x8d, 0x43, 0x11, 0x27, 0xd4, 0xfb, 0x11, 0x48, 0xe8, 0xbe, 0xe1,
+	0xf0, 0x64, 0xbb, 0x4c, 0x4e, 0x63, 0x9d, 0xa3, 0x45, 0x5f, 0x11, 0x6e, 0x47, 0xeb, 0x4d, 0x79,
+	0xc6, 0x9e, 0x0a, 0x14, 0x8b, 0xc2, 0x47, 0xa1, 0xc6, 0x77, 0xf8, 0x8c, 0x1f, 0xe3, 0x23, 0x64,
+	0x9e, 0x71, 0xf9, 0xfd, 0x6d, 0x41, 0xb6, 0xf7, 0x13, 0x98, 0x7f, 0x1c, 0x14, 0xe1, 0x11, 0x9a,
+	0xbd, 0x07, 0xf3, 0x6a, 0x0e, 0x50, 0x88, 0x6c, 0xe9, 0xc1, 0x9a, 0x79, 0x27, 0xcc, 0xce, 0xbe,
+	0x11, 0xf0, 0xfe, 0xdc, 0x01, 0x26, 0xe1, 0xaa, 0x4c, 0xd8, 0x77, 0x1e, 0x01, 0xde, 0x82, 0x95,
+	0x4c, 0x8c, 0x05, 0x62, 0x7a, 0x5d, 0x1e, 0xea, 0xa1, 0x75, 0x99, 0x12, 0x92, 0x69, 0x86, 0xdc,
+	0x98, 0x02, 0xd5, 0xf5, 0x6d, 0x96, 0xb7, 0x8e, 0xd0, 0x61, 0x1c, 0x44, 0x13, 0xe3, 0x18, 0x3e,
+	0xc8, 0xcf, 0x24, 0x84, 0x55, 0x0f, 0xdc, 0xb3, 0x68, 0x12, 0x15, 0x12, 0x79, 0x22, 0x0c, 0x1e,
+	0x05, 0x39, 0x91, 0x3a, 0x87, 0x15, 0x2d, 0x1d, 0xcd, 0xb1, 0xda, 0xd4, 0xa2, 0x2a, 0x9d, 0x9a,
+	0xe1, 0xdd, 0x81, 0x15, 0xda, 0xc0, 0x17, 0xa7, 0x41, 0x36, 0xbc, 0xf8, 0xbc, 0x5e, 0x80, 0x82,
+	0xf4, 0xf2, 0xe1, 0x3b, 0x74, 0x2c, 0x14, 0x6c, 0x0e, 0x83, 0x78, 0x18, 0x21, 0xae, 0x36, 0xf0,
+	0xab, 0x66, 0x5c, 0xf8, 0xd6, 0xa1, 0xa7, 0xc3, 0x52, 0x01, 0x53, 0x8a, 0xc9, 0x8a, 0x5f, 0xd1,
+	0xde, 0x6d, 0x58, 0x7c, 0x19, 0xe7, 0xda, 0x7c, 0x05, 0x51, 0x3a, 0x36, 0x44, 0xf1, 0x00, 0x10,
+	0x6f, 0x1f, 0x0d, 0xb3, 0xe0, 0xf4, 0x42, 0x99, 0xbf, 0x76, 0x60, 0xc3, 0x17, 0xa3, 0x28, 0x2f,
+	0x44, 0xb6, 0x63, 0x1c, 0x52, 0x35, 0xd6, 0x82, 0x8a, 0x78, 0xfd, 0x14, 0x50, 0x4e, 0x32, 0x77,
+	0x1a, 0x69, 0xb2, 0x55, 0x46, 0x65, 0x8c, 0x5c, 0x78, 0xea, 0x32, 0xe5, 0xb1, 0xd3, 0x72, 0xf0,
+	0x19, 0x42, 0x0a, 0xd5, 0x86, 0x34, 0x45, 0x49, 0x10, 0xe3, 0x43, 0x8a, 0x9d, 0xee, 0x35, 0x35,
+	0xc3, 0xfb, 0x53, 0x07, 0xd6, 0x5f, 0xa6, 0xd2, 0xcd, 0xff, 0x13, 0x7f, 0xbd, 0x4d, 0xb8, 0xfa,
+	0x32, 0xce, 0xda, 0x41, 0xf4, 0x3e, 0xc1, 0xea, 0x2b, 0x07, 0x58, 0x39, 0xee, 0x00, 0x85, 0xd3,
+	0x01, 0xba, 0x87, 0xb2, 0xa6, 0x12, 0x2a, 0x86, 0x0b, 0xa8, 0x7a, 0x06, 0x8d, 0x7f, 0x04, 0x7d,
+	0x9a, 0x23, 0x6b, 0x2b, 0x7d, 0x98, 0x89, 0x86, 0x3a, 0x93, 0xf8, 0x25, 0x2f, 0x78, 0x90, 0xe2,
+	0x65, 0x3d, 0x51, 0x9a, 0x0b, 0xbe, 0x21, 0xbd, 0x7f, 0x33, 0x58, 0xa8, 0x3a, 0x07, 0x8a, 0x9d,
+	0x88, 0x2c, 0x97, 0xf5, 0xa4, 0x47, 0x0e, 0x4d, 0xaa, 0xc9, 0x0d, 0x2b, 0x22, 0xd3, 0x21, 0xd2,
+	0x94, 0x6c, 0x6b, 0xea, 0x6b, 0x5f, 0x9d, 0x5c, 0xb5, 0x0f, 0x87, 0x27, 0x9d, 0x8e, 0x93, 0x38,
+	0x14, 0x1a, 0xb4, 0x28, 0xc2, 0xb9, 0x66, 0xb3, 0x8d, 0x6b, 0xa6, 0xd6, 0xf6, 0xb1, 0xa9, 0x09,
+	0x0d, 0x44, 0x2a, 0x5a, 0x5d, 0xc1, 0x11, 0x76, 0xcc, 0x32, 0x13, 0x04, 0x36, 0x64, 0xf6, 0x0d,
+	0x83, 0xbd, 0x07, 0x0b, 0x85, 0x79, 0xbc, 0x80, 0xe6, 0xe8, 0x6a, 0x96, 0xa8, 0xfb, 0xd0, 0xde,
+	0x6b, 0x7e, 0x25, 0x85, 0xb9, 0xed, 0xc9, 0x61, 0x99, 0x2f, 0xb9, 0x3f, 0x13, 0xa8, 0xc1, 0x1c,
+	0x25, 0x69, 0x95, 0x7d, 0x00, 0x8b, 0xc2, 0x8c, 0xd0, 0x7c, 0x99, 0x44, 0x37, 0x8c, 0xa8, 0x35,
+	0x5b, 0xa3, 0x7c, 0x2d, 0x87, 0x18, 0xa1, 0x9f, 0x3b, 0x03, 0x32, 0x5f, 0x21, 0x4d, 0x6e, 0x34,
+	0x9b, 0xe3, 0x33, 0xaa, 0x37, 0x34, 0xd8, 0x2f, 0x61, 0x25, 0xb7, 0x47, 0x63, 0xde, 0x27, 0x13,
+	0x9b, 0xae, 0x89, 0x6a, 0x6e, 0x46, 0x0b, 0xae, 0x3c, 0x19, 0xb0, 0xa7, 0x65, 0xbe, 0xda, 0x30,
+	0xe0, 0x8e, 0xd2, 0x64, 0xc0, 0x66, 0xb1, 0x9f, 0x63, 0x8a, 0xad, 0xc9, 0x94, 0xaf, 0x91, 0xfe,
+	0xb5, 0x5a, 0xdf, 0x9e, 0x5a, 0x51, 0xdd, 0x91, 0x96, 0x09, 0x49, 0xf5, 0x08, 0xc1, 0xd7, 0xdd,
+	0x84, 0xd4, 0xa3, 0x85, 0x4c, 0x88, 0x91, 0x92, 0x0e, 0x87, 0xf6, 0x58, 0xc0, 0x99, 0xeb, 0x70,
+	0x63, 0x66, 0x90, 0x0e, 0x3b, 0xf2, 0x2a, 0x64, 0xd6, 0x03, 0xce, 0x37, 0x9a, 0x21, 0x73, 0x5e,
+	0x77, 0x15, 0x32, 0x8b, 0xc5, 0x76, 0x61, 0x35, 0x74, 0x67, 0x37, 0x7e, 0x85, 0x4c, 0x5c, 0x77,
+	0x7d, 0xb0, 0x46, 0x38, 0x34, 0xd2, 0xd4, 0x61, 0xcf, 0x81, 0x15, 0xad, 0x69, 0x8f, 0x5f, 0x25,
+	0x4b, 0x37, 0xab, 0xaa, 0x9c, 0x32, 0x0f, 0xa2, 0xb1, 0x29, 0x9a, 0x32, 0x11, 0xa9, 0x35, 0x91,
+	0xf1, 0x6b, 0x6e, 0x22, 0xdc, 0x69, 0x4d, 0x26, 0xc2, 0x96, 0x66, 0x9f, 0xc1, 0x7a, 0xda, 0x9c,
+	0xb8, 0xf8, 0x26, 0x99, 0xb8, 0x61, 0x9b, 0x68, 0x87, 0xb7, 0xad, 0x27, 0x43, 0x9c, 0xda, 0x63,
+	0x14, 0xe7, 0x6e, 0x88, 0x1b, 0x33, 0x96, 0x0c, 0xb1, 0x23, 0xcf, 0x3e, 0xd5, 0xde, 0xd8, 0x88,
+	0x97, 0x5f, 0x77, 0x83, 0xdc, 0x1a, 0x13, 0x2a, 0x5f, 0x1c, 0x9c, 0x1c, 0xc0, 0xf5, 0xf4, 0x22,
+	0x10, 0xcd, 0xb7, 0xc8, 0xa4, 0x33, 0xd1, 0x4e, 0x15, 0x44, 0xd3, 0x17, 0x5b, 0x61, 0xd8, 0xa8,
+	0xd3, 0x06, 0xd8, 0xe4, 0x37, 0xdc, 0xab, 0xdc, 0x04, 0xa3, 0x68, 0xb0, 0xa5, 0x63, 0x72, 0xe0,
+	0x14, 0x20, 0xbf, 0xd9, 0xce, 0x41, 0xbb, 0x42, 0xdb, 0x7a, 0xa6, 0x1c, 0x2a, 0xac, 0xfe, 0x7a,
+	0xbb, 0x1c, 0x9c, 0x96, 0xe7, 0x48, 0x63, 0x43, 0x9b, 0x1f, 0x29, 0xdc, 0xc8, 0xdf, 0x70, 0x73,
+	0xd7, 0x80, 0x93, 0xa8, 0x69, 0x24, 0xd9, 0x1d, 0x98, 0x1d, 0x48, 0x34, 0xc8, 0xdf, 0x24, 0x95,
+	0x55, 0xa3, 0xa2, 0x21, 0x22, 0x8a, 0xaa, 0x75, 0xb6, 0x07, 0x6b, 0x45, 0x03, 0xfe, 0xf1, 0x5b,
+	0xfa, 0x67, 0x4d, 0x53, 0xf8, 0x2d, 0x78, 0x28, 0x43, 0xd6, 0xd4, 0xa2, 0x6e, 0x60, 0x83, 0x36,
+	0x7e, 0xbb, 0xd1, 0x0d, 0x5c, 0x44, 0x47, 0xdd, 0xc0, 0x66, 0xa9, 0x26, 0x6c, 0x43, 0x3c, 0xee,
+	0x35, 0x9b, 0xb0, 0x0b, 0x00, 0x55, 0x13, 0xb6, 0x79, 0xec, 0x67, 0xb0, 0x14, 0xd6, 0xc0, 0x8e,
+	0xff, 0x80, 0x0c, 0x5c, 0x75, 0x5c, 0x30, 0x98, 0x0f, 0xb5, 0x6d, 0x59, 0x52, 0xad, 0xa1, 0x1e,
+	0x7f, 0xab, 0xa1, 0x6a, 0xa3, 0x40, 0x52, 0xad, 0x19, 0xec, 0x1d, 0x98, 0x2f, 0x15, 0x84, 0xe3,
+	0x3f, 0x24, 0xb5, 0x75, 0xa3, 0x56, 0x21, 0x3b, 0x99, 0x1c, 0x2d, 0x23, 0x3b, 0xed, 0xa9, 0x86,
+	0x73, 0xfc, 0x6d, 0xb7, 0xd3, 0xd6, 0x30, 0x4f, 0x76, 0x5a, 0x23, 0x25, 0xcb, 0xb1, 0x05, 0x4b,
+	0xf8, 0x1d, 0xb7, 0x1c, 0xa7, 0x80, 0x3f, 0x59, 0x8e, 0x2d, 0x3d, 0xd9, 0x34, 0x4b, 0x17, 0x76,
+	0xf1, 0xbb, 0xee, 0x7d, 0x6e, 0xa1, 0x32, 0xd9, 0x34, 0x1b, 0x3a, 0xec, 0x4b, 0xd8, 0x28, 0xdb,
+	0x60, 0x89, 0xff, 0x88, 0x4c, 0xbd, 0x5e, 0x07, 0x20, 0x9b, 0xea, 0xd7, 0x34, 0x5d, 0xaa, 0x00,
+	0x07, 0x66, 0xf1, 0x7b, 0x8d, 0x0a, 0x68, 0x80, 0x30, 0xaa, 0x00, 0x87, 0x27, 0x2f, 0xdb, 0x89,
+	0x05, 0xb1, 0xf8, 0x7d, 0xf7, 0xb2, 0xb9, 0xf0, 0x4b, 0x5e, 0x36, 0x5b, 0xfa, 0xf1, 0x02, 0x02,
+	0x78, 0xba, 0x4e, 0xde, 0x3f, 0x3b, 0xb0, 0xe8, 0x8b, 0x50, 0x44, 0xa9, 0x9c, 0x2b, 0x69, 0x66,
+	0x41, 0xdc, 0x12, 0x7f, 0x45, 0xa0, 0x4e, 0x0d, 0x09, 0x36, 0x8b, 0x70, 0x57, 0x81, 0xd0, 0x26,
+	0x37, 0x83, 0xaa, 0xa2, 0x24, 0x9e, 0x3d, 0x0a, 0xf2, 0x23, 0xf3, 0x7b, 0xbc, 0xfc, 0x96, 0xd6,
+	0x10, 0x25, 0xed, 0x60, 0xfb, 0x2a, 0x27, 0x62, 0x68, 0x7e, 0x94, 0xb6, 0x58, 0x12, 0xf1, 0x9a,
+	0x5f, 0xf4, 0x0d, 0x92, 0x9d, 0x55, 0x88, 0xb7, 0xc1, 0x66, 0xb7, 0xa1, 0x37, 0x4e, 0x46, 0x39,
+	0xa2, 0x2f, 0x39, 0xe9, 0xad, 0x98, 0x73, 0x3e, 0x4b, 0x46, 0xf2, 0x7f, 0x27, 0x72, 0xc9, 0xfb,
+	0x7b, 0x07, 0x66, 0x89, 0x26, 0x74, 0xe9, 0xcc, 0xdb, 0x86, 0x94, 0xee, 0x23, 0x18, 0x88, 0xc2,
+	0x9c, 0x7e, 0xf0, 0x47, 0x48, 0xac, 0xa8, 0x69, 0xff, 0x4e, 0x90, 0xee, 0x0f, 0xe4, 0x9d, 0x7b,
+	0x5e, 0x4e, 0x06, 0xfa, 0xa7, 0x07, 0x74, 0xdf, 0x62, 0xc9, 0x7d, 0x8a, 0xb3, 0x78, 0x4f, 0x9e,
+	0x5b, 0xc1, 0x7e, 0x43, 0x4a, 0x50, 0x48, 0x82, 0xb4, 0xa6, 0x10, 0x63, 0xcd, 0xa8, 0x47, 0x9b,
+	0x79, 0x02, 0xb5, 0x8a, 0x18, 0xcc, 0xd1, 0x91, 0x3e, 0xf8, 0x2f, 0xcb, 0x46, 0x12, 0x41, 0xde,
+	0x1a, 0x00, 0x00,
 }
@@ -39,7 +39,7 @@ func (x ConsensusPb_ConsensusMessageType) String() string {
 	return proto.EnumName(ConsensusPb_ConsensusMessageType_name, int32(x))
 }
 func (ConsensusPb_ConsensusMessageType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{5, 0}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{6, 0}
 }
 
 type EndorsePb_ConsensusVoteTopic int32
@@ -65,7 +65,7 @@ func (x EndorsePb_ConsensusVoteTopic) String() string {
 	return proto.EnumName(EndorsePb_ConsensusVoteTopic_name, int32(x))
 }
 func (EndorsePb_ConsensusVoteTopic) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{7, 0}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{8, 0}
 }
 
 // header of a block
@@ -84,6 +84,8 @@ type BlockHeaderPb struct {
 	DkgID                []byte               `protobuf:"bytes,12,opt,name=dkgID,proto3" json:"dkgID,omitempty"`
 	DkgPubkey            []byte               `protobuf:"bytes,13,opt,name=dkgPubkey,proto3" json:"dkgPubkey,omitempty"`
 	DkgSignature         []byte               `protobuf:"bytes,14,opt,name=dkgSignature,proto3" json:"dkgSignature,omitempty"`
+	BaseFee              []byte               `protobuf:"bytes,15,opt,name=baseFee,proto3" json:"baseFee,omitempty"`
+	LogsBloom            []byte               `protobuf:"bytes,16,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -211,11 +213,26 @@ func (m *BlockHeaderPb) GetDkgSignature() []byte {
 	return nil
 }
 
+func (m *BlockHeaderPb) GetBaseFee() []byte {
+	if m != nil {
+		return m.BaseFee
+	}
+	return nil
+}
+
+func (m *BlockHeaderPb) GetLogsBloom() []byte {
+	if m != nil {
+		return m.LogsBloom
+	}
+	return nil
+}
+
 // block consists of header followed by transactions
 // hash of current block can be computed from header hence not stored
 type BlockPb struct {
 	Header               *BlockHeaderPb `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Actions              []*ActionPb    `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	Footer               *BlockFooterPb `protobuf:"bytes,3,opt,name=footer,proto3" json:"footer,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -259,6 +276,60 @@ func (m *BlockPb) GetActions() []*ActionPb {
 	return nil
 }
 
+func (m *BlockPb) GetFooter() *BlockFooterPb {
+	if m != nil {
+		return m.Footer
+	}
+	return nil
+}
+
+// footer of a block, the proof of its commit
+type BlockFooterPb struct {
+	Endorsements         *EndorsementSet `protobuf:"bytes,1,opt,name=endorsements,proto3" json:"endorsements,omitempty"`
+	CommitTimestamp      uint64          `protobuf:"varint,2,opt,name=commitTimestamp,proto3" json:"commitTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BlockFooterPb) Reset()         { *m = BlockFooterPb{} }
+func (m *BlockFooterPb) String() string { return proto.CompactTextString(m) }
+func (*BlockFooterPb) ProtoMessage()    {}
+func (*BlockFooterPb) Descriptor() ([]byte, []int) {
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{2}
+}
+func (m *BlockFooterPb) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockFooterPb.Unmarshal(m, b)
+}
+func (m *BlockFooterPb) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockFooterPb.Marshal(b, m, deterministic)
+}
+func (dst *BlockFooterPb) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFooterPb.Merge(dst, src)
+}
+func (m *BlockFooterPb) XXX_Size() int {
+	return xxx_messageInfo_BlockFooterPb.Size(m)
+}
+func (m *BlockFooterPb) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFooterPb.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFooterPb proto.InternalMessageInfo
+
+func (m *BlockFooterPb) GetEndorsements() *EndorsementSet {
+	if m != nil {
+		return m.Endorsements
+	}
+	return nil
+}
+
+func (m *BlockFooterPb) GetCommitTimestamp() uint64 {
+	if m != nil {
+		return m.CommitTimestamp
+	}
+	return 0
+}
+
 // index of block raw data file
 type BlockIndex struct {
 	Start                uint64   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -273,7 +344,7 @@ func (m *BlockIndex) Reset()         { *m = BlockIndex{} }
 func (m *BlockIndex) String() string { return proto.CompactTextString(m) }
 func (*BlockIndex) ProtoMessage()    {}
 func (*BlockIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{2}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{3}
 }
 func (m *BlockIndex) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockIndex.Unmarshal(m, b)
@@ -326,7 +397,7 @@ func (m *BlockSync) Reset()         { *m = BlockSync{} }
 func (m *BlockSync) String() string { return proto.CompactTextString(m) }
 func (*BlockSync) ProtoMessage()    {}
 func (*BlockSync) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{3}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{4}
 }
 func (m *BlockSync) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockSync.Unmarshal(m, b)
@@ -373,7 +444,7 @@ func (m *BlockContainer) Reset()         { *m = BlockContainer{} }
 func (m *BlockContainer) String() string { return proto.CompactTextString(m) }
 func (*BlockContainer) ProtoMessage()    {}
 func (*BlockContainer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{4}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{5}
 }
 func (m *BlockContainer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockContainer.Unmarshal(m, b)
@@ -415,7 +486,7 @@ func (m *ConsensusPb) Reset()         { *m = ConsensusPb{} }
 func (m *ConsensusPb) String() string { return proto.CompactTextString(m) }
 func (*ConsensusPb) ProtoMessage()    {}
 func (*ConsensusPb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{5}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{6}
 }
 func (m *ConsensusPb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConsensusPb.Unmarshal(m, b)
@@ -487,7 +558,7 @@ func (m *ProposePb) Reset()         { *m = ProposePb{} }
 func (m *ProposePb) String() string { return proto.CompactTextString(m) }
 func (*ProposePb) ProtoMessage()    {}
 func (*ProposePb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{6}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{7}
 }
 func (m *ProposePb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ProposePb.Unmarshal(m, b)
@@ -559,6 +630,7 @@ type EndorsePb struct {
 	EndorserPubKey       []byte                       `protobuf:"bytes,6,opt,name=endorserPubKey,proto3" json:"endorserPubKey,omitempty"`
 	Decision             bool                         `protobuf:"varint,7,opt,name=decision,proto3" json:"decision,omitempty"`
 	Signature            []byte                       `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	DkgSignature         []byte                       `protobuf:"bytes,9,opt,name=dkgSignature,proto3" json:"dkgSignature,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -568,7 +640,7 @@ func (m *EndorsePb) Reset()         { *m = EndorsePb{} }
 func (m *EndorsePb) String() string { return proto.CompactTextString(m) }
 func (*EndorsePb) ProtoMessage()    {}
 func (*EndorsePb) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{7}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{8}
 }
 func (m *EndorsePb) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndorsePb.Unmarshal(m, b)
@@ -644,20 +716,30 @@ func (m *EndorsePb) GetSignature() []byte {
 	return nil
 }
 
+func (m *EndorsePb) GetDkgSignature() []byte {
+	if m != nil {
+		return m.DkgSignature
+	}
+	return nil
+}
+
 type EndorsementSet struct {
-	BlockHash            []byte       `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
-	Round                uint32       `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
-	Endorsements         []*EndorsePb `protobuf:"bytes,3,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	BlockHash    []byte       `protobuf:"bytes,1,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Round        uint32       `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Endorsements []*EndorsePb `protobuf:"bytes,3,rep,name=endorsements,proto3" json:"endorsements,omitempty"`
+	// aggregated BLS signature of the COMMIT endorsements, which replaces the individual ones
+	AggregatedSignature  []byte   `protobuf:"bytes,4,opt,name=aggregatedSignature,proto3" json:"aggregatedSignature,omitempty"`
+	SignerBitmap         []byte   `protobuf:"bytes,5,opt,name=signerBitmap,proto3" json:"signerBitmap,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EndorsementSet) Reset()         { *m = EndorsementSet{} }
 func (m *EndorsementSet) String() string { return proto.CompactTextString(m) }
 func (*EndorsementSet) ProtoMessage()    {}
 func (*EndorsementSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{8}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{9}
 }
 func (m *EndorsementSet) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EndorsementSet.Unmarshal(m, b)
@@ -698,6 +780,20 @@ func (m *EndorsementSet) GetEndorsements() []*EndorsePb {
 	return nil
 }
 
+func (m *EndorsementSet) GetAggregatedSignature() []byte {
+	if m != nil {
+		return m.AggregatedSignature
+	}
+	return nil
+}
+
+func (m *EndorsementSet) GetSignerBitmap() []byte {
+	if m != nil {
+		return m.SignerBitmap
+	}
+	return nil
+}
+
 // Candidates and list of candidates
 type Candidate struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -705,6 +801,10 @@ type Candidate struct {
 	PubKey               []byte   `protobuf:"bytes,3,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
 	CreationHeight       uint64   `protobuf:"varint,4,opt,name=creationHeight,proto3" json:"creationHeight,omitempty"`
 	LastUpdateHeight     uint64   `protobuf:"varint,5,opt,name=lastUpdateHeight,proto3" json:"lastUpdateHeight,omitempty"`
+	Name                 string   `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	OperatorAddress      string   `protobuf:"bytes,7,opt,name=operatorAddress,proto3" json:"operatorAddress,omitempty"`
+	RewardAddress        string   `protobuf:"bytes,8,opt,name=rewardAddress,proto3" json:"rewardAddress,omitempty"`
+	SelfStake            []byte   `protobuf:"bytes,9,opt,name=selfStake,proto3" json:"selfStake,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -714,7 +814,7 @@ func (m *Candidate) Reset()         { *m = Candidate{} }
 func (m *Candidate) String() string { return proto.CompactTextString(m) }
 func (*Candidate) ProtoMessage()    {}
 func (*Candidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{9}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{10}
 }
 func (m *Candidate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Candidate.Unmarshal(m, b)
@@ -769,6 +869,34 @@ func (m *Candidate) GetLastUpdateHeight() uint64 {
 	return 0
 }
 
+func (m *Candidate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Candidate) GetOperatorAddress() string {
+	if m != nil {
+		return m.OperatorAddress
+	}
+	return ""
+}
+
+func (m *Candidate) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *Candidate) GetSelfStake() []byte {
+	if m != nil {
+		return m.SelfStake
+	}
+	return nil
+}
+
 type CandidateList struct {
 	Candidates           []*Candidate `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -780,7 +908,7 @@ func (m *CandidateList) Reset()         { *m = CandidateList{} }
 func (m *CandidateList) String() string { return proto.CompactTextString(m) }
 func (*CandidateList) ProtoMessage()    {}
 func (*CandidateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{10}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{11}
 }
 func (m *CandidateList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CandidateList.Unmarshal(m, b)
@@ -821,7 +949,7 @@ func (m *TestPayload) Reset()         { *m = TestPayload{} }
 func (m *TestPayload) String() string { return proto.CompactTextString(m) }
 func (*TestPayload) ProtoMessage()    {}
 func (*TestPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{11}
+	return fileDescriptor_blockchain_43e63f09bc8cef7a, []int{12}
 }
 func (m *TestPayload) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TestPayload.Unmarshal(m, b)
@@ -851,6 +979,7 @@ func (m *TestPayload) GetMsgBody() []byte {
 func init() {
 	proto.RegisterType((*BlockHeaderPb)(nil), "iproto.BlockHeaderPb")
 	proto.RegisterType((*BlockPb)(nil), "iproto.BlockPb")
+	proto.RegisterType((*BlockFooterPb)(nil), "iproto.BlockFooterPb")
 	proto.RegisterType((*BlockIndex)(nil), "iproto.BlockIndex")
 	proto.RegisterType((*BlockSync)(nil), "iproto.BlockSync")
 	proto.RegisterType((*BlockContainer)(nil), "iproto.BlockContainer")
@@ -868,62 +997,72 @@ func init() {
 func init() { proto.RegisterFile("blockchain.proto", fileDescriptor_blockchain_43e63f09bc8cef7a) }
 
 var fileDescriptor_blockchain_43e63f09bc8cef7a = []byte{
-	// 907 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5f, 0x8b, 0xdb, 0x46,
-	0x10, 0xaf, 0x6c, 0xf9, 0x8f, 0x46, 0xb6, 0xe3, 0x2e, 0x69, 0x50, 0x8f, 0x40, 0x8d, 0x48, 0x83,
-	0x09, 0xd4, 0xa1, 0x4e, 0x43, 0x43, 0xe9, 0x4b, 0xce, 0x77, 0x90, 0x23, 0x77, 0xb1, 0x58, 0xbb,
-	0x7d, 0x2d, 0x2b, 0x6b, 0x4e, 0x16, 0x3e, 0x6b, 0x85, 0x76, 0x7d, 0xc4, 0xf4, 0x03, 0xf5, 0x13,
-	0xf4, 0xb1, 0x9f, 0xa0, 0xdf, 0xa9, 0x94, 0xdd, 0x95, 0x64, 0xcb, 0x77, 0x2f, 0xa5, 0x4f, 0xde,
-	0xdf, 0xcc, 0x4f, 0x33, 0xb3, 0xbf, 0x99, 0x1d, 0xc3, 0x30, 0xbc, 0xe3, 0xab, 0xcd, 0x6a, 0xcd,
-	0x92, 0x74, 0x92, 0xe5, 0x5c, 0x72, 0xd2, 0x4e, 0xf4, 0xef, 0x59, 0x8f, 0xad, 0x64, 0xc2, 0x0b,
-	0xeb, 0xd9, 0x37, 0x31, 0xe7, 0xf1, 0x1d, 0xbe, 0xd6, 0x28, 0xdc, 0xdd, 0xbe, 0x96, 0xc9, 0x16,
-	0x85, 0x64, 0xdb, 0xcc, 0x10, 0xfc, 0xbf, 0x9a, 0xd0, 0x3f, 0x57, 0xb1, 0x3e, 0x20, 0x8b, 0x30,
-	0x0f, 0x42, 0xe2, 0x41, 0xe7, 0x1e, 0x73, 0x91, 0xf0, 0xd4, 0xb3, 0x46, 0xd6, 0xb8, 0x4f, 0x4b,
-	0xa8, 0x3c, 0x3a, 0xe3, 0xd5, 0x85, 0xd7, 0x30, 0x9e, 0x02, 0x92, 0x67, 0xd0, 0x5e, 0x63, 0x12,
-	0xaf, 0xa5, 0xd7, 0x1c, 0x59, 0x63, 0x9b, 0x16, 0x88, 0xbc, 0x03, 0xa7, 0x4a, 0xe8, 0xd9, 0x23,
-	0x6b, 0xec, 0x4e, 0xcf, 0x26, 0xa6, 0xa4, 0x49, 0x59, 0xd2, 0x64, 0x59, 0x32, 0xe8, 0x81, 0x4c,
-	0x5e, 0x40, 0x3f, 0xcb, 0xf1, 0xde, 0x94, 0xc6, 0xc4, 0xda, 0x6b, 0x8d, 0xac, 0x71, 0x8f, 0xd6,
-	0x8d, 0x2a, 0xaf, 0xfc, 0x4c, 0x39, 0x97, 0x5e, 0x5b, 0xbb, 0x0b, 0x44, 0x9e, 0x83, 0x23, 0x24,
-	0x93, 0xa8, 0x5d, 0x1d, 0xed, 0x3a, 0x18, 0xc8, 0x08, 0xdc, 0x1c, 0x57, 0x98, 0x64, 0x52, 0xfb,
-	0xbb, 0xda, 0x7f, 0x6c, 0x22, 0x67, 0xd0, 0xcd, 0x51, 0x60, 0x7e, 0x8f, 0x91, 0xe7, 0x68, 0x77,
-	0x85, 0x75, 0xec, 0x24, 0x4e, 0x99, 0xdc, 0xe5, 0xe8, 0x41, 0x11, 0xbb, 0x34, 0xa8, 0x8a, 0xb2,
-	0x5d, 0xb8, 0xc1, 0xbd, 0xe7, 0x9a, 0x8a, 0x0c, 0x22, 0x4f, 0xa1, 0x15, 0x6d, 0xe2, 0xab, 0x0b,
-	0xaf, 0xa7, 0xcd, 0x06, 0xa8, 0x58, 0xd1, 0x26, 0x0e, 0xcc, 0x07, 0x7d, 0x13, 0xab, 0x32, 0x10,
-	0x1f, 0x7a, 0xd1, 0x26, 0x5e, 0x54, 0xc9, 0x06, 0x9a, 0x50, 0xb3, 0xf9, 0x11, 0x74, 0xb4, 0x1c,
-	0x41, 0x48, 0xbe, 0x53, 0x4d, 0x50, 0x4d, 0xd4, 0x7d, 0x73, 0xa7, 0x5f, 0x4d, 0xcc, 0x48, 0x4c,
-	0x6a, 0xfd, 0xa5, 0x05, 0x89, 0xbc, 0x82, 0x8e, 0x19, 0x15, 0xe1, 0x35, 0x46, 0xcd, 0xb1, 0x3b,
-	0x1d, 0x96, 0xfc, 0xf7, 0xda, 0x1c, 0x84, 0xb4, 0x24, 0xf8, 0xd7, 0x00, 0x3a, 0xc8, 0x55, 0x1a,
-	0xe1, 0x67, 0x75, 0x17, 0x21, 0x59, 0x2e, 0x75, 0x1e, 0x9b, 0x1a, 0x40, 0x86, 0xd0, 0xc4, 0x34,
-	0xd2, 0x93, 0x61, 0x53, 0x75, 0x54, 0x5a, 0xf0, 0xdb, 0x5b, 0x81, 0x6a, 0x2a, 0x9a, 0xe3, 0x3e,
-	0x2d, 0x90, 0xff, 0x06, 0x1c, 0x1d, 0x6d, 0xb1, 0x4f, 0x57, 0x87, 0x60, 0x8d, 0x47, 0x82, 0x35,
-	0xab, 0x60, 0xfe, 0x8f, 0x30, 0xd0, 0x1f, 0xcd, 0x78, 0x2a, 0x59, 0x92, 0x62, 0x4e, 0xbe, 0x85,
-	0x96, 0x7e, 0x05, 0xc5, 0x75, 0x9f, 0xd4, 0xae, 0x1b, 0x84, 0xd4, 0x78, 0xfd, 0x7f, 0x2c, 0x70,
-	0x67, 0x3c, 0x15, 0x98, 0x8a, 0x9d, 0x08, 0xc2, 0xa3, 0x59, 0xb5, 0x6a, 0xb3, 0xfa, 0x14, 0x5a,
-	0x39, 0xdf, 0x15, 0x37, 0xe8, 0x53, 0x03, 0xc8, 0xcf, 0x60, 0xcb, 0x7d, 0x86, 0xba, 0x92, 0xc1,
-	0x74, 0x5c, 0xe6, 0x38, 0x0a, 0x78, 0x38, 0xdf, 0xa0, 0x10, 0x2c, 0xc6, 0xe5, 0x3e, 0x43, 0xaa,
-	0xbf, 0xfa, 0x1f, 0xf3, 0x4f, 0xc0, 0x8e, 0x98, 0x64, 0xc5, 0xd8, 0xeb, 0xb3, 0xff, 0x16, 0x9e,
-	0x3e, 0x96, 0x8b, 0xf4, 0xa0, 0x1b, 0xd0, 0x79, 0x30, 0x5f, 0xbc, 0xbf, 0x1e, 0x7e, 0x41, 0x9e,
-	0x80, 0x7b, 0xf9, 0xe9, 0x62, 0x4e, 0x17, 0x97, 0x37, 0x97, 0x9f, 0x96, 0x43, 0xcb, 0xff, 0xd3,
-	0x02, 0x27, 0xc8, 0x79, 0xc6, 0x05, 0x06, 0xa1, 0x1a, 0xed, 0xcc, 0x00, 0x33, 0x27, 0x0e, 0xad,
-	0xf0, 0x91, 0x34, 0x8d, 0xc7, 0xa5, 0x69, 0x1e, 0x4b, 0x43, 0xc0, 0x5e, 0xab, 0x97, 0x69, 0x9b,
-	0x12, 0xd5, 0x59, 0x31, 0x4d, 0x4f, 0x4c, 0xdd, 0x06, 0x90, 0x1f, 0xc0, 0xd1, 0x3d, 0xc9, 0x39,
-	0xbf, 0xd5, 0x2f, 0xd5, 0x9d, 0x3e, 0x2b, 0x95, 0xbc, 0x4c, 0x23, 0x9e, 0x0b, 0xdc, 0x62, 0x2a,
-	0x17, 0x28, 0xe9, 0x81, 0xe8, 0xff, 0xdd, 0x00, 0xa7, 0xf0, 0xfe, 0xe7, 0xb6, 0x3d, 0x07, 0x27,
-	0xac, 0x56, 0x47, 0xd3, 0x3c, 0xac, 0xca, 0x40, 0x7e, 0x82, 0x96, 0xe4, 0x59, 0xb2, 0xd2, 0xa5,
-	0x0f, 0xa6, 0x2f, 0x4e, 0x6a, 0x39, 0xee, 0xe9, 0xaf, 0x5c, 0xe2, 0x52, 0x71, 0xa9, 0xf9, 0x44,
-	0xe9, 0x87, 0x86, 0x96, 0xeb, 0x4b, 0x3a, 0xb4, 0xc2, 0xe4, 0x25, 0x0c, 0xca, 0x73, 0xb0, 0x0b,
-	0x3f, 0xe2, 0xbe, 0x58, 0x4b, 0x27, 0x56, 0x15, 0x23, 0xc2, 0x55, 0xa2, 0x77, 0xac, 0xda, 0x4e,
-	0x5d, 0x5a, 0xe1, 0xfa, 0x7a, 0xe9, 0x9e, 0xac, 0x17, 0xff, 0x1d, 0x90, 0x87, 0xa5, 0x9d, 0x0c,
-	0x40, 0x17, 0xec, 0xeb, 0xf9, 0xec, 0xe3, 0xd0, 0x22, 0x00, 0xed, 0xd9, 0xfc, 0xe6, 0xe6, 0x6a,
-	0x39, 0x6c, 0xf8, 0xbf, 0xc3, 0xa0, 0x2e, 0x75, 0x5d, 0x23, 0xeb, 0x54, 0xa3, 0xc7, 0x75, 0x7d,
-	0x0b, 0x3d, 0x3c, 0x44, 0x11, 0xfa, 0x61, 0xbb, 0xd3, 0x2f, 0x1f, 0x08, 0x48, 0x6b, 0x34, 0xff,
-	0x0f, 0x0b, 0x9c, 0x19, 0x4b, 0xa3, 0x24, 0x62, 0x12, 0xd5, 0xff, 0x08, 0x8b, 0xa2, 0x1c, 0x85,
-	0x28, 0x26, 0xb0, 0x84, 0x2a, 0xe9, 0x3d, 0x97, 0x28, 0x74, 0xd2, 0x1e, 0x35, 0xa0, 0xd8, 0xa9,
-	0x4a, 0xce, 0x66, 0xb5, 0x53, 0x95, 0x8c, 0x2f, 0x61, 0xb0, 0xca, 0x91, 0xa9, 0x15, 0xf5, 0xc1,
-	0x8c, 0x86, 0xad, 0x47, 0xe3, 0xc4, 0x4a, 0x5e, 0xc1, 0xf0, 0x8e, 0x09, 0xf9, 0x4b, 0xa6, 0xb2,
-	0x17, 0xcc, 0x96, 0x66, 0x3e, 0xb0, 0xfb, 0xe7, 0xd0, 0xaf, 0x0a, 0xbd, 0x4e, 0x84, 0x24, 0xdf,
-	0x03, 0xac, 0x4a, 0x83, 0xaa, 0xb7, 0x76, 0xdf, 0x8a, 0x4a, 0x8f, 0x48, 0xfe, 0x18, 0xdc, 0x25,
-	0x0a, 0x19, 0xb0, 0xfd, 0x1d, 0x67, 0x11, 0xf9, 0x1a, 0xba, 0x5b, 0x11, 0xff, 0x16, 0xf2, 0x68,
-	0x5f, 0xc8, 0xdc, 0xd9, 0x8a, 0xf8, 0x9c, 0x47, 0xfb, 0xb0, 0xad, 0xc3, 0xbc, 0xf9, 0x37, 0x00,
-	0x00, 0xff, 0xff, 0x6e, 0x93, 0x3f, 0xe6, 0xcf, 0x07, 0x00, 0x00,
+	// 1057 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa5, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xc6, 0x19, 0x27, 0xb6, 0xcb, 0x3f, 0x31, 0xcd, 0xb2, 0x1a, 0x22, 0x24, 0x56, 0xa3, 0x05,
+	0x45, 0x48, 0x78, 0x21, 0xcb, 0x8a, 0x15, 0xe2, 0x92, 0x64, 0xb3, 0xda, 0x68, 0x93, 0x8d, 0x35,
+	0x36, 0x5c, 0x51, 0xdb, 0xd3, 0x1e, 0x8f, 0x62, 0xbb, 0x47, 0x3d, 0xed, 0xb0, 0x79, 0x0e, 0x9e,
+	0x82, 0x07, 0xe0, 0x41, 0x78, 0x12, 0x4e, 0x5c, 0x11, 0xd5, 0xd5, 0x3d, 0x63, 0xcf, 0xc4, 0x1c,
+	0x10, 0x27, 0x77, 0xfd, 0xcc, 0x57, 0xd5, 0x55, 0x5f, 0x55, 0x1b, 0xfa, 0x93, 0x85, 0x9c, 0xde,
+	0x4e, 0xe7, 0x3c, 0x59, 0x0d, 0x52, 0x25, 0xb5, 0x64, 0x07, 0x09, 0xfd, 0x1e, 0x75, 0xf8, 0x54,
+	0x27, 0xd2, 0x69, 0x8f, 0x3e, 0x8b, 0xa5, 0x8c, 0x17, 0xe2, 0x19, 0x49, 0x93, 0xf5, 0xec, 0x99,
+	0x4e, 0x96, 0x22, 0xd3, 0x7c, 0x99, 0x5a, 0x87, 0xe0, 0x2f, 0x0f, 0xba, 0x67, 0x06, 0xeb, 0x8d,
+	0xe0, 0x91, 0x50, 0xc3, 0x09, 0xf3, 0xa1, 0x71, 0x27, 0x54, 0x86, 0x18, 0x7e, 0xed, 0x49, 0xed,
+	0xb8, 0x1b, 0xe6, 0xa2, 0xb1, 0x50, 0xc4, 0xcb, 0x57, 0xfe, 0x9e, 0xb5, 0x38, 0x91, 0x3d, 0x86,
+	0x83, 0xb9, 0x48, 0xe2, 0xb9, 0xf6, 0x3d, 0x34, 0xd4, 0x43, 0x27, 0xb1, 0x97, 0xd0, 0x2a, 0x02,
+	0xfa, 0x75, 0x34, 0xb5, 0x4f, 0x8e, 0x06, 0x36, 0xa5, 0x41, 0x9e, 0xd2, 0x60, 0x9c, 0x7b, 0x84,
+	0x1b, 0x67, 0xf6, 0x14, 0xba, 0xa9, 0x12, 0x77, 0x36, 0x35, 0x9e, 0xcd, 0xfd, 0x7d, 0xfc, 0xba,
+	0x13, 0x96, 0x95, 0x26, 0xae, 0x7e, 0x1f, 0x4a, 0xa9, 0xfd, 0x03, 0x32, 0x3b, 0x89, 0x7d, 0x0a,
+	0x2d, 0x84, 0xd1, 0x82, 0x4c, 0x0d, 0x32, 0x6d, 0x14, 0xec, 0x09, 0xb4, 0x95, 0x98, 0x8a, 0x24,
+	0xd5, 0x64, 0x6f, 0x92, 0x7d, 0x5b, 0xc5, 0x8e, 0xa0, 0xa9, 0x44, 0x26, 0xd4, 0x9d, 0x88, 0xfc,
+	0x16, 0x99, 0x0b, 0x99, 0xb0, 0x93, 0x78, 0xc5, 0xf5, 0x5a, 0x09, 0x1f, 0x1c, 0x76, 0xae, 0x30,
+	0x19, 0xa5, 0xeb, 0xc9, 0xad, 0xb8, 0xf7, 0xdb, 0x36, 0x23, 0x2b, 0xb1, 0x47, 0xb0, 0x1f, 0xdd,
+	0xc6, 0x58, 0xb9, 0x0e, 0xa9, 0xad, 0x60, 0xb0, 0xf0, 0x30, 0xb4, 0x1f, 0x74, 0x2d, 0x56, 0xa1,
+	0x60, 0x01, 0x74, 0x50, 0x18, 0x15, 0xc1, 0x7a, 0xe4, 0x50, 0xd2, 0x99, 0x9e, 0x4c, 0x78, 0x26,
+	0x5e, 0x0b, 0xe1, 0x1f, 0x92, 0x39, 0x17, 0x0d, 0xf6, 0x42, 0xc6, 0x19, 0x16, 0x4b, 0x2e, 0xfd,
+	0xbe, 0xc5, 0x2e, 0x14, 0xc1, 0xaf, 0x35, 0x68, 0x50, 0x1d, 0xb1, 0xe3, 0x5f, 0x99, 0xee, 0x99,
+	0xee, 0x53, 0xc3, 0xdb, 0x27, 0x1f, 0x0f, 0x2c, 0x97, 0x06, 0x25, 0x62, 0x84, 0xce, 0x89, 0x7d,
+	0x09, 0x0d, 0xcb, 0xb1, 0x0c, 0x69, 0xe0, 0xa1, 0x7f, 0x3f, 0xf7, 0x3f, 0x25, 0x35, 0xba, 0xe6,
+	0x0e, 0x06, 0x7a, 0x86, 0x05, 0x45, 0x68, 0x6f, 0x07, 0xf4, 0x6b, 0x32, 0x19, 0x68, 0xeb, 0x14,
+	0xac, 0x1d, 0x19, 0x73, 0x03, 0xfb, 0x1e, 0x3a, 0x62, 0x15, 0x49, 0x95, 0x89, 0xa5, 0x58, 0xe9,
+	0xcc, 0x25, 0xf8, 0x38, 0x47, 0xb9, 0xd8, 0xd8, 0x46, 0x42, 0x87, 0x25, 0x5f, 0x76, 0x0c, 0x87,
+	0x53, 0xb9, 0x5c, 0x26, 0xba, 0x20, 0x18, 0xd1, 0xb6, 0x1e, 0x56, 0xd5, 0xc1, 0x15, 0x00, 0x85,
+	0xbd, 0x5c, 0x45, 0xe2, 0xbd, 0x69, 0x15, 0xaa, 0x95, 0xa6, 0x60, 0xf5, 0xd0, 0x0a, 0xac, 0x0f,
+	0x1e, 0xa2, 0x3b, 0x04, 0x73, 0x34, 0xad, 0x96, 0xb3, 0x59, 0x26, 0x0c, 0xe9, 0x3d, 0x9c, 0x06,
+	0x27, 0x05, 0xcf, 0xa1, 0x45, 0x68, 0xa3, 0xfb, 0xd5, 0x74, 0x03, 0xb6, 0xb7, 0x03, 0xcc, 0x2b,
+	0xc0, 0x82, 0xef, 0xa0, 0x47, 0x1f, 0x9d, 0xcb, 0x95, 0xc6, 0x99, 0xc2, 0x32, 0x7f, 0x0e, 0xfb,
+	0x34, 0xe4, 0xee, 0xce, 0x87, 0xa5, 0xca, 0x61, 0xcd, 0xac, 0x35, 0xf8, 0xbb, 0x06, 0x6d, 0xfc,
+	0x28, 0x13, 0xab, 0x6c, 0x9d, 0x61, 0xc5, 0x36, 0xa3, 0x58, 0x2b, 0x8d, 0x22, 0x26, 0xa2, 0xe4,
+	0xda, 0xdd, 0xa0, 0x1b, 0x5a, 0x81, 0xfd, 0x00, 0x75, 0x7d, 0x9f, 0x0a, 0xca, 0xa4, 0x77, 0x72,
+	0x9c, 0xc7, 0xd8, 0x02, 0xdc, 0x9c, 0xaf, 0x45, 0x96, 0xf1, 0x58, 0x8c, 0xd1, 0x3f, 0xa4, 0xaf,
+	0xfe, 0xc7, 0x78, 0x33, 0xa8, 0x47, 0x5c, 0x73, 0x37, 0xd5, 0x74, 0x0e, 0x5e, 0xc0, 0xa3, 0x5d,
+	0xb1, 0x58, 0x07, 0x9a, 0xc3, 0xf0, 0x66, 0x78, 0x33, 0x3a, 0xbd, 0xea, 0x7f, 0xc0, 0x0e, 0xa1,
+	0x7d, 0xf1, 0xee, 0xd5, 0x4d, 0x38, 0xba, 0xb8, 0xbe, 0x78, 0x37, 0xee, 0xd7, 0x82, 0xdf, 0x6b,
+	0xd0, 0x1a, 0x2a, 0x99, 0xca, 0x4c, 0xe0, 0xf5, 0x71, 0x72, 0x53, 0x2b, 0x58, 0x36, 0xb7, 0xc2,
+	0x42, 0xde, 0x2a, 0xcd, 0xde, 0xee, 0xd2, 0x78, 0xdb, 0xa5, 0xc1, 0x14, 0xe7, 0x66, 0xf1, 0xd4,
+	0x6d, 0x8a, 0xe6, 0x6c, 0x3c, 0x6d, 0x4f, 0x6c, 0xde, 0x56, 0x60, 0xdf, 0x9a, 0x49, 0xc3, 0x9e,
+	0x28, 0x29, 0x67, 0xb4, 0x88, 0xfe, 0x9d, 0xa1, 0x1b, 0xc7, 0xe0, 0xcf, 0x3d, 0x68, 0x39, 0xeb,
+	0x7f, 0x6e, 0x1b, 0xce, 0xf6, 0xa4, 0xd8, 0x8c, 0x9e, 0x9d, 0xed, 0x42, 0x81, 0x43, 0xb3, 0xaf,
+	0x65, 0x9a, 0x4c, 0x29, 0xf5, 0xde, 0xc9, 0xd3, 0x4a, 0x2e, 0xdb, 0x3d, 0xfd, 0x09, 0xe7, 0x6c,
+	0x6c, 0x7c, 0x43, 0xfb, 0x89, 0xa9, 0x9f, 0x1b, 0x22, 0x45, 0x97, 0xc4, 0xfa, 0xe5, 0x32, 0xfb,
+	0x02, 0x7a, 0xf9, 0x19, 0x37, 0xd4, 0x5b, 0x5c, 0x59, 0x76, 0xeb, 0x56, 0xb4, 0x06, 0x23, 0x12,
+	0xd3, 0x84, 0x9e, 0x10, 0xb3, 0x7c, 0x9b, 0x61, 0x21, 0x97, 0xb7, 0x67, 0xb3, 0xba, 0x3d, 0xab,
+	0x1b, 0xaf, 0xf5, 0x70, 0xe3, 0x05, 0x2f, 0x81, 0x3d, 0x4c, 0xbf, 0x42, 0x92, 0x26, 0xd4, 0xaf,
+	0x6e, 0xce, 0xdf, 0xf6, 0x6b, 0x0c, 0xe0, 0xe0, 0xfc, 0xe6, 0xfa, 0xfa, 0x72, 0xdc, 0xdf, 0x0b,
+	0xfe, 0xa8, 0x41, 0xaf, 0xdc, 0x8f, 0x72, 0x21, 0x6b, 0xd5, 0x42, 0xee, 0x2e, 0xfe, 0x8b, 0xca,
+	0x4e, 0xf2, 0x68, 0x09, 0x7e, 0xf8, 0xa0, 0xca, 0x95, 0x75, 0xf4, 0x35, 0x7c, 0xc4, 0xe3, 0x58,
+	0x89, 0x18, 0x9f, 0xa1, 0x68, 0x73, 0x45, 0x4b, 0xaf, 0x5d, 0x26, 0x53, 0x0d, 0x53, 0x1a, 0xa1,
+	0xce, 0x12, 0xbd, 0xe4, 0xa9, 0x23, 0x5d, 0x49, 0x17, 0xfc, 0x86, 0x2c, 0x3a, 0xe7, 0xab, 0x28,
+	0xc1, 0x11, 0xa2, 0xd7, 0x80, 0x47, 0x11, 0x3e, 0x55, 0x99, 0x23, 0x7f, 0x2e, 0x9a, 0xab, 0xdc,
+	0x61, 0xb1, 0x32, 0xba, 0x0a, 0x32, 0x97, 0x04, 0xf7, 0x5a, 0x99, 0x4e, 0x7a, 0xc5, 0x6b, 0x65,
+	0x3a, 0x88, 0x9d, 0x9e, 0x2a, 0xc1, 0xcd, 0x0e, 0x7f, 0x63, 0x59, 0x59, 0x27, 0x56, 0x56, 0xb4,
+	0xf8, 0x14, 0xf4, 0x17, 0x3c, 0xd3, 0x3f, 0xa6, 0x26, 0xba, 0xf3, 0xdc, 0x27, 0xcf, 0x07, 0x7a,
+	0x33, 0x4f, 0x2b, 0xbe, 0x14, 0xc4, 0x99, 0x56, 0x48, 0x67, 0xb3, 0xa2, 0x65, 0x2a, 0x14, 0xd7,
+	0x52, 0x9d, 0xba, 0xbc, 0x1b, 0x64, 0xae, 0xaa, 0xcd, 0xff, 0x01, 0x25, 0x7e, 0xe1, 0x2a, 0xca,
+	0xfd, 0x9a, 0xe4, 0x57, 0x56, 0x12, 0xbb, 0xc4, 0x62, 0x36, 0xd2, 0xfc, 0x36, 0x27, 0xcf, 0x46,
+	0x11, 0x9c, 0x41, 0xb7, 0x28, 0xd5, 0x55, 0x92, 0x69, 0xf6, 0x0d, 0xc0, 0x34, 0x57, 0x98, 0x8a,
+	0x95, 0xfa, 0x58, 0xb8, 0x86, 0x5b, 0x4e, 0xc1, 0x31, 0xb4, 0xc7, 0xb8, 0xc3, 0x86, 0xfc, 0x7e,
+	0x21, 0x79, 0xc4, 0x3e, 0x81, 0xe6, 0x32, 0x8b, 0x7f, 0x9e, 0xc8, 0xe8, 0xde, 0xd1, 0xa7, 0x81,
+	0xf2, 0x19, 0x8a, 0x93, 0x03, 0x82, 0x79, 0xfe, 0x0f, 0x2f, 0xbd, 0x19, 0xd0, 0xab, 0x09, 0x00,
+	0x00,
 }
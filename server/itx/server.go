@@ -20,14 +20,18 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/action/protocol/account"
 	"github.com/iotexproject/iotex-core/action/protocol/execution"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
 	"github.com/iotexproject/iotex-core/action/protocol/multichain/mainchain"
 	"github.com/iotexproject/iotex-core/action/protocol/multichain/subchain"
+	"github.com/iotexproject/iotex-core/action/protocol/reward"
+	"github.com/iotexproject/iotex-core/action/protocol/timelock"
 	"github.com/iotexproject/iotex-core/action/protocol/vote"
 	"github.com/iotexproject/iotex-core/chainservice"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/dispatcher"
 	"github.com/iotexproject/iotex-core/explorer/idl/explorer"
 	"github.com/iotexproject/iotex-core/p2p"
+	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/routine"
 )
@@ -87,7 +91,21 @@ func newServer(cfg config.Config, testing bool) (*Server, error) {
 	accountProtocol := account.NewProtocol()
 	voteProtocol := vote.NewProtocol(cs.Blockchain())
 	executionProtocol := execution.NewProtocol(cs.Blockchain())
-	cs.AddProtocols(mainChainProtocol, accountProtocol, voteProtocol, executionProtocol)
+	timelockProtocol := timelock.NewProtocol(cs.Blockchain())
+	rewardProtocol, err := reward.NewProtocol(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "fail to create reward protocol")
+	}
+	governanceProtocol := governance.NewProtocol()
+	cs.AddProtocols(
+		mainChainProtocol,
+		accountProtocol,
+		voteProtocol,
+		executionProtocol,
+		timelockProtocol,
+		rewardProtocol,
+		governanceProtocol,
+	)
 	if cs.Explorer() != nil {
 		cs.Explorer().SetMainChainProtocol(mainChainProtocol)
 	}
@@ -176,7 +194,21 @@ func (s *Server) newSubChainService(cfg config.Config) error {
 	accountProtocol := account.NewProtocol()
 	voteProtocol := vote.NewProtocol(cs.Blockchain())
 	executionProtocol := execution.NewProtocol(cs.Blockchain())
-	cs.AddProtocols(subChainProtocol, accountProtocol, voteProtocol, executionProtocol)
+	timelockProtocol := timelock.NewProtocol(cs.Blockchain())
+	rewardProtocol, err := reward.NewProtocol(cfg)
+	if err != nil {
+		return err
+	}
+	governanceProtocol := governance.NewProtocol()
+	cs.AddProtocols(
+		subChainProtocol,
+		accountProtocol,
+		voteProtocol,
+		executionProtocol,
+		timelockProtocol,
+		rewardProtocol,
+		governanceProtocol,
+	)
 	s.chainservices[cs.ChainID()] = cs
 	return nil
 }
@@ -207,7 +239,21 @@ func (s *Server) NewTestingChainService(cfg config.Config) error {
 	accountProtocol := account.NewProtocol()
 	voteProtocol := vote.NewProtocol(cs.Blockchain())
 	executionProtocol := execution.NewProtocol(cs.Blockchain())
-	cs.AddProtocols(subChainProtocol, accountProtocol, voteProtocol, executionProtocol)
+	timelockProtocol := timelock.NewProtocol(cs.Blockchain())
+	rewardProtocol, err := reward.NewProtocol(cfg)
+	if err != nil {
+		return err
+	}
+	governanceProtocol := governance.NewProtocol()
+	cs.AddProtocols(
+		subChainProtocol,
+		accountProtocol,
+		voteProtocol,
+		executionProtocol,
+		timelockProtocol,
+		rewardProtocol,
+		governanceProtocol,
+	)
 	s.chainservices[cs.ChainID()] = cs
 	return nil
 }
@@ -240,6 +286,29 @@ func (s *Server) Dispatcher() dispatcher.Dispatcher {
 	return s.dispatcher
 }
 
+// InitChain creates the blockchain of a new network from the genesis file in config with all the protocols installed,
+// and returns the hash of the genesis block. If the blockchain exists, it verifies that the genesis block is created
+// from the genesis file.
+func InitChain(cfg config.Config) (hash.Hash32B, error) {
+	svr, err := NewServer(cfg)
+	if err != nil {
+		return hash.ZeroHash32B, errors.Wrap(err, "error when creating server")
+	}
+	bc := svr.rootChainService.Blockchain()
+	ctx := context.Background()
+	if err := bc.Start(ctx); err != nil {
+		return hash.ZeroHash32B, errors.Wrap(err, "error when starting blockchain")
+	}
+	genesisBlk, err := bc.GetBlockByHeight(0)
+	if err != nil {
+		if stopErr := bc.Stop(ctx); stopErr != nil {
+			log.L().Error("Failed to stop blockchain.", zap.Error(stopErr))
+		}
+		return hash.ZeroHash32B, errors.Wrap(err, "error when getting genesis block")
+	}
+	return genesisBlk.HashBlock(), bc.Stop(ctx)
+}
+
 // StartServer starts a node server
 func StartServer(svr *Server, cfg config.Config) {
 	ctx := context.Background()
@@ -8,6 +8,10 @@
 //   make build
 //   ./bin/server -config-file=./config.yaml
 //
+// To set up a new private network, create its blockchain from the genesis file, and set chain.genesisPath in the
+// config to the same file before starting the nodes:
+//   ./bin/server -config-path=./config.yaml init -genesis=./genesis.yaml
+//
 
 package main
 
@@ -34,7 +38,8 @@ func init() {
 	flag.IntVar(&recoveryHeight, "recovery-height", 0, "Recovery height")
 	flag.Usage = func() {
 		_, _ = fmt.Fprintf(os.Stderr,
-			"usage: server -config-path=[string] -recovery-height=[int]\n")
+			"usage: server -config-path=[string] -recovery-height=[int]\n"+
+				"       server -config-path=[string] init -genesis=[string]\n")
 		flag.PrintDefaults()
 		os.Exit(2)
 	}
@@ -47,6 +52,11 @@ func main() {
 		glog.Fatalln("Failed to new config.", zap.Error(err))
 	}
 
+	if flag.Arg(0) == "init" {
+		initChain(cfg, flag.Args()[1:])
+		return
+	}
+
 	initLogger(cfg)
 
 	// create and start the node
@@ -68,6 +78,27 @@ func main() {
 	itx.StartServer(svr, cfg)
 }
 
+// initChain creates the blockchain of a new network from the genesis file, or verifies the existing one is created
+// from it
+func initChain(cfg config.Config, args []string) {
+	fs := flag.NewFlagSet("init", flag.ExitOnError)
+	genesisPath := fs.String("genesis", "", "Genesis file path")
+	if err := fs.Parse(args); err != nil {
+		glog.Fatalln("Failed to parse init flags.", zap.Error(err))
+	}
+	if *genesisPath == "" {
+		glog.Fatalln("Genesis file path is required.")
+	}
+	cfg.Chain.GenesisPath = *genesisPath
+	initLogger(cfg)
+
+	genesisHash, err := itx.InitChain(cfg)
+	if err != nil {
+		log.L().Fatal("Failed to initialize blockchain.", zap.Error(err))
+	}
+	log.L().Info("Initialized blockchain.", log.Hex("genesisHash", genesisHash[:]))
+}
+
 func initLogger(cfg config.Config) {
 	addr, err := cfg.BlockchainAddress()
 	if err != nil {
@@ -38,8 +38,18 @@ type Candidate struct {
 	PublicKey        keypair.PublicKey
 	CreationHeight   uint64
 	LastUpdateHeight uint64
+	// The metadata below is only set for a candidate registered by the candidate registration action, but not the one
+	// self-nominated by voting to itself
+	Name            string
+	OperatorAddress string
+	RewardAddress   string
+	// SelfStake is the amount locked from the balance of the candidate on registration
+	SelfStake *big.Int
 }
 
+// Registered returns true if the candidate is registered by the candidate registration action
+func (c *Candidate) Registered() bool { return c.SelfStake != nil && c.SelfStake.Sign() > 0 }
+
 // CandidateList indicates the list of Candidates which is sortable
 type CandidateList []*Candidate
 
@@ -94,10 +104,16 @@ func candidateToPb(cand *Candidate) (*iproto.Candidate, error) {
 		PubKey:           cand.PublicKey[:],
 		CreationHeight:   cand.CreationHeight,
 		LastUpdateHeight: cand.LastUpdateHeight,
+		Name:             cand.Name,
+		OperatorAddress:  cand.OperatorAddress,
+		RewardAddress:    cand.RewardAddress,
 	}
 	if cand.Votes != nil && len(cand.Votes.Bytes()) > 0 {
 		candidatePb.Votes = cand.Votes.Bytes()
 	}
+	if cand.SelfStake != nil && len(cand.SelfStake.Bytes()) > 0 {
+		candidatePb.SelfStake = cand.SelfStake.Bytes()
+	}
 	return candidatePb, nil
 }
 
@@ -115,6 +131,10 @@ func pbToCandidate(candPb *iproto.Candidate) (*Candidate, error) {
 		PublicKey:        pk,
 		CreationHeight:   candPb.CreationHeight,
 		LastUpdateHeight: candPb.LastUpdateHeight,
+		Name:             candPb.Name,
+		OperatorAddress:  candPb.OperatorAddress,
+		RewardAddress:    candPb.RewardAddress,
+		SelfStake:        big.NewInt(0).SetBytes(candPb.SelfStake),
 	}
 	return candidate, nil
 }
@@ -77,3 +77,33 @@ func TestCandidate(t *testing.T) {
 	require.Equal(uint64(2), candidateMap[cand2Hash].Votes.Uint64())
 	require.Equal(uint64(3), candidateMap[cand3Hash].Votes.Uint64())
 }
+
+func TestCandidateListSerialization(t *testing.T) {
+	require := require.New(t)
+
+	l1 := CandidateList{
+		{
+			Address:          "io1qyqsyqcy0av2reec8lrrth063uj8xkuugmmtu3tm2pahu9",
+			Votes:            big.NewInt(100),
+			CreationHeight:   1,
+			LastUpdateHeight: 2,
+			Name:             "registered",
+			OperatorAddress:  "io1qyqsyqcyl7ge4df3g94rzxvd2acldsfvdtq22kvf2ws722",
+			RewardAddress:    "io1qyqsyqcyf64rhvaj2y70q66yzkrpkhl52428vm5v88gqah",
+			SelfStake:        big.NewInt(10),
+		},
+		{
+			Address: "io1qyqsyqcyl7ge4df3g94rzxvd2acldsfvdtq22kvf2ws722",
+			Votes:   big.NewInt(1),
+		},
+	}
+	data, err := l1.Serialize()
+	require.NoError(err)
+	var l2 CandidateList
+	require.NoError(l2.Deserialize(data))
+	require.Equal(2, len(l2))
+	require.Equal(*l1[0], *l2[0])
+	require.True(l2[0].Registered())
+	require.Equal("", l2[1].Name)
+	require.False(l2[1].Registered())
+}
@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/governance"
 	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
@@ -55,6 +56,7 @@ type (
 		RootHashByHeight(uint64) (hash.Hash32B, error)
 		Height() (uint64, error)
 		NewWorkingSet() (WorkingSet, error)
+		NewWorkingSetAtHeight(uint64) (WorkingSet, error)
 		Commit(WorkingSet) error
 		// Candidate pool
 		CandidatesByHeight(uint64) ([]*state.Candidate, error)
@@ -242,6 +244,18 @@ func (sf *factory) NewWorkingSet() (WorkingSet, error) {
 	return NewWorkingSet(sf.currentChainHeight, sf.dao, sf.rootHash(), sf.actionHandlers)
 }
 
+// NewWorkingSetAtHeight returns a working set on top of the states at a given height, which is never committed. It
+// fails if the states at the height are pruned.
+func (sf *factory) NewWorkingSetAtHeight(blockHeight uint64) (WorkingSet, error) {
+	root, err := sf.RootHashByHeight(blockHeight)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get root hash at height %d", blockHeight)
+	}
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+	return NewWorkingSet(blockHeight, sf.dao, root, sf.actionHandlers)
+}
+
 // Commit persists all changes in RunActions() into the DB
 func (sf *factory) Commit(ws WorkingSet) error {
 	if ws == nil {
@@ -275,6 +289,11 @@ func (sf *factory) Commit(ws WorkingSet) error {
 //======================================
 // CandidatesByHeight returns array of Candidates in candidate pool of a given height
 func (sf *factory) CandidatesByHeight(height uint64) ([]*state.Candidate, error) {
+	// The number of candidates may be changed by governance since the config
+	numCandidates, err := governance.Parameter(sf, governance.NumCandidates, height, uint64(sf.numCandidates))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get the number of candidates")
+	}
 	sf.mutex.RLock()
 	defer sf.mutex.RUnlock()
 	var candidates state.CandidateList
@@ -293,8 +312,8 @@ func (sf *factory) CandidatesByHeight(height uint64) ([]*state.Candidate, error)
 		return nil, errors.Wrap(state.ErrStateNotExist, "failed to get most recent state of candidateList")
 	}
 
-	if len(candidates) > int(sf.numCandidates) {
-		candidates = candidates[:sf.numCandidates]
+	if uint64(len(candidates)) > numCandidates {
+		candidates = candidates[:numCandidates]
 	}
 	return candidates, nil
 }
@@ -1085,3 +1085,111 @@ func compareStrings(actual []string, expected []string) bool {
 	}
 	return len(act) == 0
 }
+
+func TestRunBatch(t *testing.T) {
+	require := require.New(t)
+	a := testaddress.IotxAddrinfo["alfa"]
+	b := testaddress.IotxAddrinfo["bravo"]
+	c := testaddress.IotxAddrinfo["charlie"]
+
+	cfg := config.Default
+	sf, err := NewFactory(cfg, InMemTrieOption())
+	require.NoError(err)
+	sf.AddActionHandlers(account.NewProtocol())
+	require.NoError(sf.Start(context.Background()))
+	defer func() { require.NoError(sf.Stop(context.Background())) }()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	_, err = account.LoadOrCreateAccount(ws, a.RawAddress, big.NewInt(100000))
+	require.NoError(err)
+
+	newBatch := func(nonce uint64, amountB, amountC int64, gasLimit uint64, gasPrice int64) action.SealedEnvelope {
+		tsf1, err := action.NewTransfer(nonce, big.NewInt(amountB), a.RawAddress, b.RawAddress, nil, 0, big.NewInt(0))
+		require.NoError(err)
+		tsf2, err := action.NewTransfer(nonce, big.NewInt(amountC), a.RawAddress, c.RawAddress, nil, 0, big.NewInt(0))
+		require.NoError(err)
+		batch, err := action.NewBatch(tsf1, tsf2)
+		require.NoError(err)
+		bd := &action.EnvelopeBuilder{}
+		elp := bd.SetNonce(nonce).SetGasLimit(gasLimit).SetGasPrice(big.NewInt(gasPrice)).SetAction(batch).Build()
+		selp, err := action.Sign(elp, a.RawAddress, a.PrivateKey)
+		require.NoError(err)
+		return selp
+	}
+	gasLimit := testutil.TestGasLimit
+	ctx := protocol.WithRunActionsCtx(context.Background(),
+		protocol.RunActionsCtx{
+			ProducerAddr:    testaddress.IotxAddrinfo["producer"].RawAddress,
+			GasLimit:        &gasLimit,
+			EnableGasCharge: testutil.EnableGasCharge,
+		})
+
+	// Both transfers succeed
+	selp := newBatch(1, 10, 20, 100000, 0)
+	_, receipts, err := ws.RunActions(ctx, 1, []action.SealedEnvelope{selp})
+	require.NoError(err)
+	require.Equal(1, len(receipts))
+	require.Equal(action.SuccessReceiptStatus, receipts[selp.Hash()].Status)
+	require.NoError(sf.Commit(ws))
+	balance, err := sf.Balance(b.RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(10), balance)
+	balance, err = sf.Balance(c.RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(20), balance)
+
+	// The second transfer overdraws, so the whole batch is reverted
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	selp = newBatch(2, 10, 100000, 100000, 0)
+	_, receipts, err = ws.RunActions(ctx, 2, []action.SealedEnvelope{selp})
+	require.NoError(err)
+	require.Equal(action.FailureReceiptStatus, receipts[selp.Hash()].Status)
+	require.NoError(sf.Commit(ws))
+	balance, err = sf.Balance(a.RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(99970), balance)
+	balance, err = sf.Balance(b.RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(10), balance)
+	nonce, err := sf.Nonce(a.RawAddress)
+	require.NoError(err)
+	require.Equal(uint64(2), nonce)
+
+	// The reverted batch still pays for its intrinsic gas
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	selp = newBatch(3, 10, 100000, 100000, 1)
+	remaining := gasLimit
+	_, receipts, err = ws.RunActions(ctx, 3, []action.SealedEnvelope{selp})
+	require.NoError(err)
+	require.Equal(action.FailureReceiptStatus, receipts[selp.Hash()].Status)
+	require.Equal(2*action.TransferBaseIntrinsicGas, receipts[selp.Hash()].GasConsumed)
+	require.Equal(remaining-2*action.TransferBaseIntrinsicGas, gasLimit)
+	require.NoError(sf.Commit(ws))
+	balance, err = sf.Balance(a.RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(79970), balance)
+	balance, err = sf.Balance(testaddress.IotxAddrinfo["producer"].RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(20000), balance)
+	balance, err = sf.Balance(b.RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(10), balance)
+
+	// The payloads cannot consume more gas than the gas limit of the batch
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	selp = newBatch(4, 10, 20, action.TransferBaseIntrinsicGas+1, 0)
+	_, receipts, err = ws.RunActions(ctx, 4, []action.SealedEnvelope{selp})
+	require.NoError(err)
+	require.Equal(action.FailureReceiptStatus, receipts[selp.Hash()].Status)
+	require.Equal(action.TransferBaseIntrinsicGas+1, receipts[selp.Hash()].GasConsumed)
+	require.NoError(sf.Commit(ws))
+	balance, err = sf.Balance(b.RawAddress)
+	require.NoError(err)
+	require.Equal(big.NewInt(10), balance)
+	nonce, err = sf.Nonce(a.RawAddress)
+	require.NoError(err)
+	require.Equal(uint64(4), nonce)
+}
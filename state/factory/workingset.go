@@ -9,14 +9,18 @@ package factory
 import (
 	"context"
 	"fmt"
+	"math/big"
 
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/db/trie"
 	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/log"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/state"
 )
@@ -106,6 +110,20 @@ func (ws *workingSet) RunActions(
 	// Handle actions
 	receipts := make(map[hash.Hash32B]*action.Receipt)
 	for _, elp := range elps {
+		if batch, ok := elp.Action().(*action.Batch); ok {
+			receipt, err := ws.runBatch(ctx, elp, batch)
+			if err != nil {
+				return hash.ZeroHash32B, nil, errors.Wrapf(
+					err,
+					"error when batch %x (nonce: %d) from %s mutates states",
+					elp.Hash(),
+					elp.Nonce(),
+					elp.SrcAddr(),
+				)
+			}
+			receipts[elp.Hash()] = receipt
+			continue
+		}
 		for _, actionHandler := range ws.actionHandlers {
 			receipt, err := actionHandler.Handle(ctx, elp.Action(), ws)
 			if err != nil {
@@ -140,6 +158,110 @@ func (ws *workingSet) RunActions(
 	return ws.RootHash(), receipts, nil
 }
 
+// runBatch runs the payloads of a batch atomically. If any payload fails, all the changes of the batch are reverted,
+// while the sender is still charged for the gas and the nonce is consumed. A single receipt aggregating the payloads'
+// receipts is returned. The payloads altogether cannot consume more gas than the gas limit of the batch envelope.
+func (ws *workingSet) runBatch(
+	ctx context.Context,
+	elp action.SealedEnvelope,
+	batch *action.Batch,
+) (*action.Receipt, error) {
+	raCtx, ok := protocol.GetRunActionsCtx(ctx)
+	if !ok {
+		return nil, errors.New("failed to get RunActionsCtx")
+	}
+	budget := elp.GasLimit()
+	if raCtx.GasLimit != nil && *raCtx.GasLimit < budget {
+		budget = *raCtx.GasLimit
+	}
+	gasLimit := budget
+	payloadCtx := raCtx
+	payloadCtx.GasLimit = &gasLimit
+	ctx = protocol.WithRunActionsCtx(ctx, payloadCtx)
+
+	snapshot := ws.Snapshot()
+	receipt := &action.Receipt{
+		Hash:   elp.Hash(),
+		Status: action.SuccessReceiptStatus,
+	}
+	var failure error
+	for i, payload := range batch.Payloads() {
+		// Each payload runs out of the gas left by the payloads before it
+		batch.LimitPayloadGas(i, gasLimit)
+		for _, actionHandler := range ws.actionHandlers {
+			r, err := actionHandler.Handle(ctx, payload, ws)
+			if err != nil {
+				failure = err
+				break
+			}
+			if r == nil {
+				continue
+			}
+			if r.Status == action.FailureReceiptStatus {
+				failure = errors.Errorf("payload of batch %x failed", elp.Hash())
+				break
+			}
+			receipt.GasConsumed += r.GasConsumed
+			receipt.Logs = append(receipt.Logs, r.Logs...)
+			if r.ContractAddress != "" {
+				receipt.ContractAddress = r.ContractAddress
+			}
+			receipt.ReturnValue = r.ReturnValue
+		}
+		if failure != nil {
+			break
+		}
+	}
+	consumed := budget - gasLimit
+	if failure == nil {
+		if raCtx.GasLimit != nil {
+			*raCtx.GasLimit -= consumed
+		}
+		return receipt, nil
+	}
+
+	log.L().Info("Revert the batch.", zap.Error(failure), log.Hex("batch", receipt.Hash[:]))
+	if err := ws.Revert(snapshot); err != nil {
+		return nil, errors.Wrap(err, "failed to revert the batch")
+	}
+	// The failed batch still pays for the gas it has consumed, but no less than its intrinsic gas
+	gas, err := batch.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get intrinsic gas for batch %x", elp.Hash())
+	}
+	if gas < consumed {
+		gas = consumed
+	}
+	if gas > budget {
+		gas = budget
+	}
+	charged := &revertedBatch{SealedEnvelope: elp, gas: gas}
+	if raCtx.GasLimit == nil {
+		raCtx.GasLimit = &budget
+	} else if !raCtx.EnableGasCharge {
+		*raCtx.GasLimit -= gas
+	}
+	sender, err := account.LoadOrCreateAccount(ws, elp.SrcAddr(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of sender %s", elp.SrcAddr())
+	}
+	gasFee, tip, err := account.ChargeGas(charged, elp.SrcAddr(), sender, raCtx, ws)
+	if err != nil {
+		return nil, err
+	}
+	return account.FailureReceipt(charged, elp.SrcAddr(), sender, gasFee, tip, raCtx, ws)
+}
+
+// revertedBatch is a reverted batch charged for the gas it has consumed in place of its intrinsic gas
+type revertedBatch struct {
+	action.SealedEnvelope
+
+	gas uint64
+}
+
+// IntrinsicGas returns the gas charged for the reverted batch
+func (rb *revertedBatch) IntrinsicGas() (uint64, error) { return rb.gas, nil }
+
 func (ws *workingSet) Snapshot() int {
 	s := ws.cb.Snapshot()
 	ws.trieRoots[s] = byteutil.BytesTo32B(ws.accountTrie.RootHash())
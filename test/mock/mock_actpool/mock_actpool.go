@@ -5,10 +5,13 @@
 package mock_actpool
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	action "github.com/iotexproject/iotex-core/action"
 	protocol "github.com/iotexproject/iotex-core/action/protocol"
+	actpool "github.com/iotexproject/iotex-core/actpool"
 	hash "github.com/iotexproject/iotex-core/pkg/hash"
+	big "math/big"
 	reflect "reflect"
 )
 
@@ -35,6 +38,30 @@ func (m *MockActPool) EXPECT() *MockActPoolMockRecorder {
 	return m.recorder
 }
 
+// Start mocks base method
+func (m *MockActPool) Start(arg0 context.Context) error {
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start
+func (mr *MockActPoolMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockActPool)(nil).Start), arg0)
+}
+
+// Stop mocks base method
+func (m *MockActPool) Stop(arg0 context.Context) error {
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockActPoolMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockActPool)(nil).Stop), arg0)
+}
+
 // Reset mocks base method
 func (m *MockActPool) Reset() {
 	m.ctrl.Call(m, "Reset")
@@ -131,6 +158,19 @@ func (mr *MockActPoolMockRecorder) GetCapacity() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacity", reflect.TypeOf((*MockActPool)(nil).GetCapacity))
 }
 
+// GetReplacementGasPrice mocks base method
+func (m *MockActPool) GetReplacementGasPrice(addr string, nonce uint64) (*big.Int, error) {
+	ret := m.ctrl.Call(m, "GetReplacementGasPrice", addr, nonce)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplacementGasPrice indicates an expected call of GetReplacementGasPrice
+func (mr *MockActPoolMockRecorder) GetReplacementGasPrice(addr, nonce interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplacementGasPrice", reflect.TypeOf((*MockActPool)(nil).GetReplacementGasPrice), addr, nonce)
+}
+
 // AddActionValidators mocks base method
 func (m *MockActPool) AddActionValidators(arg0 ...protocol.ActionValidator) {
 	varargs := []interface{}{}
@@ -145,6 +185,30 @@ func (mr *MockActPoolMockRecorder) AddActionValidators(arg0 ...interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActionValidators", reflect.TypeOf((*MockActPool)(nil).AddActionValidators), arg0...)
 }
 
+// AddSubscriber mocks base method
+func (m *MockActPool) AddSubscriber(arg0 actpool.Subscriber) error {
+	ret := m.ctrl.Call(m, "AddSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSubscriber indicates an expected call of AddSubscriber
+func (mr *MockActPoolMockRecorder) AddSubscriber(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubscriber", reflect.TypeOf((*MockActPool)(nil).AddSubscriber), arg0)
+}
+
+// RemoveSubscriber mocks base method
+func (m *MockActPool) RemoveSubscriber(arg0 actpool.Subscriber) error {
+	ret := m.ctrl.Call(m, "RemoveSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSubscriber indicates an expected call of RemoveSubscriber
+func (mr *MockActPoolMockRecorder) RemoveSubscriber(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubscriber", reflect.TypeOf((*MockActPool)(nil).RemoveSubscriber), arg0)
+}
+
 // AddActionEnvelopeValidators mocks base method
 func (m *MockActPool) AddActionEnvelopeValidators(arg0 ...protocol.ActionEnvelopeValidator) {
 	varargs := []interface{}{}
@@ -8,8 +8,11 @@ import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
 	action "github.com/iotexproject/iotex-core/action"
+	blocklimit "github.com/iotexproject/iotex-core/action/protocol/blocklimit"
+	evm "github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	blockchain "github.com/iotexproject/iotex-core/blockchain"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
+	genesis "github.com/iotexproject/iotex-core/blockchain/genesis"
 	iotxaddress "github.com/iotexproject/iotex-core/iotxaddress"
 	hash "github.com/iotexproject/iotex-core/pkg/hash"
 	state "github.com/iotexproject/iotex-core/state"
@@ -247,6 +250,19 @@ func (mr *MockBlockchainMockRecorder) GetTransfersToAddress(address interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfersToAddress", reflect.TypeOf((*MockBlockchain)(nil).GetTransfersToAddress), address)
 }
 
+// GetTransfersByMemoTag mocks base method
+func (m *MockBlockchain) GetTransfersByMemoTag(tag string) ([]hash.Hash32B, error) {
+	ret := m.ctrl.Call(m, "GetTransfersByMemoTag", tag)
+	ret0, _ := ret[0].([]hash.Hash32B)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfersByMemoTag indicates an expected call of GetTransfersByMemoTag
+func (mr *MockBlockchainMockRecorder) GetTransfersByMemoTag(tag interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfersByMemoTag", reflect.TypeOf((*MockBlockchain)(nil).GetTransfersByMemoTag), tag)
+}
+
 // GetTransferByTransferHash mocks base method
 func (m *MockBlockchain) GetTransferByTransferHash(h hash.Hash32B) (*action.Transfer, error) {
 	ret := m.ctrl.Call(m, "GetTransferByTransferHash", h)
@@ -403,6 +419,33 @@ func (mr *MockBlockchainMockRecorder) GetReceiptByActionHash(h interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptByActionHash", reflect.TypeOf((*MockBlockchain)(nil).GetReceiptByActionHash), h)
 }
 
+// GetLogs mocks base method
+func (m *MockBlockchain) GetLogs(filter *blockchain.LogFilter) ([]*action.Log, error) {
+	ret := m.ctrl.Call(m, "GetLogs", filter)
+	ret0, _ := ret[0].([]*action.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogs indicates an expected call of GetLogs
+func (mr *MockBlockchainMockRecorder) GetLogs(filter interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockBlockchain)(nil).GetLogs), filter)
+}
+
+// TraceExecution mocks base method
+func (m *MockBlockchain) TraceExecution(h hash.Hash32B) (*action.Receipt, *evm.Trace, error) {
+	ret := m.ctrl.Call(m, "TraceExecution", h)
+	ret0, _ := ret[0].(*action.Receipt)
+	ret1, _ := ret[1].(*evm.Trace)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// TraceExecution indicates an expected call of TraceExecution
+func (mr *MockBlockchainMockRecorder) TraceExecution(h interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceExecution", reflect.TypeOf((*MockBlockchain)(nil).TraceExecution), h)
+}
+
 // GetActionsFromAddress mocks base method
 func (m *MockBlockchain) GetActionsFromAddress(address string) ([]hash.Hash32B, error) {
 	ret := m.ctrl.Call(m, "GetActionsFromAddress", address)
@@ -467,6 +510,57 @@ func (mr *MockBlockchainMockRecorder) GetFactory() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFactory", reflect.TypeOf((*MockBlockchain)(nil).GetFactory))
 }
 
+// BlockLimits mocks base method
+func (m *MockBlockchain) BlockLimits() (blocklimit.Limits, error) {
+	ret := m.ctrl.Call(m, "BlockLimits")
+	ret0, _ := ret[0].(blocklimit.Limits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockLimits indicates an expected call of BlockLimits
+func (mr *MockBlockchainMockRecorder) BlockLimits() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockLimits", reflect.TypeOf((*MockBlockchain)(nil).BlockLimits))
+}
+
+// Productivity mocks base method
+func (m *MockBlockchain) Productivity(startHeight, endHeight uint64) (map[string]uint64, error) {
+	ret := m.ctrl.Call(m, "Productivity", startHeight, endHeight)
+	ret0, _ := ret[0].(map[string]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Productivity indicates an expected call of Productivity
+func (mr *MockBlockchainMockRecorder) Productivity(startHeight, endHeight interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Productivity", reflect.TypeOf((*MockBlockchain)(nil).Productivity), startHeight, endHeight)
+}
+
+// ForkSchedule mocks base method
+func (m *MockBlockchain) ForkSchedule() genesis.ForkSchedule {
+	ret := m.ctrl.Call(m, "ForkSchedule")
+	ret0, _ := ret[0].(genesis.ForkSchedule)
+	return ret0
+}
+
+// ForkSchedule indicates an expected call of ForkSchedule
+func (mr *MockBlockchainMockRecorder) ForkSchedule() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkSchedule", reflect.TypeOf((*MockBlockchain)(nil).ForkSchedule))
+}
+
+// NextBaseFee mocks base method
+func (m *MockBlockchain) NextBaseFee() (*big.Int, error) {
+	ret := m.ctrl.Call(m, "NextBaseFee")
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextBaseFee indicates an expected call of NextBaseFee
+func (mr *MockBlockchainMockRecorder) NextBaseFee() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextBaseFee", reflect.TypeOf((*MockBlockchain)(nil).NextBaseFee))
+}
+
 // ChainID mocks base method
 func (m *MockBlockchain) ChainID() uint32 {
 	ret := m.ctrl.Call(m, "ChainID")
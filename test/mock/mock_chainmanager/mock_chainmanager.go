@@ -9,6 +9,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	action "github.com/iotexproject/iotex-core/action"
 	protocol "github.com/iotexproject/iotex-core/action/protocol"
+	genesis "github.com/iotexproject/iotex-core/blockchain/genesis"
 	db "github.com/iotexproject/iotex-core/db"
 	hash "github.com/iotexproject/iotex-core/pkg/hash"
 	state "github.com/iotexproject/iotex-core/state"
@@ -243,6 +244,30 @@ func (mr *MockChainManagerMockRecorder) Nonce(addr interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Nonce", reflect.TypeOf((*MockChainManager)(nil).Nonce), addr)
 }
 
+// TipHeight mocks base method
+func (m *MockChainManager) TipHeight() uint64 {
+	ret := m.ctrl.Call(m, "TipHeight")
+	ret0, _ := ret[0].(uint64)
+	return ret0
+}
+
+// TipHeight indicates an expected call of TipHeight
+func (mr *MockChainManagerMockRecorder) TipHeight() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TipHeight", reflect.TypeOf((*MockChainManager)(nil).TipHeight))
+}
+
+// ForkSchedule mocks base method
+func (m *MockChainManager) ForkSchedule() genesis.ForkSchedule {
+	ret := m.ctrl.Call(m, "ForkSchedule")
+	ret0, _ := ret[0].(genesis.ForkSchedule)
+	return ret0
+}
+
+// ForkSchedule indicates an expected call of ForkSchedule
+func (mr *MockChainManagerMockRecorder) ForkSchedule() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForkSchedule", reflect.TypeOf((*MockChainManager)(nil).ForkSchedule))
+}
+
 // MockStateManager is a mock of StateManager interface
 type MockStateManager struct {
 	ctrl     *gomock.Controller
@@ -137,6 +137,19 @@ func (mr *MockExplorerMockRecorder) GetTransfersByBlockID(blkID, offset, limit i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfersByBlockID", reflect.TypeOf((*MockExplorer)(nil).GetTransfersByBlockID), blkID, offset, limit)
 }
 
+// GetTransfersByMemoTag mocks base method
+func (m *MockExplorer) GetTransfersByMemoTag(tag string, offset, limit int64) ([]explorer.Transfer, error) {
+	ret := m.ctrl.Call(m, "GetTransfersByMemoTag", tag, offset, limit)
+	ret0, _ := ret[0].([]explorer.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfersByMemoTag indicates an expected call of GetTransfersByMemoTag
+func (mr *MockExplorerMockRecorder) GetTransfersByMemoTag(tag, offset, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfersByMemoTag", reflect.TypeOf((*MockExplorer)(nil).GetTransfersByMemoTag), tag, offset, limit)
+}
+
 // GetLastVotesByRange mocks base method
 func (m *MockExplorer) GetLastVotesByRange(startBlockHeight, offset, limit int64) ([]explorer.Vote, error) {
 	ret := m.ctrl.Call(m, "GetLastVotesByRange", startBlockHeight, offset, limit)
@@ -630,3 +643,107 @@ func (m *MockExplorer) GetStateRootHash(blockHeight int64) (string, error) {
 func (mr *MockExplorerMockRecorder) GetStateRootHash(blockHeight interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateRootHash", reflect.TypeOf((*MockExplorer)(nil).GetStateRootHash), blockHeight)
 }
+
+// GetTimelocksByAddress mocks base method
+func (m *MockExplorer) GetTimelocksByAddress(address string) ([]explorer.Timelock, error) {
+	ret := m.ctrl.Call(m, "GetTimelocksByAddress", address)
+	ret0, _ := ret[0].([]explorer.Timelock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimelocksByAddress indicates an expected call of GetTimelocksByAddress
+func (mr *MockExplorerMockRecorder) GetTimelocksByAddress(address interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimelocksByAddress", reflect.TypeOf((*MockExplorer)(nil).GetTimelocksByAddress), address)
+}
+
+// GetReplacementGasPrice mocks base method
+func (m *MockExplorer) GetReplacementGasPrice(address string, nonce int64) (string, error) {
+	ret := m.ctrl.Call(m, "GetReplacementGasPrice", address, nonce)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplacementGasPrice indicates an expected call of GetReplacementGasPrice
+func (mr *MockExplorerMockRecorder) GetReplacementGasPrice(address, nonce interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplacementGasPrice", reflect.TypeOf((*MockExplorer)(nil).GetReplacementGasPrice), address, nonce)
+}
+
+// GetActPoolEvents mocks base method
+func (m *MockExplorer) GetActPoolEvents(address string, cursor, timeout int64) (explorer.ActPoolEventList, error) {
+	ret := m.ctrl.Call(m, "GetActPoolEvents", address, cursor, timeout)
+	ret0, _ := ret[0].(explorer.ActPoolEventList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActPoolEvents indicates an expected call of GetActPoolEvents
+func (mr *MockExplorerMockRecorder) GetActPoolEvents(address, cursor, timeout interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActPoolEvents", reflect.TypeOf((*MockExplorer)(nil).GetActPoolEvents), address, cursor, timeout)
+}
+
+// GetBlockLimits mocks base method
+func (m *MockExplorer) GetBlockLimits() (explorer.BlockLimits, error) {
+	ret := m.ctrl.Call(m, "GetBlockLimits")
+	ret0, _ := ret[0].(explorer.BlockLimits)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockLimits indicates an expected call of GetBlockLimits
+func (mr *MockExplorerMockRecorder) GetBlockLimits() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockLimits", reflect.TypeOf((*MockExplorer)(nil).GetBlockLimits))
+}
+
+// GetRewardPool mocks base method
+func (m *MockExplorer) GetRewardPool() (explorer.RewardPool, error) {
+	ret := m.ctrl.Call(m, "GetRewardPool")
+	ret0, _ := ret[0].(explorer.RewardPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardPool indicates an expected call of GetRewardPool
+func (mr *MockExplorerMockRecorder) GetRewardPool() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardPool", reflect.TypeOf((*MockExplorer)(nil).GetRewardPool))
+}
+
+// GetUnclaimedReward mocks base method
+func (m *MockExplorer) GetUnclaimedReward(address string) (string, error) {
+	ret := m.ctrl.Call(m, "GetUnclaimedReward", address)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnclaimedReward indicates an expected call of GetUnclaimedReward
+func (mr *MockExplorerMockRecorder) GetUnclaimedReward(address interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnclaimedReward", reflect.TypeOf((*MockExplorer)(nil).GetUnclaimedReward), address)
+}
+
+// GetLogs mocks base method
+func (m *MockExplorer) GetLogs(filter explorer.LogFilter) ([]explorer.Log, error) {
+	ret := m.ctrl.Call(m, "GetLogs", filter)
+	ret0, _ := ret[0].([]explorer.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogs indicates an expected call of GetLogs
+func (mr *MockExplorerMockRecorder) GetLogs(filter interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockExplorer)(nil).GetLogs), filter)
+}
+
+// TraceExecution mocks base method
+func (m *MockExplorer) TraceExecution(id string) (explorer.ExecutionTrace, error) {
+	ret := m.ctrl.Call(m, "TraceExecution", id)
+	ret0, _ := ret[0].(explorer.ExecutionTrace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceExecution indicates an expected call of TraceExecution
+func (mr *MockExplorerMockRecorder) TraceExecution(id interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceExecution", reflect.TypeOf((*MockExplorer)(nil).TraceExecution), id)
+}
@@ -152,6 +152,19 @@ func (mr *MockFactoryMockRecorder) NewWorkingSet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkingSet", reflect.TypeOf((*MockFactory)(nil).NewWorkingSet))
 }
 
+// NewWorkingSetAtHeight mocks base method
+func (m *MockFactory) NewWorkingSetAtHeight(arg0 uint64) (factory.WorkingSet, error) {
+	ret := m.ctrl.Call(m, "NewWorkingSetAtHeight", arg0)
+	ret0, _ := ret[0].(factory.WorkingSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewWorkingSetAtHeight indicates an expected call of NewWorkingSetAtHeight
+func (mr *MockFactoryMockRecorder) NewWorkingSetAtHeight(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkingSetAtHeight", reflect.TypeOf((*MockFactory)(nil).NewWorkingSetAtHeight), arg0)
+}
+
 // Commit mocks base method
 func (m *MockFactory) Commit(arg0 factory.WorkingSet) error {
 	ret := m.ctrl.Call(m, "Commit", arg0)
@@ -13,7 +13,6 @@ import (
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
-	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
@@ -23,7 +22,7 @@ var createConfigCmd = &cobra.Command{
 	Short: "Creates a yaml config using generated pub/pri key pair.",
 	Long:  `Creates a yaml config using generated pub/pri key pair.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		public, private, err := crypto.EC283.NewKeyPair()
+		public, private, err := newKeyPair()
 		if err != nil {
 			log.L().Fatal("failed to create key pair", zap.Error(err))
 		}
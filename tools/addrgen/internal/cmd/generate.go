@@ -13,7 +13,6 @@ import (
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
-	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
@@ -32,7 +31,7 @@ var _addrNum int
 func generate(args []string) string {
 	items := make([]string, _addrNum)
 	for i := 0; i < _addrNum; i++ {
-		public, private, err := crypto.EC283.NewKeyPair()
+		public, private, err := newKeyPair()
 		if err != nil {
 			log.L().Fatal("failed to create key pair", zap.Error(err))
 		}
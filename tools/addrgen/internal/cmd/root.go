@@ -7,9 +7,12 @@
 package cmd
 
 import (
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/crypto"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
@@ -20,9 +23,27 @@ var rootCmd = &cobra.Command{
 	Long:  "addrgen is a command-line interface to generate IoTex address.",
 }
 
+var _keyType string
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() {
 	if err := rootCmd.Execute(); err != nil {
 		log.L().Fatal("failed to add cmd", zap.Error(err))
 	}
 }
+
+// newKeyPair generates a key pair of the key type given by the --key-type flag
+func newKeyPair() (keypair.PublicKey, keypair.PrivateKey, error) {
+	switch _keyType {
+	case keypair.EC283.String():
+		return crypto.NewKeyPair(keypair.EC283)
+	case keypair.Secp256k1.String():
+		return crypto.NewKeyPair(keypair.Secp256k1)
+	default:
+		return keypair.ZeroPublicKey, keypair.ZeroPrivateKey, errors.Errorf("unsupported key type %s", _keyType)
+	}
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVarP(&_keyType, "key-type", "t", keypair.EC283.String(), "key type, ec283 or secp256k1")
+}
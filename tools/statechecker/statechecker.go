@@ -0,0 +1,147 @@
+// Copyright (c) 2018 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// This is a tool to check the consistency of the voting weights in the state db. It recomputes the voting weights of
+// all the votees from the balances of their voters and the stake buckets for them, and diffs them with the stored
+// voting weights and the votes of the latest candidates. The accounts are collected from the senders, recipients and
+// producers of the blocks, the destinations of the payloads in the batches, and the accounts the executions call into
+// or transfer to, which are found by tracing the executions, so the node has to have run with explorer enabled.
+// To use, run "make build" and " ./bin/statechecker -config-path=<path to the config of the node>" while the node is
+// stopped. It exits with 1 if there are mismatches, and 2 if the check cannot be done.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol/vote"
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+const (
+	exitMismatch = 1
+	exitFailure  = 2
+)
+
+func main() {
+	flag.Parse()
+	os.Exit(run())
+}
+
+// run does the check and returns the exit code, so that the blockchain is stopped before the process exits
+func run() int {
+	cfg, err := config.New()
+	if err != nil {
+		log.L().Error("Failed to new config.", zap.Error(err))
+		return exitFailure
+	}
+	bc, err := blockchain.NewBlockchain(cfg, blockchain.DefaultStateFactoryOption(), blockchain.BoltDBDaoOption())
+	if err != nil {
+		log.L().Error("Failed to create blockchain.", zap.Error(err))
+		return exitFailure
+	}
+	if err := bc.Start(context.Background()); err != nil {
+		log.L().Error("Failed to start blockchain.", zap.Error(err))
+		return exitFailure
+	}
+	defer func() {
+		if err := bc.Stop(context.Background()); err != nil {
+			log.L().Error("Failed to stop blockchain.", zap.Error(err))
+		}
+	}()
+
+	tip := bc.TipHeight()
+	seen := make(map[string]bool)
+	var addrs []string
+	collect := func(addr string) {
+		if addr == "" || seen[addr] {
+			return
+		}
+		seen[addr] = true
+		addrs = append(addrs, addr)
+	}
+	for h := uint64(0); h <= tip; h++ {
+		blk, err := bc.GetBlockByHeight(h)
+		if err != nil {
+			log.L().Error("Failed to get block.", zap.Uint64("height", h), zap.Error(err))
+			return exitFailure
+		}
+		collect(blk.ProducerAddress())
+		for _, selp := range blk.Actions {
+			actHash := selp.Hash()
+			collect(selp.SrcAddr())
+			collect(selp.DstAddr())
+			switch act := selp.Action().(type) {
+			case *action.Batch:
+				for _, payload := range act.Payloads() {
+					if _, ok := payload.(*action.Execution); ok {
+						log.L().Error("Cannot trace the execution in batch.", log.Hex("hash", actHash[:]))
+						return exitFailure
+					}
+					if p, ok := payload.(interface{ DstAddr() string }); ok {
+						collect(p.DstAddr())
+					}
+				}
+			case *action.Execution:
+				if !cfg.Explorer.Enabled {
+					log.L().Error("Explorer has to be enabled to trace the executions.")
+					return exitFailure
+				}
+				_, trace, err := bc.TraceExecution(actHash)
+				if err != nil {
+					log.L().Error("Failed to trace execution.", log.Hex("hash", actHash[:]), zap.Error(err))
+					return exitFailure
+				}
+				for _, call := range trace.Calls {
+					collect(call.From)
+					collect(call.To)
+				}
+			}
+		}
+	}
+
+	sf := bc.GetFactory()
+	candidates, err := sf.CandidatesByHeight(tip)
+	if err != nil {
+		log.L().Error("Failed to get candidates.", zap.Uint64("height", tip), zap.Error(err))
+		return exitFailure
+	}
+	diffs, err := vote.CheckWeights(sf, addrs, candidates)
+	if err != nil {
+		log.L().Error("Failed to check voting weights.", zap.Error(err))
+		return exitFailure
+	}
+	for _, d := range diffs {
+		fmt.Printf("voting weight of %s: stored %s, expected %s\n", d.Address, d.Stored, d.Expected)
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Address < candidates[j].Address })
+	mismatches := len(diffs)
+	for _, c := range candidates {
+		acct, err := sf.AccountState(c.Address)
+		if err != nil {
+			log.L().Error("Failed to get account state.", zap.String("address", c.Address), zap.Error(err))
+			return exitFailure
+		}
+		if c.Votes.Cmp(acct.VotingWeight) != 0 {
+			fmt.Printf("votes of candidate %s: listed %s, stored %s\n", c.Address, c.Votes, acct.VotingWeight)
+			mismatches++
+		}
+	}
+	fmt.Printf("checked %d accounts and %d candidates at height %d, %d mismatches\n",
+		len(addrs), len(candidates), tip, mismatches)
+	if mismatches > 0 {
+		return exitMismatch
+	}
+	return 0
+}